@@ -0,0 +1,2227 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/aiagent"
+	v1alpha1 "github.com/techwithhuz/mcp-security-governance/controller/pkg/apis/governance/v1alpha1"
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/compliance"
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/discovery"
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/evaluator"
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/graph"
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/imageverify"
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/inventory"
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/mcpprobe"
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/skillscanner"
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/watcher"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	// Version is set at build time via ldflags
+	Version = "dev"
+
+	stateMu      sync.RWMutex
+	lastResult   *evaluator.EvaluationResult
+	lastCluster  *evaluator.ClusterState
+	currentState *evaluator.ClusterState
+	policy       evaluator.Policy
+	discoverer   *discovery.K8sDiscoverer
+
+	// Compliance framework mappings (built-in, overridable from a mounted ConfigMap)
+	complianceMappings *compliance.MappingLoader
+
+	// AI agent state
+	aiAgent      *aiagent.GovernanceAgent
+	lastAIResult *aiagent.AIScoreResult
+	aiAgentErr   error // Tracks initialization or last runtime error
+
+	// AI evaluation rate limiting
+	aiLastRun     time.Time
+	aiBackoff     time.Duration
+	aiMinInterval = 5 * time.Minute // default; overridden by policy.AIScanInterval
+	aiScanPaused  bool              // runtime pause flag (toggled via API)
+
+	// Governance scan state
+	lastScanTime  time.Time  // when the last governance scan completed
+	scanInterval  = 5 * time.Minute // default; overridden by policy.ScanInterval
+	scanMode      = "watch" // "watch" (reconcile on change) or "poll" (periodic timer)
+
+	// Resource watcher (reconcile-based scanning)
+	resourceWatcher *watcher.ResourceWatcher
+
+	// Cosign image verification — one verifier per set of trusted keys and
+	// registry settings, each keeping its digest cache until they change
+	imageVerifyMu  sync.Mutex
+	imageVerifiers = map[string]*imageverify.Verifier{}
+
+	// Inventory watcher — watches MCPServerCatalog from Agent Registry
+	// and scores each one with a Verified Score (publisher, transport, deployment, tools, usage)
+	inventoryWatcher *inventory.Watcher
+)
+
+func main() {
+	port := os.Getenv("PORT")
+	if port == "" {
+		port = "8090"
+	}
+
+	// Try to create a real Kubernetes discoverer
+	var err error
+	discoverer, err = discovery.NewK8sDiscoverer()
+	if err != nil {
+		log.Printf("[governance-api] WARNING: Could not create K8s discoverer: %v", err)
+		log.Printf("[governance-api] Falling back to simulated cluster state")
+		discoverer = nil
+	} else {
+		log.Printf("[governance-api] Connected to Kubernetes cluster — using real discovery")
+	}
+
+	mappingPath := os.Getenv("COMPLIANCE_MAPPING_PATH")
+	if mappingPath == "" {
+		mappingPath = compliance.DefaultMountPath
+	}
+	complianceMappings = compliance.NewMappingLoader(mappingPath)
+
+	// Initial discovery and evaluation
+	currentState = doDiscovery()
+	policy = loadPolicy()
+	verifyImages(currentState, policy)
+	probeMCPServers(currentState, policy)
+	probeAuthBypass(currentState, policy)
+	lastCluster = currentState
+	lastResult = evaluator.Evaluate(currentState.FilterByNamespaces(policy.TargetNamespaces, policy.ExcludeNamespaces), policy)
+	recordTrendPoint(lastResult)
+	findingStore.Record(lastResult)
+	updatePolicyStatus(policy, lastResult)
+	updateEvaluationStatus(policy.Name, lastResult)
+	log.Printf("[governance] Initial evaluation. Score: %d, Findings: %d (Policy: AgentGW=%v, CORS=%v, JWT=%v, RBAC=%v, TLS=%v, PromptGuard=%v, RateLimit=%v, Hardening=%v, AIAgent=%v, TargetNS=%v, ExcludeNS=%v)", 
+		lastResult.Score, len(lastResult.Findings),
+		policy.RequireAgentGateway, policy.RequireCORS, policy.RequireJWTAuth, 
+		policy.RequireRBAC, policy.RequireTLS, policy.RequirePromptGuard, policy.RequireRateLimit, policy.RequireHardenedDeployment,
+		policy.EnableAIAgent,
+		policy.TargetNamespaces, policy.ExcludeNamespaces)
+
+	// Initialize AI agent if enabled
+	if policy.EnableAIAgent {
+		initAIAgent(context.Background())
+	}
+
+	// Parse scan interval from policy (used as resync period for watcher fallback)
+	if policy.ScanInterval != "" {
+		if d, err := time.ParseDuration(policy.ScanInterval); err == nil && d >= 30*time.Second {
+			scanInterval = d
+		} else {
+			log.Printf("[governance] Invalid scanInterval %q, using default %v", policy.ScanInterval, scanInterval)
+		}
+	}
+	lastScanTime = time.Now()
+
+	// Start resource watcher (reconcile on change) or fall back to periodic polling
+	if discoverer != nil {
+		w, err := watcher.New(watcher.Config{
+			DynamicClient: discoverer.DynamicClient(),
+			Reconcile: func(reason string) {
+				doPeriodicScan()
+			},
+			Debounce:     3 * time.Second,
+			ResyncPeriod: scanInterval, // full resync as safety net
+		})
+		if err != nil {
+			log.Printf("[governance] WARNING: Failed to create resource watcher: %v — falling back to polling", err)
+			scanMode = "poll"
+			startPollingLoop()
+		} else {
+			resourceWatcher = w
+			scanMode = "watch"
+			log.Printf("[governance] Watch mode enabled — reconciling on resource changes (resync every %v)", scanInterval)
+			go resourceWatcher.Start(context.Background())
+		}
+	} else {
+		scanMode = "poll"
+		log.Printf("[governance] No K8s connection — using polling mode (every %v)", scanInterval)
+		startPollingLoop()
+	}
+
+	// Start inventory watcher — watches MCPServerCatalog from Agent Registry
+	// and scores each resource with a Verified Score on add/update/delete.
+	// No polling needed: the controller reconciliation loop handles it.
+	if discoverer != nil {
+		invPolicy := inventory.ScoringPolicy{
+			MaxToolsWarning:  policy.MaxToolsWarning,
+			MaxToolsCritical: policy.MaxToolsCritical,
+		}
+		// Apply verifiedCatalogScoring overrides from governance policy CR
+		if vcs, ok := policy.VerifiedCatalogScoring.(*v1alpha1.VerifiedCatalogScoringConfig); ok && vcs != nil {
+			if vcs.SecurityWeight > 0 {
+				invPolicy.SecurityWeight = vcs.SecurityWeight
+			}
+			if vcs.TrustWeight > 0 {
+				invPolicy.TrustWeight = vcs.TrustWeight
+			}
+			if vcs.ComplianceWeight > 0 {
+				invPolicy.ComplianceWeight = vcs.ComplianceWeight
+			}
+			if vcs.VerifiedThreshold > 0 {
+				invPolicy.VerifiedThreshold = vcs.VerifiedThreshold
+			}
+			if vcs.UnverifiedThreshold > 0 {
+				invPolicy.UnverifiedThreshold = vcs.UnverifiedThreshold
+			}
+			if len(vcs.CheckMaxScores) > 0 {
+				invPolicy.CheckMaxScores = vcs.CheckMaxScores
+			}
+		}
+		iw, err := inventory.NewWatcher(inventory.WatcherConfig{
+			DynamicClient: discoverer.DynamicClient(),
+			Policy: invPolicy,
+			Namespace: "", // watch all namespaces
+			PatchStatusOnUpdate: true, // Enable status patching with governance scores
+			OnChange: func() {
+				// Log when inventory verified scores change
+				log.Printf("[inventory] Verified resources updated — scores reconciled")
+			},
+		})
+		if err != nil {
+			log.Printf("[governance] WARNING: Failed to create inventory watcher: %v", err)
+		} else {
+			inventoryWatcher = iw
+			log.Printf("[governance] Inventory watcher enabled — scoring MCPServerCatalog resources on change and patching status")
+			go inventoryWatcher.Start(context.Background())
+		}
+	} else {
+		log.Printf("[governance] No K8s connection — inventory watcher disabled")
+	}
+
+	// API routes
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/health", handleHealth)
+	mux.HandleFunc("/api/governance/score", handleScore)
+	mux.HandleFunc("/api/governance/findings", handleFindings)
+	mux.HandleFunc("/api/governance/resources", handleResources)
+	mux.HandleFunc("/api/governance/namespaces", handleNamespaces)
+	mux.HandleFunc("/api/governance/teams", handleTeams)
+	mux.HandleFunc("/api/governance/teams/{team}", handleTeamDetail)
+	mux.HandleFunc("/api/governance/breakdown", handleBreakdown)
+	mux.HandleFunc("/api/governance/policy/effective", handleEffectivePolicy)
+	mux.HandleFunc("/api/governance/simulate", handleSimulate)
+	mux.HandleFunc("/api/governance/authz/simulate", handleAuthzSimulate)
+	mux.HandleFunc("/api/governance/compliance", handleCompliance)
+	mux.HandleFunc("/api/governance/graph", handleGraph)
+	mux.HandleFunc("/api/governance/blast-radius", handleBlastRadius)
+	mux.HandleFunc("/api/governance/evaluation", handleFullEvaluation)
+	mux.HandleFunc("/api/governance/trends", handleTrends)
+	mux.HandleFunc("/api/governance/resources/detail", handleResourceDetail)
+	mux.HandleFunc("/api/governance/ai-score", handleAIScore)
+	mux.HandleFunc("/api/governance/ai-score/refresh", handleAIRefresh)
+	mux.HandleFunc("/api/governance/ai-score/toggle", handleAIToggle)
+	// MCP-server-centric endpoints
+	mux.HandleFunc("/api/governance/mcp-servers", handleMCPServers)
+	mux.HandleFunc("/api/governance/mcp-servers/summary", handleMCPServerSummary)
+	mux.HandleFunc("/api/governance/mcp-servers/detail", handleMCPServerDetail)
+	// Agent-centric endpoints
+	mux.HandleFunc("/api/governance/agents", handleAgents)
+	mux.HandleFunc("/api/governance/agents/detail", handleAgentDetail)
+	// Scan management endpoints
+	mux.HandleFunc("/api/governance/scan/refresh", handleRefreshScan)
+	mux.HandleFunc("/api/governance/scan/status", handleScanStatus)
+	// Inventory verified score endpoints
+	mux.HandleFunc("/api/governance/inventory/verified", handleInventoryVerified)
+	mux.HandleFunc("/api/governance/inventory/summary", handleInventorySummary)
+	mux.HandleFunc("/api/governance/inventory/detail", handleInventoryDetail)
+	// Skill Catalog governance endpoints
+	mux.HandleFunc("/api/governance/skill-catalogs", handleSkillCatalogs)
+	mux.HandleFunc("/api/governance/skill-catalogs/scan", handleSkillCatalogScan)
+
+	// CORS middleware
+	handler := corsMiddleware(mux)
+
+	log.Printf("[governance-api] Starting on :%s", port)
+	if err := http.ListenAndServe(":"+port, handler); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func corsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		if r.Method == "OPTIONS" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func jsonResponse(w http.ResponseWriter, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(data)
+}
+
+// stateSnapshot holds an immutable copy of shared state for safe use in handlers.
+type stateSnapshot struct {
+	result  *evaluator.EvaluationResult
+	cluster *evaluator.ClusterState
+	policy  evaluator.Policy
+}
+
+// getSnapshot returns a consistent read of the shared state.
+func getSnapshot() stateSnapshot {
+	stateMu.RLock()
+	defer stateMu.RUnlock()
+	return stateSnapshot{
+		result:  lastResult,
+		cluster: lastCluster,
+		policy:  policy,
+	}
+}
+
+func handleHealth(w http.ResponseWriter, r *http.Request) {
+	stateMu.RLock()
+	scanTime := lastScanTime
+	interval := scanInterval
+	mode := scanMode
+	stateMu.RUnlock()
+
+	resp := map[string]interface{}{
+		"status":       "healthy",
+		"version":      Version,
+		"lastScanTime": scanTime.Format(time.RFC3339),
+		"scanInterval": interval.String(),
+		"scanMode":     mode,
+	}
+
+	// Include watcher stats if in watch mode
+	if mode == "watch" && resourceWatcher != nil {
+		stats := resourceWatcher.Stats()
+		resp["watcher"] = map[string]interface{}{
+			"activeWatches":  stats.ActiveWatches,
+			"totalGVRs":      stats.TotalGVRs,
+			"eventCount":     stats.EventCount,
+			"reconcileCount": stats.ReconcileCount,
+			"lastEvent":      stats.LastEvent.Format(time.RFC3339),
+			"lastReconcile":  stats.LastReconcile.Format(time.RFC3339),
+		}
+	}
+
+	// Include inventory watcher stats
+	if inventoryWatcher != nil {
+		iStats := inventoryWatcher.Stats()
+		iSummary := inventoryWatcher.GetSummary()
+		resp["inventory"] = map[string]interface{}{
+			"enabled":        true,
+			"resourceCount":  iStats.ResourceCount,
+			"eventCount":     iStats.EventCount,
+			"reconcileCount": iStats.ReconcileCount,
+			"lastEvent":      iStats.LastEvent.Format(time.RFC3339),
+			"lastReconcile":  iStats.LastReconcile.Format(time.RFC3339),
+			"averageScore":   iSummary.AverageScore,
+			"verifiedCount":  iSummary.VerifiedCount,
+			"warningCount":   iSummary.WarningCount,
+		}
+	}
+
+	jsonResponse(w, resp)
+}
+
+func getGrade(score int) string {
+	switch {
+	case score >= 90:
+		return "A"
+	case score >= 70:
+		return "B"
+	case score >= 50:
+		return "C"
+	case score >= 30:
+		return "D"
+	default:
+		return "F"
+	}
+}
+
+func getPhase(score int) string {
+	switch {
+	case score >= 90:
+		return "Compliant"
+	case score >= 70:
+		return "PartiallyCompliant"
+	case score >= 50:
+		return "NonCompliant"
+	default:
+		return "Critical"
+	}
+}
+
+func handleScore(w http.ResponseWriter, r *http.Request) {
+	snap := getSnapshot()
+	if snap.result == nil {
+		jsonResponse(w, map[string]interface{}{"score": 0, "grade": "F", "phase": "Unknown"})
+		return
+	}
+	type serverContribution struct {
+		Name  string `json:"name"`
+		Score int    `json:"score"`
+		Grade string `json:"grade"`
+	}
+	type categoryDetail struct {
+		Category    string               `json:"category"`
+		Score       int                  `json:"score"`
+		Weight      int                  `json:"weight"`
+		Weighted    float64              `json:"weighted"`
+		Status      string               `json:"status"`
+		InfraAbsent bool                 `json:"infraAbsent"`
+		Servers     []serverContribution `json:"servers"` // per-server scores for this category
+	}
+
+	// Mapping from category display name to MCPServerScoreBreakdown field getter
+	type catDef struct {
+		Name     string
+		Required bool
+		Weight   int
+		ClScore  int
+		GetScore func(v evaluator.MCPServerView) int
+	}
+
+	pw := snap.policy.Weights
+	bd := snap.result.ScoreBreakdown
+
+	allCats := []catDef{
+		{"AgentGateway Compliance", snap.policy.RequireAgentGateway, pw.AgentGatewayIntegration, bd.AgentGatewayScore,
+			func(v evaluator.MCPServerView) int { return v.ScoreBreakdown.GatewayRouting }},
+		{"Authentication", snap.policy.RequireJWTAuth, pw.Authentication, bd.AuthenticationScore,
+			func(v evaluator.MCPServerView) int { return v.ScoreBreakdown.Authentication }},
+		{"Authorization", snap.policy.RequireRBAC, pw.Authorization, bd.AuthorizationScore,
+			func(v evaluator.MCPServerView) int { return v.ScoreBreakdown.Authorization }},
+		{"CORS", snap.policy.RequireCORS, pw.CORSPolicy, bd.CORSScore,
+			func(v evaluator.MCPServerView) int { return v.ScoreBreakdown.CORS }},
+		{"TLS", snap.policy.RequireTLS, pw.TLSEncryption, bd.TLSScore,
+			func(v evaluator.MCPServerView) int { return v.ScoreBreakdown.TLS }},
+		{"Prompt Guard", snap.policy.RequirePromptGuard, pw.PromptGuard, bd.PromptGuardScore,
+			func(v evaluator.MCPServerView) int { return v.ScoreBreakdown.PromptGuard }},
+		{"Rate Limit", snap.policy.RequireRateLimit, pw.RateLimit, bd.RateLimitScore,
+			func(v evaluator.MCPServerView) int { return v.ScoreBreakdown.RateLimit }},
+		{"Tool Scope", snap.policy.MaxToolsWarning > 0 || snap.policy.MaxToolsCritical > 0, pw.ToolScope, bd.ToolScopeScore,
+			func(v evaluator.MCPServerView) int { return v.ScoreBreakdown.ToolScope }},
+		{"Hardened Deployment", snap.policy.RequireHardenedDeployment, pw.HardenedDeployment, bd.HardenedDeploymentScore,
+			func(v evaluator.MCPServerView) int { return v.ScoreBreakdown.HardeningScore }},
+	}
+
+	totalWeight := 0
+	var cats []categoryDetail
+
+	for _, c := range allCats {
+		if !c.Required {
+			continue
+		}
+		totalWeight += c.Weight
+
+		// Collect per-server scores for this category
+		var servers []serverContribution
+		for _, v := range snap.result.MCPServerViews {
+			s := c.GetScore(v)
+			servers = append(servers, serverContribution{
+				Name:  v.Name,
+				Score: s,
+				Grade: getGrade(s),
+			})
+		}
+
+		cats = append(cats, categoryDetail{
+			Category:    c.Name,
+			Score:       c.ClScore,
+			Weight:      c.Weight,
+			Status:      statusLabel(c.ClScore),
+			InfraAbsent: bd.InfraAbsent[c.Name],
+			Servers:     servers,
+		})
+	}
+
+	if totalWeight == 0 {
+		totalWeight = 100
+	}
+
+	// Recalculate weighted scores with correct totalWeight
+	for i := range cats {
+		cats[i].Weighted = float64(cats[i].Score*cats[i].Weight) / float64(totalWeight)
+	}
+
+	numServers := len(snap.result.MCPServerViews)
+	response := map[string]interface{}{
+		"score":      snap.result.Score,
+		"grade":      getGrade(snap.result.Score),
+		"phase":      getPhase(snap.result.Score),
+		"timestamp":  snap.result.Timestamp,
+		"categories": cats,
+		"severityPenalties": map[string]int{
+			"Critical": snap.policy.SeverityPenalties.Critical,
+			"High":     snap.policy.SeverityPenalties.High,
+			"Medium":   snap.policy.SeverityPenalties.Medium,
+			"Low":      snap.policy.SeverityPenalties.Low,
+		},
+		"explanation": fmt.Sprintf(
+			"Score is a weighted average of %d governance categories. Each category score is the average across %d MCP server(s). The final score %d/100 = Grade %s.",
+			len(cats), numServers, snap.result.Score, getGrade(snap.result.Score)),
+		"aiAgentEnabled": snap.policy.EnableAIAgent,
+	}
+
+	// Include AI score if available
+	stateMu.RLock()
+	if lastAIResult != nil {
+		response["aiScore"] = lastAIResult
+	}
+	stateMu.RUnlock()
+
+	jsonResponse(w, response)
+}
+
+func statusLabel(score int) string {
+	switch {
+	case score >= 90:
+		return "passing"
+	case score >= 70:
+		return "warning"
+	case score >= 50:
+		return "failing"
+	default:
+		return "critical"
+	}
+}
+
+func handleFindings(w http.ResponseWriter, r *http.Request) {
+	snap := getSnapshot()
+	if snap.result == nil {
+		jsonResponse(w, map[string]interface{}{
+			"findings":              []evaluator.TrackedFinding{},
+			"total":                 0,
+			"bySeverity":            map[string]int{},
+			"waived":                []evaluator.WaivedFinding{},
+			"totalWaived":           0,
+			"newSinceLastScan":      0,
+			"resolvedSinceLastScan": 0,
+		})
+		return
+	}
+
+	newFindings := findingStore.NewSinceLastScan()
+	resolved := findingStore.ResolvedSinceLastScan()
+
+	// ?filter=new → only findings that appeared in the last scan
+	// ?filter=resolved → only findings that disappeared in the last scan
+	var findings []evaluator.TrackedFinding
+	switch r.URL.Query().Get("filter") {
+	case "new":
+		findings = newFindings
+	case "resolved":
+		findings = resolved
+	case "", "all":
+		findings = findingStore.Track(snap.result.Findings)
+	default:
+		http.Error(w, "Invalid filter: expected new, resolved or all", http.StatusBadRequest)
+		return
+	}
+
+	bySeverity := map[string]int{}
+	frameworks := complianceFrameworks()
+	mapped := make([]findingWithControls, 0, len(findings))
+	for _, f := range findings {
+		bySeverity[f.Severity]++
+		mapped = append(mapped, findingWithControls{TrackedFinding: f, Controls: compliance.ControlsForFinding(frameworks, f.ID)})
+	}
+	waived := snap.result.WaivedFindings
+	if waived == nil {
+		waived = []evaluator.WaivedFinding{}
+	}
+	resp := map[string]interface{}{
+		"findings":              mapped,
+		"total":                 len(findings),
+		"bySeverity":            bySeverity,
+		"waived":                waived,
+		"totalWaived":           len(waived),
+		"newSinceLastScan":      len(newFindings),
+		"resolvedSinceLastScan": len(resolved),
+	}
+	if last := findingStore.LastScan(); !last.IsZero() {
+		resp["lastScan"] = last.Format(time.RFC3339)
+	}
+	jsonResponse(w, resp)
+}
+
+// findingWithControls is a tracked finding with the compliance controls it
+// maps to, keyed by framework ID.
+type findingWithControls struct {
+	evaluator.TrackedFinding
+	Controls map[string][]string `json:"controls"`
+}
+
+func handleResources(w http.ResponseWriter, r *http.Request) {
+	snap := getSnapshot()
+	if snap.result == nil {
+		jsonResponse(w, evaluator.ResourceSummary{})
+		return
+	}
+	jsonResponse(w, snap.result.ResourceSummary)
+}
+
+func handleNamespaces(w http.ResponseWriter, r *http.Request) {
+	snap := getSnapshot()
+	if snap.result == nil {
+		jsonResponse(w, map[string]interface{}{"namespaces": []evaluator.NamespaceScore{}})
+		return
+	}
+	jsonResponse(w, map[string]interface{}{"namespaces": snap.result.NamespaceScores})
+}
+
+// handleTeams returns the per-team scorecards. Owners are resolved from the
+// policy's ownership labels/annotations on each resource, its workload or its
+// namespace; anything unlabelled is reported under "unowned".
+func handleTeams(w http.ResponseWriter, r *http.Request) {
+	snap := getSnapshot()
+	if snap.result == nil {
+		jsonResponse(w, map[string]interface{}{"teams": []evaluator.TeamScore{}})
+		return
+	}
+	teams := snap.result.TeamScores
+	if teams == nil {
+		teams = []evaluator.TeamScore{}
+	}
+	jsonResponse(w, map[string]interface{}{"teams": teams})
+}
+
+// handleTeamDetail returns one team's scorecard with all of its MCP servers
+// (worst first) and open findings.
+func handleTeamDetail(w http.ResponseWriter, r *http.Request) {
+	snap := getSnapshot()
+	if snap.result == nil {
+		http.Error(w, "No evaluation available", http.StatusServiceUnavailable)
+		return
+	}
+
+	team := r.PathValue("team")
+	detail, ok := evaluator.BuildTeamDetail(snap.result, team)
+	if !ok {
+		http.Error(w, fmt.Sprintf("Unknown team %q", team), http.StatusNotFound)
+		return
+	}
+	jsonResponse(w, detail)
+}
+
+func handleBreakdown(w http.ResponseWriter, r *http.Request) {
+	snap := getSnapshot()
+	if snap.result == nil {
+		jsonResponse(w, map[string]interface{}{})
+		return
+	}
+	bd := snap.result.ScoreBreakdown
+	result := map[string]int{}
+	if snap.policy.RequireAgentGateway {
+		result["agentGatewayScore"] = bd.AgentGatewayScore
+	}
+	if snap.policy.RequireJWTAuth {
+		result["authenticationScore"] = bd.AuthenticationScore
+	}
+	if snap.policy.RequireRBAC {
+		result["authorizationScore"] = bd.AuthorizationScore
+	}
+	if snap.policy.RequireCORS {
+		result["corsScore"] = bd.CORSScore
+	}
+	if snap.policy.RequireTLS {
+		result["tlsScore"] = bd.TLSScore
+	}
+	if snap.policy.RequirePromptGuard {
+		result["promptGuardScore"] = bd.PromptGuardScore
+	}
+	if snap.policy.RequireRateLimit {
+		result["rateLimitScore"] = bd.RateLimitScore
+	}
+	if snap.policy.MaxToolsWarning > 0 || snap.policy.MaxToolsCritical > 0 {
+		result["toolScopeScore"] = bd.ToolScopeScore
+	}
+	if snap.policy.RequireHardenedDeployment {
+		result["hardeningScore"] = bd.HardenedDeploymentScore
+	}
+	jsonResponse(w, result)
+}
+
+func handleFullEvaluation(w http.ResponseWriter, r *http.Request) {
+	snap := getSnapshot()
+	if snap.result == nil {
+		http.Error(w, "No evaluation available", http.StatusServiceUnavailable)
+		return
+	}
+	jsonResponse(w, snap.result)
+}
+
+// ResourceDetail groups findings per individual resource
+type ResourceDetail struct {
+	ResourceRef string             `json:"resourceRef"`
+	Kind        string             `json:"kind"`
+	Name        string             `json:"name"`
+	Namespace   string             `json:"namespace"`
+	Status      string             `json:"status"`
+	Score       int                `json:"score"`
+	Findings    []evaluator.Finding `json:"findings"`
+	Critical    int                `json:"critical"`
+	High        int                `json:"high"`
+	Medium      int                `json:"medium"`
+	Low         int                `json:"low"`
+}
+
+func handleResourceDetail(w http.ResponseWriter, r *http.Request) {
+	snap := getSnapshot()
+	if snap.result == nil || snap.cluster == nil {
+		jsonResponse(w, map[string]interface{}{"resources": []ResourceDetail{}})
+		return
+	}
+
+	// Build a map of resourceRef -> findings
+	findingsMap := map[string][]evaluator.Finding{}
+	clusterFindings := []evaluator.Finding{} // findings not tied to a specific resource
+	for _, f := range snap.result.Findings {
+		if f.ResourceRef != "" {
+			findingsMap[f.ResourceRef] = append(findingsMap[f.ResourceRef], f)
+		} else {
+			clusterFindings = append(clusterFindings, f)
+		}
+	}
+
+	var resources []ResourceDetail
+
+	// AgentGateway Backends
+	for _, b := range snap.cluster.AgentgatewayBackends {
+		ref := fmt.Sprintf("AgentgatewayBackend/%s/%s", b.Namespace, b.Name)
+		rd := buildResourceDetail(ref, "AgentgatewayBackend", b.Name, b.Namespace, findingsMap[ref], snap.policy)
+		resources = append(resources, rd)
+	}
+
+	// AgentGateway Policies
+	for _, p := range snap.cluster.AgentgatewayPolicies {
+		ref := fmt.Sprintf("AgentgatewayPolicy/%s/%s", p.Namespace, p.Name)
+		rd := buildResourceDetail(ref, "AgentgatewayPolicy", p.Name, p.Namespace, findingsMap[ref], snap.policy)
+		resources = append(resources, rd)
+	}
+
+	// Gateways
+	for _, g := range snap.cluster.Gateways {
+		ref := fmt.Sprintf("Gateway/%s/%s", g.Namespace, g.Name)
+		rd := buildResourceDetail(ref, "Gateway", g.Name, g.Namespace, findingsMap[ref], snap.policy)
+		resources = append(resources, rd)
+	}
+
+	// HTTPRoutes
+	for _, h := range snap.cluster.HTTPRoutes {
+		ref := fmt.Sprintf("HTTPRoute/%s/%s", h.Namespace, h.Name)
+		rd := buildResourceDetail(ref, "HTTPRoute", h.Name, h.Namespace, findingsMap[ref], snap.policy)
+		resources = append(resources, rd)
+	}
+
+	// Kagent Agents
+	for _, a := range snap.cluster.KagentAgents {
+		ref := fmt.Sprintf("Agent/%s/%s", a.Namespace, a.Name)
+		rd := buildResourceDetail(ref, "Agent", a.Name, a.Namespace, findingsMap[ref], snap.policy)
+		resources = append(resources, rd)
+	}
+
+	// Kagent MCPServers
+	for _, m := range snap.cluster.KagentMCPServers {
+		ref := fmt.Sprintf("MCPServer/%s/%s", m.Namespace, m.Name)
+		rd := buildResourceDetail(ref, "MCPServer", m.Name, m.Namespace, findingsMap[ref], snap.policy)
+		resources = append(resources, rd)
+	}
+
+	// Kagent RemoteMCPServers
+	for _, rm := range snap.cluster.KagentRemoteMCPServers {
+		ref := fmt.Sprintf("RemoteMCPServer/%s/%s", rm.Namespace, rm.Name)
+		rd := buildResourceDetail(ref, "RemoteMCPServer", rm.Name, rm.Namespace, findingsMap[ref], snap.policy)
+		resources = append(resources, rd)
+	}
+
+	// Add cluster-wide findings as a virtual resource
+	if len(clusterFindings) > 0 {
+		rd := buildResourceDetail("cluster-wide", "Cluster", "cluster-wide-policies", "", clusterFindings, snap.policy)
+		resources = append(resources, rd)
+	}
+
+	jsonResponse(w, map[string]interface{}{
+		"resources": resources,
+		"total":     len(resources),
+	})
+}
+
+func buildResourceDetail(ref, kind, name, namespace string, findings []evaluator.Finding, p evaluator.Policy) ResourceDetail {
+	rd := ResourceDetail{
+		ResourceRef: ref,
+		Kind:        kind,
+		Name:        name,
+		Namespace:   namespace,
+		Findings:    findings,
+		Score:       100,
+	}
+	if rd.Findings == nil {
+		rd.Findings = []evaluator.Finding{}
+	}
+
+	// Count severity occurrences
+	for _, f := range findings {
+		switch f.Severity {
+		case "Critical":
+			rd.Critical++
+		case "High":
+			rd.High++
+		case "Medium":
+			rd.Medium++
+		case "Low":
+			rd.Low++
+		}
+	}
+
+	// Calculate score: if any Critical findings exist, score = 0
+	// Otherwise deduct per severity using policy-configured penalties
+	if rd.Critical > 0 {
+		rd.Score = 0
+	} else {
+		for _, f := range findings {
+			switch f.Severity {
+			case "High":
+				rd.Score -= p.SeverityPenalties.High
+			case "Medium":
+				rd.Score -= p.SeverityPenalties.Medium
+			case "Low":
+				rd.Score -= p.SeverityPenalties.Low
+			}
+		}
+		if rd.Score < 0 {
+			rd.Score = 0
+		}
+	}
+
+	switch {
+	case len(findings) == 0:
+		rd.Status = "compliant"
+	case rd.Critical > 0:
+		rd.Status = "critical"
+	case rd.High > 0:
+		rd.Status = "failing"
+	case rd.Medium > 0:
+		rd.Status = "warning"
+	default:
+		rd.Status = "info"
+	}
+	return rd
+}
+
+// Trend data - in production this would use persistent storage
+var (
+	trendMu      sync.RWMutex
+	trendHistory []TrendPoint
+)
+
+// findingStore tracks first-seen / last-seen / resolved lifecycle per finding
+// fingerprint across scans. Fed from the same call sites as recordTrendPoint.
+var findingStore = evaluator.NewFindingStore(evaluator.DefaultFindingRetention)
+
+type TrendPoint struct {
+	Timestamp string `json:"timestamp"`
+	Score     int    `json:"score"`
+	Findings  int    `json:"findings"`
+	Critical  int    `json:"critical"`
+	High      int    `json:"high"`
+	Medium    int    `json:"medium"`
+	Low       int    `json:"low"`
+}
+
+// recordTrendPoint appends a trend point from an evaluation result.
+// Called only from the initial setup and the ticker goroutine — never from HTTP handlers.
+func recordTrendPoint(res *evaluator.EvaluationResult) {
+	if res == nil {
+		return
+	}
+	critical, high, medium, low := 0, 0, 0, 0
+	for _, f := range res.Findings {
+		switch f.Severity {
+		case "Critical":
+			critical++
+		case "High":
+			high++
+		case "Medium":
+			medium++
+		case "Low":
+			low++
+		}
+	}
+
+	trendMu.Lock()
+	trendHistory = append(trendHistory, TrendPoint{
+		Timestamp: res.Timestamp.Format(time.RFC3339),
+		Score:     res.Score,
+		Findings:  len(res.Findings),
+		Critical:  critical,
+		High:      high,
+		Medium:    medium,
+		Low:       low,
+	})
+
+	// Keep last 100 trend points
+	if len(trendHistory) > 100 {
+		trendHistory = trendHistory[len(trendHistory)-100:]
+	}
+	trendMu.Unlock()
+}
+
+func handleTrends(w http.ResponseWriter, r *http.Request) {
+	trendMu.RLock()
+	trends := make([]TrendPoint, len(trendHistory))
+	copy(trends, trendHistory)
+	trendMu.RUnlock()
+
+	jsonResponse(w, map[string]interface{}{"trends": trends})
+}
+
+// doDiscovery uses real K8s discovery if available, otherwise falls back to simulated data
+func doDiscovery() *evaluator.ClusterState {
+	if discoverer != nil {
+		return discoverer.DiscoverClusterState(context.Background())
+	}
+	return discoverClusterState()
+}
+
+// verifyImages checks the cosign signatures of the images of every evaluated
+// workload whose namespace policy enables image verification, against the
+// keys that policy trusts, and stores the results in the cluster state for the
+// image-verification check.
+func verifyImages(cs *evaluator.ClusterState, p evaluator.Policy) {
+	if cs == nil {
+		return
+	}
+	targets := evaluator.ImageVerificationTargets(cs.FilterByNamespaces(p.TargetNamespaces, p.ExcludeNamespaces), p)
+	if len(targets) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	imageVerifyMu.Lock()
+	defer imageVerifyMu.Unlock()
+	cs.ImageSignatures = map[string]imageverify.Result{}
+	used := map[string]bool{}
+	for _, t := range targets {
+		var cmKeys []string
+		if cm := t.Policy.PublicKeysConfigMap; cm != "" && discoverer != nil {
+			keys, err := discoverer.LoadPublicKeys(ctx, cm)
+			if err != nil {
+				log.Printf("[governance] WARNING: Failed to load cosign keys from ConfigMap %s: %v", cm, err)
+			}
+			cmKeys = keys
+		}
+		cfg := t.Policy.VerifierConfig(cmKeys)
+
+		key := imageverify.ConfigKey(cfg)
+		v, ok := imageVerifiers[key]
+		if !ok {
+			var err error
+			if v, err = imageverify.New(cfg); err != nil {
+				log.Printf("[governance] WARNING: Image verification disabled for namespaces %s: %v", strings.Join(t.Namespaces, ", "), err)
+				for _, ns := range t.Namespaces {
+					for _, img := range t.Images {
+						cs.ImageSignatures[evaluator.ImageSignatureKey(ns, img)] = imageverify.Result{Image: img, Status: imageverify.StatusError, Reason: err.Error()}
+					}
+				}
+				continue
+			}
+			imageVerifiers[key] = v
+		}
+		used[key] = true
+		results := v.VerifyAll(ctx, t.Images, 4)
+		for _, ns := range t.Namespaces {
+			for img, r := range results {
+				cs.ImageSignatures[evaluator.ImageSignatureKey(ns, img)] = r
+			}
+		}
+	}
+	for key := range imageVerifiers {
+		if !used[key] {
+			delete(imageVerifiers, key)
+		}
+	}
+	log.Printf("[governance] Verified signatures of images in %d key set(s)", len(targets))
+}
+
+// probeMCPServers connects to the MCP servers in the probe allowlist when the
+// baseline or their namespace policy enables live probing, and stores their
+// tool inventories in the cluster state for the mcp-live-probe check.
+func probeMCPServers(cs *evaluator.ClusterState, p evaluator.Policy) {
+	if cs == nil || !p.MCPProbeEnabled() {
+		return
+	}
+	targets := evaluator.MCPProbeTargets(cs.FilterByNamespaces(p.TargetNamespaces, p.ExcludeNamespaces), p)
+	if len(targets) == 0 {
+		return
+	}
+	cfg := p.MCPProbe.ProberConfig()
+	// Every probe is bounded by cfg.Timeout; the overall deadline only guards
+	// against a stuck scan.
+	batches := (len(targets) + cfg.Concurrency - 1) / cfg.Concurrency
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(batches+1)*cfg.Timeout)
+	defer cancel()
+
+	cs.MCPProbes = mcpprobe.New(cfg).ProbeAll(ctx, targets)
+	failed := 0
+	for _, r := range cs.MCPProbes {
+		if r.Status != mcpprobe.StatusOK {
+			failed++
+		}
+	}
+	log.Printf("[governance] Probed %d MCP server(s), %d failed", len(cs.MCPProbes), failed)
+}
+
+// probeAuthBypass sends unauthenticated, expired-token and wrong-audience
+// requests to the gateway-routed MCP servers in the probe allowlist, through
+// the gateway and directly to their backends, when the baseline or their
+// namespace policy enables it, and stores the answers in the cluster state
+// for the auth-bypass-probe check.
+func probeAuthBypass(cs *evaluator.ClusterState, p evaluator.Policy) {
+	if cs == nil || !p.AuthBypassProbeEnabled() {
+		return
+	}
+	probes := evaluator.AuthProbeTargets(cs.FilterByNamespaces(p.TargetNamespaces, p.ExcludeNamespaces), p)
+	if len(probes) == 0 {
+		return
+	}
+	cfg := p.MCPProbe.ProberConfig()
+	// The probe only presents forged credentials, so gateway certificates are
+	// not verified here; TLS-004..006 report on them.
+	cfg.Client = &http.Client{Transport: &http.Transport{
+		Proxy:           http.ProxyFromEnvironment,
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec
+	}}
+	targets := make([]mcpprobe.AuthTarget, len(probes))
+	for i, pr := range probes {
+		targets[i] = pr.Target
+	}
+	// Each request is bounded by cfg.Timeout; the overall deadline only guards
+	// against a stuck scan.
+	batches := (len(targets) + cfg.Concurrency - 1) / cfg.Concurrency
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(3*batches+1)*cfg.Timeout)
+	defer cancel()
+
+	cs.AuthProbes = make(map[string][]evaluator.AuthProbe)
+	accepted := 0
+	for i, results := range mcpprobe.New(cfg).ProbeAuthAll(ctx, targets) {
+		probes[i].Results = results
+		cs.AuthProbes[probes[i].ServerID] = append(cs.AuthProbes[probes[i].ServerID], probes[i])
+		for _, r := range results {
+			if r.Outcome == mcpprobe.OutcomeAccepted {
+				accepted++
+			}
+		}
+	}
+	log.Printf("[governance] Auth-probed %d MCP endpoint(s), %d request(s) accepted", len(probes), accepted)
+}
+
+// loadPolicy loads the MCPGovernancePolicy from the cluster or returns default
+func loadPolicy() evaluator.Policy {
+	if discoverer != nil {
+		if policy := discoverer.DiscoverGovernancePolicy(context.Background()); policy != nil {
+			return *policy
+		}
+	}
+	log.Printf("[governance] Using default policy")
+	return evaluator.DefaultPolicy()
+}
+
+// updatePolicyStatus writes the evaluation result back to the status subresource of
+// every MCPGovernancePolicy CR merged into the effective policy: baselines get the
+// cluster score, namespace-scoped policies the score of their namespaces.
+func updatePolicyStatus(p evaluator.Policy, result *evaluator.EvaluationResult) {
+	if discoverer == nil || result == nil {
+		return
+	}
+	baselines := p.SourcePolicies
+	if len(baselines) == 0 && p.Name != "" {
+		baselines = []string{p.Name}
+	}
+	for _, name := range baselines {
+		if err := discoverer.UpdatePolicyStatus(context.Background(), name, result); err != nil {
+			log.Printf("[governance] WARNING: Failed to update policy status for %s: %v", name, err)
+		}
+	}
+	for name, namespaces := range p.ScopedPolicyNamespaces() {
+		if err := discoverer.UpdateScopedPolicyStatus(context.Background(), name, namespaces, result); err != nil {
+			log.Printf("[governance] WARNING: Failed to update policy status for %s: %v", name, err)
+		}
+	}
+}
+
+// updateEvaluationStatus writes the evaluation result back to GovernanceEvaluation CRs that reference the policy.
+func updateEvaluationStatus(policyName string, result *evaluator.EvaluationResult) {
+	if discoverer == nil || policyName == "" || result == nil {
+		return
+	}
+
+	// Populate verified catalog scores from inventory watcher if available
+	if inventoryWatcher != nil {
+		verifiedResources := inventoryWatcher.GetResources()
+		verifiedScores := make([]v1alpha1.VerifiedCatalogScore, 0, len(verifiedResources))
+
+		for _, vr := range verifiedResources {
+			score := v1alpha1.VerifiedCatalogScore{
+				CatalogName:     vr.CatalogName,
+				Namespace:       vr.Namespace,
+				ResourceVersion: vr.ResourceVersion,
+				Status:          vr.VerifiedScore.Status,
+				CompositeScore:  vr.VerifiedScore.Score,
+				SecurityScore:   vr.VerifiedScore.SecurityScore,
+				TrustScore:      vr.VerifiedScore.TrustScore,
+				ComplianceScore: vr.VerifiedScore.ComplianceScore,
+			}
+
+			// Convert VerifiedCheck to CatalogScoringCheck
+			for _, check := range vr.VerifiedScore.Checks {
+				score.Checks = append(score.Checks, v1alpha1.CatalogScoringCheck{
+					ID:        check.ID,
+					Name:      check.Name,
+					Points:    check.Score,
+					MaxPoints: check.MaxScore,
+				})
+			}
+
+			// Set LastScored timestamp
+			if !vr.LastScored.IsZero() {
+				metaTime := metav1.NewTime(vr.LastScored)
+				score.LastScored = &metaTime
+			}
+
+			verifiedScores = append(verifiedScores, score)
+		}
+
+		result.VerifiedCatalogScores = verifiedScores
+	}
+
+	if err := discoverer.UpdateEvaluationStatus(context.Background(), policyName, result); err != nil {
+		log.Printf("[governance] WARNING: Failed to update evaluation status: %v", err)
+	}
+}
+
+// ---------- AI Agent ----------
+
+// initAIAgent initializes the AI governance agent
+func initAIAgent(ctx context.Context) {
+	stateMu.RLock()
+	p := policy
+	stateMu.RUnlock()
+
+	provider := p.AIProvider
+	if provider == "" {
+		provider = "gemini"
+	}
+
+	if !aiagent.IsAvailable(provider) {
+		log.Printf("[ai-agent] Provider %q not available — AI agent disabled", provider)
+		aiAgentErr = fmt.Errorf("AI agent provider %q is not available (check env vars or Ollama endpoint)", provider)
+		return
+	}
+
+	config := aiagent.AIAgentConfig{
+		Provider:       provider,
+		Model:          p.AIModel,
+		OllamaEndpoint: p.OllamaEndpoint,
+	}
+
+	var err error
+	aiAgent, err = aiagent.NewGovernanceAgent(ctx, config)
+	if err != nil {
+		log.Printf("[ai-agent] Failed to initialize AI agent: %v", err)
+		aiAgentErr = err
+		return
+	}
+
+	log.Printf("[ai-agent] AI Governance Agent initialized successfully (provider=%s)", provider)
+
+	// Parse scan interval from policy
+	if p.AIScanInterval != "" {
+		if d, err := time.ParseDuration(p.AIScanInterval); err == nil && d >= 1*time.Minute {
+			aiMinInterval = d
+			log.Printf("[ai-agent] Scan interval set to %v", d)
+		} else {
+			log.Printf("[ai-agent] Invalid scanInterval %q, using default %v", p.AIScanInterval, aiMinInterval)
+		}
+	}
+
+	// Respect scanEnabled from policy
+	stateMu.Lock()
+	aiScanPaused = !p.AIScanEnabled
+	stateMu.Unlock()
+	if aiScanPaused {
+		log.Printf("[ai-agent] Periodic scanning is disabled (scanEnabled=false)")
+	}
+
+	// Run initial AI evaluation (always runs once regardless of pause)
+	stateMu.RLock()
+	cs := currentState
+	pol := policy
+	res := lastResult
+	stateMu.RUnlock()
+
+	if cs != nil && res != nil {
+		forceRunAIEvaluation(ctx, cs, pol, res)
+	}
+}
+
+// runAIEvaluation runs the AI agent with rate-limiting and pause checks
+func runAIEvaluation(ctx context.Context, state *evaluator.ClusterState, p evaluator.Policy, result *evaluator.EvaluationResult) {
+	if aiAgent == nil {
+		return
+	}
+
+	// Check if scanning is paused
+	stateMu.RLock()
+	paused := aiScanPaused
+	lastRun := aiLastRun
+	backoff := aiBackoff
+	stateMu.RUnlock()
+
+	if paused {
+		return // scanning is paused
+	}
+
+	// Rate-limit AI evaluations to avoid burning through API quotas
+	interval := aiMinInterval
+	if backoff > interval {
+		interval = backoff
+	}
+	if time.Since(lastRun) < interval {
+		return // too soon, skip this cycle
+	}
+
+	forceRunAIEvaluation(ctx, state, p, result)
+}
+
+// forceRunAIEvaluation runs the AI agent immediately, bypassing rate-limit and pause checks.
+// Used for initial evaluation and manual refresh via API.
+func forceRunAIEvaluation(ctx context.Context, state *evaluator.ClusterState, p evaluator.Policy, result *evaluator.EvaluationResult) {
+	if aiAgent == nil {
+		return
+	}
+
+	log.Printf("[ai-agent] Running AI governance evaluation...")
+
+	stateMu.Lock()
+	aiLastRun = time.Now()
+	stateMu.Unlock()
+
+	aiResult, err := aiAgent.Evaluate(ctx, state, p, result)
+	if err != nil {
+		log.Printf("[ai-agent] AI evaluation failed: %v", err)
+		stateMu.Lock()
+		aiAgentErr = err
+		// Exponential backoff: 5m → 10m → 20m, capped at 30m
+		if aiBackoff == 0 {
+			aiBackoff = aiMinInterval
+		} else {
+			aiBackoff *= 2
+			if aiBackoff > 30*time.Minute {
+				aiBackoff = 30 * time.Minute
+			}
+		}
+		log.Printf("[ai-agent] Next retry in %v", aiBackoff)
+		stateMu.Unlock()
+		return
+	}
+
+	stateMu.Lock()
+	lastAIResult = aiResult
+	aiAgentErr = nil
+	aiBackoff = 0 // reset backoff on success
+	stateMu.Unlock()
+
+	log.Printf("[ai-agent] AI evaluation complete. AI Score: %d (Grade: %s) vs Algorithmic Score: %d",
+		aiResult.Score, aiResult.Grade, result.Score)
+}
+
+// handleAIScore returns the AI agent's governance assessment
+func handleAIScore(w http.ResponseWriter, r *http.Request) {
+	snap := getSnapshot()
+
+	stateMu.RLock()
+	aiResult := lastAIResult
+	aiErr := aiAgentErr
+	paused := aiScanPaused
+	stateMu.RUnlock()
+
+	scanConfig := map[string]interface{}{
+		"scanInterval": aiMinInterval.String(),
+		"scanPaused":   paused,
+	}
+
+	if !snap.policy.EnableAIAgent {
+		jsonResponse(w, map[string]interface{}{
+			"enabled":    false,
+			"scanConfig": scanConfig,
+			"message":    "AI agent scoring is not enabled in the governance policy. Set enableAIAgent: true in MCPGovernancePolicy spec.",
+		})
+		return
+	}
+
+	if aiResult == nil {
+		errMsg := "AI evaluation has not completed yet"
+		if aiErr != nil {
+			errMsg = fmt.Sprintf("AI agent error: %v", aiErr)
+		}
+		jsonResponse(w, map[string]interface{}{
+			"enabled":    true,
+			"available":  false,
+			"scanConfig": scanConfig,
+			"message":    errMsg,
+		})
+		return
+	}
+
+	// Include comparison with algorithmic score
+	algorithmicScore := 0
+	algorithmicGrade := "F"
+	if snap.result != nil {
+		algorithmicScore = snap.result.Score
+		algorithmicGrade = getGrade(snap.result.Score)
+	}
+
+	jsonResponse(w, map[string]interface{}{
+		"enabled":    true,
+		"available":  true,
+		"scanConfig": scanConfig,
+		"aiScore":    aiResult,
+		"comparison": map[string]interface{}{
+			"algorithmicScore": algorithmicScore,
+			"algorithmicGrade": algorithmicGrade,
+			"aiScore":          aiResult.Score,
+			"aiGrade":          aiResult.Grade,
+			"scoreDifference":  aiResult.Score - algorithmicScore,
+		},
+	})
+}
+
+// handleAIRefresh triggers an immediate AI evaluation (bypasses rate-limit and pause)
+func handleAIRefresh(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if aiAgent == nil {
+		jsonResponse(w, map[string]interface{}{
+			"success": false,
+			"message": "AI agent is not initialized",
+		})
+		return
+	}
+
+	snap := getSnapshot()
+	if snap.cluster == nil || snap.result == nil {
+		jsonResponse(w, map[string]interface{}{
+			"success": false,
+			"message": "Cluster state not yet available, please wait for initial scan",
+		})
+		return
+	}
+
+	go forceRunAIEvaluation(context.Background(), snap.cluster, snap.policy, snap.result)
+
+	jsonResponse(w, map[string]interface{}{
+		"success": true,
+		"message": "AI evaluation triggered. Results will be available shortly.",
+	})
+}
+
+// handleAIToggle toggles the periodic AI scanning on/off
+func handleAIToggle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	stateMu.Lock()
+	aiScanPaused = !aiScanPaused
+	paused := aiScanPaused
+	stateMu.Unlock()
+
+	status := "resumed"
+	if paused {
+		status = "paused"
+	}
+	log.Printf("[ai-agent] Periodic scanning %s via API", status)
+
+	jsonResponse(w, map[string]interface{}{
+		"success":    true,
+		"scanPaused": paused,
+		"message":    fmt.Sprintf("AI periodic scanning %s", status),
+	})
+}
+
+// ---------- MCP Server Endpoints ----------
+
+// doPeriodicScan runs a full governance scan and updates all state
+func doPeriodicScan() {
+	cs := doDiscovery()
+	p := loadPolicy()
+	verifyImages(cs, p)
+	probeMCPServers(cs, p)
+	probeAuthBypass(cs, p)
+	res := evaluator.Evaluate(cs.FilterByNamespaces(p.TargetNamespaces, p.ExcludeNamespaces), p)
+
+	stateMu.Lock()
+	currentState = cs
+	policy = p
+	lastCluster = cs
+	lastResult = res
+	lastScanTime = time.Now()
+	stateMu.Unlock()
+
+	recordTrendPoint(res)
+	findingStore.Record(res)
+	updatePolicyStatus(p, res)
+	updateEvaluationStatus(p.Name, res)
+	log.Printf("[governance] Scan complete. Score: %d, Findings: %d, MCP Servers: %d", res.Score, len(res.Findings), len(res.MCPServerViews))
+
+	// Run AI agent evaluation if enabled
+	if p.EnableAIAgent {
+		runAIEvaluation(context.Background(), cs, p, res)
+	}
+}
+
+// startPollingLoop starts a traditional ticker-based scan loop.
+// Used as fallback when the resource watcher cannot be created.
+func startPollingLoop() {
+	go func() {
+		ticker := time.NewTicker(scanInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			doPeriodicScan()
+		}
+	}()
+}
+
+// handleRefreshScan triggers an on-demand governance scan (POST only)
+func handleRefreshScan(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	log.Printf("[governance] On-demand scan triggered via API")
+	doPeriodicScan()
+
+	stateMu.RLock()
+	result := lastResult
+	scanTime := lastScanTime
+	stateMu.RUnlock()
+
+	jsonResponse(w, map[string]interface{}{
+		"status":       "completed",
+		"score":        result.Score,
+		"findings":     len(result.Findings),
+		"mcpServers":   len(result.MCPServerViews),
+		"lastScanTime": scanTime.Format(time.RFC3339),
+	})
+}
+
+// handleScanStatus returns the current scan status and interval
+func handleScanStatus(w http.ResponseWriter, r *http.Request) {
+	stateMu.RLock()
+	scanTime := lastScanTime
+	interval := scanInterval
+	p := policy
+	mode := scanMode
+	stateMu.RUnlock()
+
+	resp := map[string]interface{}{
+		"lastScanTime":     scanTime.Format(time.RFC3339),
+		"scanInterval":     interval.String(),
+		"scanIntervalSpec": p.ScanInterval,
+		"scanMode":         mode,
+	}
+
+	if mode == "watch" {
+		resp["description"] = "Reconcile-on-change mode: the controller watches Kubernetes resources and re-evaluates governance scores within seconds of any change."
+		if resourceWatcher != nil {
+			stats := resourceWatcher.Stats()
+			resp["watcher"] = stats
+		}
+	} else {
+		nextScan := scanTime.Add(interval)
+		resp["nextScanTime"] = nextScan.Format(time.RFC3339)
+		resp["description"] = "Polling mode: the controller periodically scans the cluster at a fixed interval."
+	}
+
+	jsonResponse(w, resp)
+}
+
+// handleMCPServers returns all MCP server views with their scores and related resources
+func handleMCPServers(w http.ResponseWriter, r *http.Request) {
+	snap := getSnapshot()
+	if snap.result == nil {
+		jsonResponse(w, map[string]interface{}{
+			"servers": []evaluator.MCPServerView{},
+			"summary": evaluator.MCPServerSummary{},
+		})
+		return
+	}
+	jsonResponse(w, map[string]interface{}{
+		"servers": snap.result.MCPServerViews,
+		"summary": snap.result.MCPServerSummary,
+	})
+}
+
+// handleMCPServerSummary returns only the cluster-level MCP server summary
+func handleMCPServerSummary(w http.ResponseWriter, r *http.Request) {
+	snap := getSnapshot()
+	if snap.result == nil {
+		jsonResponse(w, evaluator.MCPServerSummary{})
+		return
+	}
+	jsonResponse(w, snap.result.MCPServerSummary)
+}
+
+// handleMCPServerDetail returns detailed info for a single MCP server by ID
+func handleMCPServerDetail(w http.ResponseWriter, r *http.Request) {
+	snap := getSnapshot()
+	if snap.result == nil {
+		http.Error(w, "No evaluation available", http.StatusServiceUnavailable)
+		return
+	}
+
+	serverID := r.URL.Query().Get("id")
+	if serverID == "" {
+		http.Error(w, "Missing 'id' query parameter", http.StatusBadRequest)
+		return
+	}
+
+	for _, view := range snap.result.MCPServerViews {
+		if view.ID == serverID {
+			jsonResponse(w, view)
+			return
+		}
+	}
+
+	http.Error(w, "MCP server not found", http.StatusNotFound)
+}
+
+// handleAgents returns all agent-centric views
+func handleAgents(w http.ResponseWriter, r *http.Request) {
+	snap := getSnapshot()
+	if snap.result == nil {
+		jsonResponse(w, map[string]interface{}{
+			"agents": []evaluator.AgentView{},
+		})
+		return
+	}
+	jsonResponse(w, map[string]interface{}{
+		"agents": snap.result.AgentViews,
+	})
+}
+
+// handleAgentDetail returns the view of a single agent by ID (Agent/ns/name)
+func handleAgentDetail(w http.ResponseWriter, r *http.Request) {
+	snap := getSnapshot()
+	if snap.result == nil {
+		http.Error(w, "No evaluation available", http.StatusServiceUnavailable)
+		return
+	}
+
+	agentID := r.URL.Query().Get("id")
+	if agentID == "" {
+		http.Error(w, "Missing 'id' query parameter", http.StatusBadRequest)
+		return
+	}
+
+	for _, view := range snap.result.AgentViews {
+		if view.ID == agentID {
+			jsonResponse(w, view)
+			return
+		}
+	}
+
+	http.Error(w, "Agent not found", http.StatusNotFound)
+}
+
+// handleEffectivePolicy returns the effective policy for ?namespace=<ns> after
+// merging the cluster baseline with any namespace-scoped MCPGovernancePolicies.
+// Without a namespace it returns the baseline and the namespaces that have overrides.
+func handleEffectivePolicy(w http.ResponseWriter, r *http.Request) {
+	snap := getSnapshot()
+	ns := r.URL.Query().Get("namespace")
+
+	overridden := make([]string, 0, len(snap.policy.NamespacePolicies))
+	for n := range snap.policy.NamespacePolicies {
+		overridden = append(overridden, n)
+	}
+	sort.Strings(overridden)
+
+	eff := snap.policy.ForNamespace(ns)
+	jsonResponse(w, map[string]interface{}{
+		"namespace":            ns,
+		"scoped":               ns != "" && len(eff.SourcePolicies) > len(snap.policy.SourcePolicies),
+		"sourcePolicies":       eff.SourcePolicies,
+		"overriddenNamespaces": overridden,
+		"policy":               effectivePolicyJSON(eff),
+	})
+}
+
+// effectivePolicyJSON renders the merge-relevant fields of a policy.
+func effectivePolicyJSON(p evaluator.Policy) map[string]interface{} {
+	checks := map[string]interface{}{}
+	for id, c := range p.Checks {
+		checks[id] = map[string]interface{}{"enabled": !c.Disabled, "severity": c.Severity}
+	}
+	overrides := map[string]interface{}{}
+	for prefix, o := range p.CheckOverrides {
+		entry := map[string]interface{}{"enabled": !o.Disabled, "severity": o.Severity}
+		if o.Penalty != nil {
+			entry["penalty"] = *o.Penalty
+		}
+		overrides[prefix] = entry
+	}
+	rules := make([]string, 0, len(p.CustomRules))
+	for _, r := range p.CustomRules {
+		rules = append(rules, r.ID)
+	}
+	return map[string]interface{}{
+		"requireAgentGateway":       p.RequireAgentGateway,
+		"requireCORS":               p.RequireCORS,
+		"requireJWTAuth":            p.RequireJWTAuth,
+		"requireRBAC":               p.RequireRBAC,
+		"requirePromptGuard":        p.RequirePromptGuard,
+		"requireTLS":                p.RequireTLS,
+		"requireRateLimit":          p.RequireRateLimit,
+		"requireHardenedDeployment": p.RequireHardenedDeployment,
+		"requireWorkloadRBAC":       p.RequireWorkloadRBAC,
+		"maxToolsWarning":           p.MaxToolsWarning,
+		"maxToolsCritical":          p.MaxToolsCritical,
+		"certExpiryWarningDays":     p.CertExpiryWarningDays,
+		"requiredJWTClaims":         p.RequiredJWTClaims,
+		"allowedCORSOrigins":        p.AllowedCORSOrigins,
+		"maxRateLimitRPS":           p.MaxRateLimitRPS,
+		"requiredPromptGuardBuiltins": p.RequiredPromptGuardBuiltins,
+		"severityPenalties": map[string]int{
+			"critical": p.SeverityPenalties.Critical,
+			"high":     p.SeverityPenalties.High,
+			"medium":   p.SeverityPenalties.Medium,
+			"low":      p.SeverityPenalties.Low,
+		},
+		"checks":         checks,
+		"checkOverrides": overrides,
+		"customRules":    rules,
+		"toolSensitivity": map[string]interface{}{
+			"patterns":               p.ToolSensitivity.Patterns,
+			"penalties":              p.ToolSensitivity.Penalties,
+			"unrestrictedMultiplier": p.ToolSensitivity.UnrestrictedMultiplier,
+		},
+		"imageVerification": map[string]interface{}{
+			"enabled":             p.ImageVerification.Enabled,
+			"publicKeyCount":      len(p.ImageVerification.PublicKeys),
+			"publicKeysConfigMap": p.ImageVerification.PublicKeysConfigMap,
+			"allowedRegistries":   p.ImageVerification.AllowedRegistries,
+			"registryMirror":      p.ImageVerification.RegistryMirror,
+			"insecureRegistry":    p.ImageVerification.InsecureRegistry,
+		},
+		"mcpProbe": map[string]interface{}{
+			"enabled":           p.MCPProbe.Enabled,
+			"allowedNamespaces": p.MCPProbe.AllowedNamespaces,
+			"concurrency":       p.MCPProbe.Concurrency,
+			"timeoutSeconds":    p.MCPProbe.TimeoutSeconds,
+			"authBypass":        p.MCPProbe.AuthBypass,
+		},
+		"vulnerabilities": map[string]interface{}{
+			"enabled":       p.Vulnerabilities.Enabled,
+			"maxCritical":   p.Vulnerabilities.MaxCritical,
+			"maxHigh":       p.Vulnerabilities.MaxHigh,
+			"ignoreUnfixed": p.Vulnerabilities.IgnoreUnfixed,
+		},
+	}
+}
+
+// handleSimulate runs a what-if evaluation (POST only). The body is a full or
+// partial MCPGovernancePolicySpec, optionally wrapped in {"spec": {...}}; it is
+// overlaid on the current baseline policy and evaluated against the cached
+// cluster state. Nothing is persisted: the last result, trends and CR status
+// are left untouched.
+func handleSimulate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var body map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid policy spec: %v", err), http.StatusBadRequest)
+		return
+	}
+	spec := body
+	if inner, ok := body["spec"].(map[string]interface{}); ok {
+		spec = inner
+	}
+	spec, _ = normalizeJSONNumbers(spec).(map[string]interface{})
+
+	stateMu.RLock()
+	cs := currentState
+	stateMu.RUnlock()
+	snap := getSnapshot()
+	if cs == nil {
+		http.Error(w, "No cluster state available yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	base := snap.policy
+	base.NamespacePolicies = nil
+	discovery.ApplyPolicySpec(&base, spec)
+	simPolicy := evaluator.ResolvePolicies(append([]evaluator.Policy{base}, snap.policy.ScopedPolicies...))
+	simPolicy.EnableAuditLogging = false
+
+	projected := evaluator.Evaluate(cs.FilterByNamespaces(simPolicy.TargetNamespaces, simPolicy.ExcludeNamespaces), simPolicy)
+	diff := evaluator.DiffResults(snap.result, projected)
+
+	jsonResponse(w, map[string]interface{}{
+		"currentScore":       diff.PreviousScore,
+		"projectedScore":     diff.Score,
+		"scoreDelta":         diff.ScoreDelta,
+		"currentGrade":       getGrade(diff.PreviousScore),
+		"projectedGrade":     getGrade(diff.Score),
+		"projectedBreakdown": projected.ScoreBreakdown,
+		"projectedFindings":  len(projected.Findings),
+		"mcpServers":         diff.ServerDeltas,
+		"findingsAdded":      diff.FindingsAdded,
+		"findingsRemoved":    diff.FindingsRemoved,
+		"policy":             effectivePolicyJSON(simPolicy),
+	})
+}
+
+// handleAuthzSimulate answers "can this principal call tool Y on server Z?"
+// by evaluating the authorization rules of the AgentgatewayPolicies related
+// to the server. A body with "principals" returns a principal × tool matrix
+// instead of a single decision.
+func handleAuthzSimulate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var body struct {
+		evaluator.AuthzSimulationRequest
+		Principals []evaluator.AuthzPrincipal `json:"principals"`
+		Tools      []string                   `json:"tools"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid request: %v", err), http.StatusBadRequest)
+		return
+	}
+	if body.ServerID == "" {
+		http.Error(w, "Missing 'serverId'", http.StatusBadRequest)
+		return
+	}
+
+	snap := getSnapshot()
+	if snap.result == nil || snap.cluster == nil {
+		http.Error(w, "No evaluation available", http.StatusServiceUnavailable)
+		return
+	}
+
+	var (
+		resp interface{}
+		err  error
+	)
+	if len(body.Principals) > 0 {
+		resp, err = evaluator.SimulateAuthorizationMatrix(snap.cluster, snap.result.MCPServerViews, evaluator.AuthzMatrixRequest{
+			ServerID:   body.ServerID,
+			Principals: body.Principals,
+			Tools:      body.Tools,
+		})
+	} else {
+		resp, err = evaluator.SimulateAuthorization(snap.cluster, snap.result.MCPServerViews, body.AuthzSimulationRequest)
+	}
+	if err != nil {
+		status := http.StatusBadRequest
+		if strings.Contains(err.Error(), "not found") {
+			status = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+	jsonResponse(w, resp)
+}
+
+// normalizeJSONNumbers converts integral float64 values decoded from JSON into
+// int64 so the spec matches what the dynamic client returns for CRs.
+func normalizeJSONNumbers(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, val := range t {
+			t[k] = normalizeJSONNumbers(val)
+		}
+	case []interface{}:
+		for i, val := range t {
+			t[i] = normalizeJSONNumbers(val)
+		}
+	case float64:
+		if t == float64(int64(t)) {
+			return int64(t)
+		}
+	}
+	return v
+}
+
+// complianceFrameworks returns the frameworks of the mapping ConfigMap, or the
+// built-in ones when no mapping loader is configured.
+func complianceFrameworks() map[string]compliance.Framework {
+	if complianceMappings != nil {
+		return complianceMappings.Get()
+	}
+	return compliance.DefaultFrameworks()
+}
+
+// handleCompliance returns a compliance report for ?framework=<id>
+// (owasp-mcp, nist-800-53, soc2 or any framework added via the ConfigMap).
+// Without a framework it returns the summary of every known framework.
+func handleCompliance(w http.ResponseWriter, r *http.Request) {
+	snap := getSnapshot()
+	frameworks := complianceFrameworks()
+
+	id := r.URL.Query().Get("framework")
+	if id == "" {
+		summaries := make([]map[string]interface{}, 0, len(frameworks))
+		for _, fid := range compliance.FrameworkIDs(frameworks) {
+			rep := compliance.BuildReport(frameworks[fid], snap.result, snap.cluster)
+			summaries = append(summaries, map[string]interface{}{
+				"framework": rep.Framework,
+				"name":      rep.Name,
+				"version":   rep.Version,
+				"summary":   rep.Summary,
+			})
+		}
+		jsonResponse(w, map[string]interface{}{"frameworks": summaries})
+		return
+	}
+
+	fw, ok := frameworks[id]
+	if !ok {
+		http.Error(w, fmt.Sprintf("Unknown framework %q", id), http.StatusNotFound)
+		return
+	}
+	jsonResponse(w, compliance.BuildReport(fw, snap.result, snap.cluster))
+}
+
+// handleGraph returns the attack-path graph: Gateway → HTTPRoute →
+// AgentgatewayBackend → MCP target → MCP server → tools, plus the agent → MCP
+// server edges and the subset of those that skip the gateway.
+func handleGraph(w http.ResponseWriter, r *http.Request) {
+	snap := getSnapshot()
+	if snap.result == nil {
+		http.Error(w, "No evaluation available", http.StatusServiceUnavailable)
+		return
+	}
+	jsonResponse(w, graph.Build(snap.cluster, snap.result))
+}
+
+// handleBlastRadius lists every tool an agent (?agent=ns/name) can reach and
+// the controls on each path.
+func handleBlastRadius(w http.ResponseWriter, r *http.Request) {
+	snap := getSnapshot()
+	if snap.result == nil {
+		http.Error(w, "No evaluation available", http.StatusServiceUnavailable)
+		return
+	}
+
+	agent := r.URL.Query().Get("agent")
+	if strings.Count(agent, "/") != 1 || strings.HasPrefix(agent, "/") || strings.HasSuffix(agent, "/") {
+		http.Error(w, "Missing or invalid 'agent' query parameter (want namespace/name)", http.StatusBadRequest)
+		return
+	}
+	br, ok := graph.Build(snap.cluster, snap.result).BlastRadius(agent)
+	if !ok {
+		http.Error(w, fmt.Sprintf("Agent %q not found", agent), http.StatusNotFound)
+		return
+	}
+	jsonResponse(w, br)
+}
+
+// discoverClusterState is the fallback simulated discovery
+// Used when the controller is running outside a Kubernetes cluster
+func discoverClusterState() *evaluator.ClusterState {
+	state := &evaluator.ClusterState{
+		Namespaces: []string{"default", "agentgateway-system", "kagent", "mcp-apps"},
+
+		// Simulate: agentgateway Gateway exists but may lack policies
+		Gateways: []evaluator.GatewayResource{
+			{
+				Name:             "agentgateway-proxy",
+				Namespace:        "agentgateway-system",
+				GatewayClassName: "agentgateway",
+				Programmed:       true,
+				Listeners: []evaluator.ListenerInfo{
+					{Name: "http", Port: 80, Protocol: "HTTP"},
+				},
+			},
+		},
+
+		// Some MCP backends configured
+		AgentgatewayBackends: []evaluator.AgentgatewayBackendResource{
+			{
+				Name:        "github-mcp-backend",
+				Namespace:   "agentgateway-system",
+				BackendType: "mcp",
+				HasTLS:      false,
+				MCPTargets: []evaluator.MCPTargetInfo{
+					{
+						Name:     "github-mcp",
+						Host:     "mcp-github-server.mcp-apps.svc.cluster.local",
+						Port:     80,
+						Protocol: "StreamableHTTP",
+						HasAuth:  false,
+						HasRBAC:  false,
+					},
+				},
+			},
+			{
+				Name:        "fetch-mcp-backend",
+				Namespace:   "agentgateway-system",
+				BackendType: "mcp",
+				HasTLS:      false,
+				MCPTargets: []evaluator.MCPTargetInfo{
+					{
+						Name:     "fetch-mcp",
+						Host:     "mcp-website-fetcher.default.svc.cluster.local",
+						Port:     80,
+						Protocol: "SSE",
+						HasAuth:  false,
+						HasRBAC:  false,
+					},
+				},
+			},
+			{
+				Name:        "openai-backend",
+				Namespace:   "agentgateway-system",
+				BackendType: "ai",
+				HasTLS:      true,
+			},
+		},
+
+		// Limited policies - missing some security controls
+		AgentgatewayPolicies: []evaluator.AgentgatewayPolicyResource{
+			{
+				Name:      "basic-auth",
+				Namespace: "agentgateway-system",
+				HasJWT:    true,
+				JWTMode:   "Optional", // Intentionally weak for demo
+				HasCORS:   false,
+				HasCSRF:   false,
+				HasRBAC:   false,
+				HasRateLimit: false,
+				HasPromptGuard: false,
+				TargetRefs: []evaluator.PolicyTargetRef{
+					{Group: "gateway.networking.k8s.io", Kind: "Gateway", Name: "agentgateway-proxy"},
+				},
+			},
+		},
+
+		HTTPRoutes: []evaluator.HTTPRouteResource{
+			{
+				Name:          "mcp-github",
+				Namespace:     "agentgateway-system",
+				ParentGateway: "agentgateway-proxy",
+				BackendRefs:   []string{"github-mcp-backend"},
+				HasCORSFilter: false,
+			},
+			{
+				Name:          "mcp-fetcher",
+				Namespace:     "agentgateway-system",
+				ParentGateway: "agentgateway-proxy",
+				BackendRefs:   []string{"fetch-mcp-backend"},
+				HasCORSFilter: false,
+			},
+		},
+
+		// kagent resources
+		KagentAgents: []evaluator.KagentAgentResource{
+			{
+				Name:      "k8s-agent",
+				Namespace: "kagent",
+				Type:      "Declarative",
+				Ready:     true,
+				Tools: []evaluator.KagentToolRef{
+					{Type: "McpServer", Kind: "RemoteMCPServer", Name: "kagent-tool-server", ToolNames: []string{"k8s_get_resources"}},
+				},
+			},
+			{
+				Name:      "fetch-agent",
+				Namespace: "kagent",
+				Type:      "Declarative",
+				Ready:     true,
+				Tools: []evaluator.KagentToolRef{
+					{Type: "McpServer", Kind: "MCPServer", Name: "mcp-website-fetcher", ToolNames: []string{"fetch"}},
+				},
+			},
+		},
+
+		KagentMCPServers: []evaluator.KagentMCPServerResource{
+			{
+				Name:      "mcp-website-fetcher",
+				Namespace: "kagent",
+				Transport: "stdio",
+				Port:      3000,
+			},
+			{
+				Name:      "unrouted-mcp-server",
+				Namespace: "mcp-apps",
+				Transport: "sse",
+				Port:      8080,
+			},
+		},
+
+		KagentRemoteMCPServers: []evaluator.KagentRemoteMCPServerResource{
+			{
+				Name:      "kagent-tool-server",
+				Namespace: "kagent",
+				URL:       "http://kagent-tool-server.kagent.svc:3000",
+			},
+		},
+
+		Services: []evaluator.ServiceResource{
+			{
+				Name:        "mcp-website-fetcher",
+				Namespace:   "default",
+				AppProtocol: "kgateway.dev/mcp",
+				Ports:       []int{80},
+				IsMCP:       true,
+			},
+			{
+				Name:        "standalone-mcp-svc",
+				Namespace:   "mcp-apps",
+				AppProtocol: "kgateway.dev/mcp",
+				Ports:       []int{8080},
+				IsMCP:       true,
+			},
+		},
+	}
+
+	return state
+}
+
+func init() {
+	// Suppress unused import warning
+	_ = fmt.Sprintf
+}
+
+// ---------- Inventory Verified Score Endpoints ----------
+
+// handleInventoryVerified returns all MCPServerCatalog entries with their Verified Scores.
+func handleInventoryVerified(w http.ResponseWriter, r *http.Request) {
+	if inventoryWatcher == nil {
+		jsonResponse(w, map[string]interface{}{
+			"enabled":   false,
+			"message":   "Inventory watcher is not running — no Kubernetes connection or MCPServerCatalog CRD not available",
+			"resources": []interface{}{},
+			"summary":   inventory.VerifiedSummary{},
+		})
+		return
+	}
+
+	resources := inventoryWatcher.GetResources()
+	summary := inventoryWatcher.GetSummary()
+
+	jsonResponse(w, map[string]interface{}{
+		"enabled":   true,
+		"resources": resources,
+		"summary":   summary,
+		"total":     len(resources),
+	})
+}
+
+// handleInventorySummary returns only the cluster-level verified summary.
+func handleInventorySummary(w http.ResponseWriter, r *http.Request) {
+	if inventoryWatcher == nil {
+		jsonResponse(w, map[string]interface{}{
+			"enabled": false,
+			"summary": inventory.VerifiedSummary{},
+		})
+		return
+	}
+
+	summary := inventoryWatcher.GetSummary()
+	jsonResponse(w, map[string]interface{}{
+		"enabled": true,
+		"summary": summary,
+	})
+}
+
+// handleInventoryDetail returns detailed verified score for a single MCPServerCatalog.
+func handleInventoryDetail(w http.ResponseWriter, r *http.Request) {
+	if inventoryWatcher == nil {
+		http.Error(w, "Inventory watcher not running", http.StatusServiceUnavailable)
+		return
+	}
+
+	namespace := r.URL.Query().Get("namespace")
+	name := r.URL.Query().Get("name")
+	if namespace == "" || name == "" {
+		http.Error(w, "Missing 'namespace' and 'name' query parameters", http.StatusBadRequest)
+		return
+	}
+
+	res, found := inventoryWatcher.GetResource(namespace, name)
+	if !found {
+		http.Error(w, fmt.Sprintf("MCPServerCatalog %s/%s not found in verified resources", namespace, name), http.StatusNotFound)
+		return
+	}
+
+	jsonResponse(w, res)
+}
+
+// handleSkillCatalogs returns all SkillCatalog governance scores from the latest evaluation.
+func handleSkillCatalogs(w http.ResponseWriter, r *http.Request) {
+	snap := getSnapshot()
+	if snap.result == nil {
+		jsonResponse(w, map[string]interface{}{
+			"catalogs": []interface{}{},
+			"summary": map[string]interface{}{
+				"total":        0,
+				"passCount":    0,
+				"warningCount": 0,
+				"failCount":    0,
+				"averageScore": 0,
+			},
+		})
+		return
+	}
+
+	catalogs := snap.result.SkillCatalogScores
+	if catalogs == nil {
+		catalogs = []evaluator.SkillCatalogScore{}
+	}
+
+	// Compute summary
+	total := len(catalogs)
+	passCount, warningCount, failCount, scoreSum := 0, 0, 0, 0
+	for _, c := range catalogs {
+		switch c.Status {
+		case "pass":
+			passCount++
+		case "warning":
+			warningCount++
+		case "fail":
+			failCount++
+		}
+		scoreSum += c.Score
+	}
+	avgScore := 0
+	if total > 0 {
+		avgScore = scoreSum / total
+	}
+
+	jsonResponse(w, map[string]interface{}{
+		"catalogs": catalogs,
+		"summary": map[string]interface{}{
+			"total":        total,
+			"passCount":    passCount,
+			"warningCount": warningCount,
+			"failCount":    failCount,
+			"averageScore": avgScore,
+		},
+	})
+}
+
+// handleSkillCatalogScan runs an on-demand security scan for a specific SkillCatalog.
+// POST /api/governance/skill-catalogs/scan
+// Body: { "skillName": "...", "namespace": "...", "repoURL": "...", "token": "..." }
+//
+// This allows the dashboard to trigger a targeted repo content scan with an optional
+// GitHub token for private repositories, without changing the cluster-wide policy.
+func handleSkillCatalogScan(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req struct {
+		SkillName string `json:"skillName"`
+		Namespace string `json:"namespace"`
+		RepoURL   string `json:"repoURL"`
+		Token     string `json:"token"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.SkillName == "" {
+		http.Error(w, `{"error":"skillName is required"}`, http.StatusBadRequest)
+		return
+	}
+
+	// Find the matching SkillCatalog resource from current state
+	stateMu.RLock()
+	cs := currentState
+	p := policy
+	stateMu.RUnlock()
+
+	if cs == nil {
+		http.Error(w, `{"error":"no cluster state available"}`, http.StatusServiceUnavailable)
+		return
+	}
+
+	ns := req.Namespace
+	if ns == "" {
+		ns = "default"
+	}
+
+	var skill *evaluator.SkillCatalogResource
+	for i := range cs.SkillCatalogs {
+		if cs.SkillCatalogs[i].Name == req.SkillName && cs.SkillCatalogs[i].Namespace == ns {
+			skill = &cs.SkillCatalogs[i]
+			break
+		}
+	}
+	if skill == nil {
+		http.Error(w, fmt.Sprintf(`{"error":"SkillCatalog %q not found in namespace %q"}`, req.SkillName, ns), http.StatusNotFound)
+		return
+	}
+
+	// Override repoURL if provided
+	scanSkill := *skill
+	if req.RepoURL != "" {
+		scanSkill.RepoURL = req.RepoURL
+		scanSkill.RepoSource = "github"
+	}
+
+	// Build a one-shot policy with scanRepoContent=true and the supplied token
+	scanPolicy := p
+	scanPolicy.SkillGovernance.ScanRepoContent = true
+	if req.Token != "" {
+		scanPolicy.SkillGovernance.GitHubToken = req.Token
+	}
+
+	// Load patterns
+	patternLoader := skillscanner.NewPatternLoader(p.SkillGovernance.PatternMountPath)
+	ps := patternLoader.Get()
+
+	// Run metadata checks
+	ref := fmt.Sprintf("SkillCatalog/%s/%s", scanSkill.Namespace, scanSkill.Name)
+	metaFindings := evaluator.CheckSkillMetadataExported(scanSkill, ref)
+
+	// Run security scan
+	contentFindings, scannedFiles := evaluator.ScanSkillRepoExported(scanSkill, scanPolicy, ps)
+
+	// Build score
+	score := evaluator.ScoreSkillCatalogExported(scanSkill, metaFindings, contentFindings, scanPolicy)
+	score.ScannedFiles = scannedFiles
+	score.SecurityScanned = true
+
+	jsonResponse(w, map[string]interface{}{
+		"skill":        score,
+		"scannedFiles": scannedFiles,
+		"status":       "completed",
+	})
+}
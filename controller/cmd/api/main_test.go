@@ -0,0 +1,1238 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/evaluator"
+)
+
+// ────────────────────────────────────────────────────────────────────────────
+// Test Setup Helpers
+// ────────────────────────────────────────────────────────────────────────────
+
+func setupTestState(result *evaluator.EvaluationResult, cluster *evaluator.ClusterState, p evaluator.Policy) {
+	stateMu.Lock()
+	defer stateMu.Unlock()
+	lastResult = result
+	lastCluster = cluster
+	currentState = cluster
+	policy = p
+}
+
+func setupNilState() {
+	stateMu.Lock()
+	defer stateMu.Unlock()
+	lastResult = nil
+	lastCluster = nil
+	currentState = nil
+	policy = evaluator.DefaultPolicy()
+}
+
+func sampleResult() *evaluator.EvaluationResult {
+	return &evaluator.EvaluationResult{
+		Score:     72,
+		Timestamp: time.Date(2026, 2, 11, 12, 0, 0, 0, time.UTC),
+		Findings: []evaluator.Finding{
+			{ID: "AGW-001", Severity: "Critical", Category: "AgentGateway", Title: "No gateway"},
+			{ID: "AUTH-002", Severity: "Critical", Category: "Authentication", Title: "No JWT"},
+			{ID: "CORS-001", Severity: "Medium", Category: "CORS", Title: "No CORS"},
+			{ID: "TLS-001-b1", Severity: "High", Category: "TLS", Title: "No TLS on b1", ResourceRef: "AgentgatewayBackend/system/b1", Namespace: "system"},
+		},
+		ScoreBreakdown: evaluator.ScoreBreakdown{
+			AgentGatewayScore:   0,
+			AuthenticationScore: 0,
+			AuthorizationScore:  100,
+			CORSScore:           85,
+			TLSScore:            75,
+			PromptGuardScore:    0,
+			RateLimitScore:      0,
+			InfraAbsent: map[string]bool{
+				"AgentGateway Compliance": true,
+				"Authentication":          true,
+			},
+		},
+		ResourceSummary: evaluator.ResourceSummary{
+			GatewaysFound:          1,
+			AgentgatewayBackends:   2,
+			AgentgatewayPolicies:   1,
+			KagentAgents:           3,
+			KagentRemoteMCPServers: 2,
+			TotalMCPEndpoints:      4,
+		},
+		NamespaceScores: []evaluator.NamespaceScore{
+			{Namespace: "default", Score: 100, Findings: 0},
+			{Namespace: "system", Score: 75, Findings: 1},
+		},
+	}
+}
+
+func sampleCluster() *evaluator.ClusterState {
+	return &evaluator.ClusterState{
+		Namespaces: []string{"default", "system"},
+		Gateways: []evaluator.GatewayResource{
+			{Name: "gw", Namespace: "system", GatewayClassName: "agentgateway"},
+		},
+		AgentgatewayBackends: []evaluator.AgentgatewayBackendResource{
+			{Name: "b1", Namespace: "system", BackendType: "mcp"},
+		},
+		AgentgatewayPolicies: []evaluator.AgentgatewayPolicyResource{
+			{Name: "p1", Namespace: "system"},
+		},
+		KagentAgents: []evaluator.KagentAgentResource{
+			{Name: "a1", Namespace: "default"},
+		},
+		KagentRemoteMCPServers: []evaluator.KagentRemoteMCPServerResource{
+			{Name: "r1", Namespace: "default"},
+		},
+	}
+}
+
+// ────────────────────────────────────────────────────────────────────────────
+// Grade & Phase Helpers
+// ────────────────────────────────────────────────────────────────────────────
+
+func TestGetGrade(t *testing.T) {
+	tests := []struct {
+		score int
+		want  string
+	}{
+		{100, "A"},
+		{95, "A"},
+		{90, "A"},
+		{89, "B"},
+		{70, "B"},
+		{69, "C"},
+		{50, "C"},
+		{49, "D"},
+		{30, "D"},
+		{29, "F"},
+		{0, "F"},
+	}
+	for _, tt := range tests {
+		got := getGrade(tt.score)
+		if got != tt.want {
+			t.Errorf("getGrade(%d) = %q, want %q", tt.score, got, tt.want)
+		}
+	}
+}
+
+func TestGetPhase(t *testing.T) {
+	tests := []struct {
+		score int
+		want  string
+	}{
+		{100, "Compliant"},
+		{90, "Compliant"},
+		{89, "PartiallyCompliant"},
+		{70, "PartiallyCompliant"},
+		{69, "NonCompliant"},
+		{50, "NonCompliant"},
+		{49, "Critical"},
+		{0, "Critical"},
+	}
+	for _, tt := range tests {
+		got := getPhase(tt.score)
+		if got != tt.want {
+			t.Errorf("getPhase(%d) = %q, want %q", tt.score, got, tt.want)
+		}
+	}
+}
+
+func TestStatusLabel(t *testing.T) {
+	tests := []struct {
+		score int
+		want  string
+	}{
+		{100, "passing"},
+		{90, "passing"},
+		{89, "warning"},
+		{70, "warning"},
+		{69, "failing"},
+		{50, "failing"},
+		{49, "critical"},
+		{0, "critical"},
+	}
+	for _, tt := range tests {
+		got := statusLabel(tt.score)
+		if got != tt.want {
+			t.Errorf("statusLabel(%d) = %q, want %q", tt.score, got, tt.want)
+		}
+	}
+}
+
+// ────────────────────────────────────────────────────────────────────────────
+// Health Endpoint
+// ────────────────────────────────────────────────────────────────────────────
+
+func TestHandleHealth(t *testing.T) {
+	req := httptest.NewRequest("GET", "/api/health", nil)
+	w := httptest.NewRecorder()
+
+	handleHealth(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("status = %d, want 200", w.Code)
+	}
+
+	var body map[string]string
+	json.NewDecoder(w.Body).Decode(&body)
+
+	if body["status"] != "healthy" {
+		t.Errorf("status = %q, want 'healthy'", body["status"])
+	}
+	if body["version"] == "" {
+		t.Error("version should not be empty")
+	}
+}
+
+// ────────────────────────────────────────────────────────────────────────────
+// Score Endpoint
+// ────────────────────────────────────────────────────────────────────────────
+
+func TestHandleScore_NilResult(t *testing.T) {
+	setupNilState()
+	req := httptest.NewRequest("GET", "/api/governance/score", nil)
+	w := httptest.NewRecorder()
+
+	handleScore(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("status = %d, want 200", w.Code)
+	}
+
+	var body map[string]interface{}
+	json.NewDecoder(w.Body).Decode(&body)
+
+	if body["score"].(float64) != 0 {
+		t.Errorf("score = %v, want 0", body["score"])
+	}
+	if body["grade"] != "F" {
+		t.Errorf("grade = %v, want F", body["grade"])
+	}
+}
+
+func TestHandleScore_WithResult(t *testing.T) {
+	setupTestState(sampleResult(), sampleCluster(), evaluator.DefaultPolicy())
+	req := httptest.NewRequest("GET", "/api/governance/score", nil)
+	w := httptest.NewRecorder()
+
+	handleScore(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("status = %d, want 200", w.Code)
+	}
+
+	var body map[string]interface{}
+	json.NewDecoder(w.Body).Decode(&body)
+
+	if body["score"].(float64) != 72 {
+		t.Errorf("score = %v, want 72", body["score"])
+	}
+	if body["grade"] != "B" {
+		t.Errorf("grade = %v, want B", body["grade"])
+	}
+	if body["phase"] != "PartiallyCompliant" {
+		t.Errorf("phase = %v, want PartiallyCompliant", body["phase"])
+	}
+
+	cats, ok := body["categories"].([]interface{})
+	if !ok || len(cats) == 0 {
+		t.Error("categories should be a non-empty array")
+	}
+
+	if body["explanation"] == nil || body["explanation"] == "" {
+		t.Error("explanation should be present")
+	}
+}
+
+// ────────────────────────────────────────────────────────────────────────────
+// Findings Endpoint
+// ────────────────────────────────────────────────────────────────────────────
+
+func TestHandleFindings_NilResult(t *testing.T) {
+	setupNilState()
+	req := httptest.NewRequest("GET", "/api/governance/findings", nil)
+	w := httptest.NewRecorder()
+
+	handleFindings(w, req)
+
+	var body map[string]interface{}
+	json.NewDecoder(w.Body).Decode(&body)
+
+	if body["total"].(float64) != 0 {
+		t.Errorf("total = %v, want 0", body["total"])
+	}
+}
+
+func TestHandleFindings_WithResult(t *testing.T) {
+	setupTestState(sampleResult(), sampleCluster(), evaluator.DefaultPolicy())
+	req := httptest.NewRequest("GET", "/api/governance/findings", nil)
+	w := httptest.NewRecorder()
+
+	handleFindings(w, req)
+
+	var body map[string]interface{}
+	json.NewDecoder(w.Body).Decode(&body)
+
+	total := int(body["total"].(float64))
+	if total != 4 {
+		t.Errorf("total = %d, want 4", total)
+	}
+
+	findings := body["findings"].([]interface{})
+	if len(findings) != 4 {
+		t.Errorf("findings len = %d, want 4", len(findings))
+	}
+
+	bySeverity := body["bySeverity"].(map[string]interface{})
+	if int(bySeverity["Critical"].(float64)) != 2 {
+		t.Errorf("Critical count = %v, want 2", bySeverity["Critical"])
+	}
+	if int(bySeverity["High"].(float64)) != 1 {
+		t.Errorf("High count = %v, want 1", bySeverity["High"])
+	}
+	if int(bySeverity["Medium"].(float64)) != 1 {
+		t.Errorf("Medium count = %v, want 1", bySeverity["Medium"])
+	}
+
+	for _, raw := range findings {
+		f := raw.(map[string]interface{})
+		controls, ok := f["controls"].(map[string]interface{})
+		if !ok {
+			t.Errorf("finding %v has no controls map", f["id"])
+		} else if f["id"] == "AUTH-002" && len(controls) == 0 {
+			t.Error("Expected AUTH-002 to map to compliance controls")
+		}
+	}
+}
+
+func TestHandleFindings_Waived(t *testing.T) {
+	result := sampleResult()
+	result.WaivedFindings = []evaluator.WaivedFinding{{
+		Finding: evaluator.Finding{ID: "HDN-003-my-mcp", Severity: "Medium", Category: "Hardening", Namespace: "default"},
+		Waiver: evaluator.FindingWaiver{
+			Exception:     "default/legacy-image",
+			Justification: "vendor image, fix tracked upstream",
+			Approver:      "security-team",
+			ExpiresAt:     "2030-01-01T00:00:00Z",
+		},
+	}}
+	setupTestState(result, sampleCluster(), evaluator.DefaultPolicy())
+	req := httptest.NewRequest("GET", "/api/governance/findings", nil)
+	w := httptest.NewRecorder()
+
+	handleFindings(w, req)
+
+	var body map[string]interface{}
+	json.NewDecoder(w.Body).Decode(&body)
+
+	if int(body["totalWaived"].(float64)) != 1 {
+		t.Fatalf("totalWaived = %v, want 1", body["totalWaived"])
+	}
+	wf := body["waived"].([]interface{})[0].(map[string]interface{})
+	if wf["id"] != "HDN-003-my-mcp" {
+		t.Errorf("waived id = %v", wf["id"])
+	}
+	waiver := wf["waiver"].(map[string]interface{})
+	if waiver["approver"] != "security-team" || waiver["exception"] != "default/legacy-image" {
+		t.Errorf("waiver metadata = %v", waiver)
+	}
+}
+
+func TestHandleFindings_LifecycleFilters(t *testing.T) {
+	orig := findingStore
+	findingStore = evaluator.NewFindingStore(0)
+	defer func() { findingStore = orig }()
+
+	first := sampleResult()
+	findingStore.Record(first)
+	second := sampleResult()
+	second.Timestamp = first.Timestamp.Add(time.Minute)
+	second.Findings = second.Findings[1:]
+	second.Findings = append(second.Findings, evaluator.Finding{
+		ID: "TLS-001-new", Severity: "High", Category: "TLS", ResourceRef: "AgentgatewayBackend/default/new",
+	})
+	findingStore.Record(second)
+	setupTestState(second, sampleCluster(), evaluator.DefaultPolicy())
+
+	get := func(url string) map[string]interface{} {
+		req := httptest.NewRequest("GET", url, nil)
+		w := httptest.NewRecorder()
+		handleFindings(w, req)
+		var body map[string]interface{}
+		json.NewDecoder(w.Body).Decode(&body)
+		return body
+	}
+
+	body := get("/api/governance/findings")
+	if int(body["newSinceLastScan"].(float64)) != 1 || int(body["resolvedSinceLastScan"].(float64)) != 1 {
+		t.Errorf("counts = new %v resolved %v, want 1/1", body["newSinceLastScan"], body["resolvedSinceLastScan"])
+	}
+	f := body["findings"].([]interface{})[0].(map[string]interface{})
+	for _, key := range []string{"fingerprint", "firstSeen", "lastSeen", "occurrences", "status"} {
+		if _, ok := f[key]; !ok {
+			t.Errorf("finding missing lifecycle field %q", key)
+		}
+	}
+
+	body = get("/api/governance/findings?filter=new")
+	newList := body["findings"].([]interface{})
+	if len(newList) != 1 || newList[0].(map[string]interface{})["id"] != "TLS-001-new" {
+		t.Errorf("filter=new = %v", newList)
+	}
+
+	body = get("/api/governance/findings?filter=resolved")
+	resolved := body["findings"].([]interface{})
+	if len(resolved) != 1 || resolved[0].(map[string]interface{})["id"] != first.Findings[0].ID {
+		t.Errorf("filter=resolved = %v", resolved)
+	}
+	if resolved[0].(map[string]interface{})["resolvedAt"] == nil {
+		t.Error("resolved finding should carry resolvedAt")
+	}
+
+	req := httptest.NewRequest("GET", "/api/governance/findings?filter=bogus", nil)
+	w := httptest.NewRecorder()
+	handleFindings(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("invalid filter status = %d, want 400", w.Code)
+	}
+}
+
+func TestHandleEffectivePolicy(t *testing.T) {
+	base := evaluator.DefaultPolicy()
+	base.Name = "baseline"
+	base.RequireRateLimit = false
+	p := evaluator.ResolvePolicies([]evaluator.Policy{
+		base,
+		{Name: "team-a", ScopeNamespaces: []string{"team-a"}, RequireRateLimit: true},
+	})
+	setupTestState(sampleResult(), sampleCluster(), p)
+
+	req := httptest.NewRequest("GET", "/api/governance/policy/effective?namespace=team-a", nil)
+	w := httptest.NewRecorder()
+	handleEffectivePolicy(w, req)
+
+	var body map[string]interface{}
+	json.NewDecoder(w.Body).Decode(&body)
+
+	if body["scoped"] != true {
+		t.Errorf("scoped = %v, want true", body["scoped"])
+	}
+	sources := body["sourcePolicies"].([]interface{})
+	if len(sources) != 2 || sources[1] != "team-a" {
+		t.Errorf("sourcePolicies = %v", sources)
+	}
+	pol := body["policy"].(map[string]interface{})
+	if pol["requireRateLimit"] != true {
+		t.Errorf("requireRateLimit = %v, want true", pol["requireRateLimit"])
+	}
+
+	req = httptest.NewRequest("GET", "/api/governance/policy/effective?namespace=other", nil)
+	w = httptest.NewRecorder()
+	handleEffectivePolicy(w, req)
+	body = nil
+	json.NewDecoder(w.Body).Decode(&body)
+	if body["scoped"] != false {
+		t.Errorf("scoped = %v for unscoped namespace, want false", body["scoped"])
+	}
+	if body["policy"].(map[string]interface{})["requireRateLimit"] != false {
+		t.Error("Unscoped namespace should get the baseline")
+	}
+}
+
+// ────────────────────────────────────────────────────────────────────────────
+// Resources Endpoint
+// ────────────────────────────────────────────────────────────────────────────
+
+func TestHandleSimulate_MethodNotAllowed(t *testing.T) {
+	setupTestState(sampleResult(), sampleCluster(), evaluator.DefaultPolicy())
+
+	req := httptest.NewRequest("GET", "/api/governance/simulate", nil)
+	w := httptest.NewRecorder()
+	handleSimulate(w, req)
+
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("status = %d, want 405", w.Code)
+	}
+}
+
+func TestHandleSimulate_NoState(t *testing.T) {
+	setupNilState()
+
+	req := httptest.NewRequest("POST", "/api/governance/simulate", strings.NewReader(`{}`))
+	w := httptest.NewRecorder()
+	handleSimulate(w, req)
+
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("status = %d, want 503", w.Code)
+	}
+}
+
+func TestHandleAuthzSimulate(t *testing.T) {
+	p := evaluator.DefaultPolicy()
+	cluster := sampleCluster()
+	cluster.KagentRemoteMCPServers[0].ToolNames = []string{"read", "write"}
+	cluster.AgentgatewayPolicies[0].Authorization = evaluator.AnalyzeAuthorization([]evaluator.AuthorizationRule{
+		{Section: "backend.mcp", Action: "Allow", Expression: `mcp.tool.name == "read" || (jwt.role == "admin" && mcp.tool.name == "write")`},
+	})
+	result := evaluator.Evaluate(cluster, p)
+	setupTestState(result, cluster, p)
+	serverID := result.MCPServerViews[0].ID
+
+	post := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/api/governance/authz/simulate", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		handleAuthzSimulate(w, req)
+		return w
+	}
+
+	w := post(fmt.Sprintf(`{"serverId": %q, "tool": "write", "claims": {"sub": "bob"}}`, serverID))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+	var decision evaluator.AuthzDecision
+	json.NewDecoder(w.Body).Decode(&decision)
+	if decision.Allowed || decision.Principal != "bob" || len(decision.MissingClaims) != 1 || decision.MissingClaims[0] != "role" {
+		t.Errorf("decision = %+v", decision)
+	}
+
+	w = post(fmt.Sprintf(`{"serverId": %q, "principals": [{"name": "viewer"}, {"name": "admin", "claims": {"role": "admin"}}]}`, serverID))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+	var matrix evaluator.AuthzMatrix
+	json.NewDecoder(w.Body).Decode(&matrix)
+	if len(matrix.Rows) != 2 || matrix.Rows[0].Decisions["write"] != "deny" || matrix.Rows[1].Decisions["write"] != "allow" {
+		t.Errorf("matrix = %+v", matrix)
+	}
+
+	if w := post(`{"serverId": "missing", "tool": "read"}`); w.Code != http.StatusNotFound {
+		t.Errorf("unknown server status = %d, want 404", w.Code)
+	}
+	if w := post(`{"tool": "read"}`); w.Code != http.StatusBadRequest {
+		t.Errorf("missing serverId status = %d, want 400", w.Code)
+	}
+	req := httptest.NewRequest("GET", "/api/governance/authz/simulate", nil)
+	w = httptest.NewRecorder()
+	handleAuthzSimulate(w, req)
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("GET status = %d, want 405", w.Code)
+	}
+}
+
+func TestHandleSimulate_PartialSpec(t *testing.T) {
+	p := evaluator.DefaultPolicy()
+	cluster := sampleCluster()
+	current := evaluator.Evaluate(cluster, p)
+	setupTestState(current, cluster, p)
+
+	spec := `{"spec": {"requireCORS": false, "requireRateLimit": false, "requirePromptGuard": false, "severityPenalties": {"critical": 5}}}`
+	req := httptest.NewRequest("POST", "/api/governance/simulate", strings.NewReader(spec))
+	w := httptest.NewRecorder()
+	handleSimulate(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+	var body map[string]interface{}
+	json.NewDecoder(w.Body).Decode(&body)
+
+	if int(body["currentScore"].(float64)) != current.Score {
+		t.Errorf("currentScore = %v, want %d", body["currentScore"], current.Score)
+	}
+	projected := int(body["projectedScore"].(float64))
+	if projected <= current.Score {
+		t.Errorf("projectedScore = %d, expected a looser policy to beat %d", projected, current.Score)
+	}
+	if int(body["scoreDelta"].(float64)) != projected-current.Score {
+		t.Errorf("scoreDelta = %v", body["scoreDelta"])
+	}
+	if len(body["findingsRemoved"].([]interface{})) == 0 {
+		t.Error("Expected findings removed when requirements are relaxed")
+	}
+	pen := body["policy"].(map[string]interface{})["severityPenalties"].(map[string]interface{})
+	if pen["critical"].(float64) != 5 || pen["high"].(float64) != float64(p.SeverityPenalties.High) {
+		t.Errorf("severityPenalties = %v, want critical overridden and high kept", pen)
+	}
+
+	snap := getSnapshot()
+	if snap.result != current || snap.policy.RequireCORS != p.RequireCORS {
+		t.Error("Simulation must not persist its result or policy")
+	}
+}
+
+func TestNormalizeJSONNumbers(t *testing.T) {
+	in := map[string]interface{}{
+		"a": float64(3),
+		"b": 1.5,
+		"c": []interface{}{float64(7), map[string]interface{}{"d": float64(0)}},
+	}
+	out := normalizeJSONNumbers(in).(map[string]interface{})
+	if out["a"] != int64(3) || out["b"] != 1.5 {
+		t.Errorf("unexpected normalisation: %v", out)
+	}
+	c := out["c"].([]interface{})
+	if c[0] != int64(7) || c[1].(map[string]interface{})["d"] != int64(0) {
+		t.Errorf("nested values not normalised: %v", c)
+	}
+}
+
+func TestHandleCompliance(t *testing.T) {
+	setupTestState(sampleResult(), sampleCluster(), evaluator.DefaultPolicy())
+
+	req := httptest.NewRequest("GET", "/api/governance/compliance?framework=owasp-mcp", nil)
+	w := httptest.NewRecorder()
+	handleCompliance(w, req)
+
+	var body map[string]interface{}
+	json.NewDecoder(w.Body).Decode(&body)
+	if body["framework"] != "owasp-mcp" {
+		t.Fatalf("framework = %v", body["framework"])
+	}
+	statuses := map[string]string{}
+	for _, c := range body["controls"].([]interface{}) {
+		ctrl := c.(map[string]interface{})
+		statuses[ctrl["id"].(string)] = ctrl["status"].(string)
+	}
+	if statuses["MCP07"] != "fail" {
+		t.Errorf("MCP07 = %s, want fail (AUTH-002 open)", statuses["MCP07"])
+	}
+	if statuses["MCP08"] != "not-applicable" {
+		t.Errorf("MCP08 = %s, want not-applicable", statuses["MCP08"])
+	}
+
+	req = httptest.NewRequest("GET", "/api/governance/compliance", nil)
+	w = httptest.NewRecorder()
+	handleCompliance(w, req)
+	body = nil
+	json.NewDecoder(w.Body).Decode(&body)
+	if len(body["frameworks"].([]interface{})) != 3 {
+		t.Errorf("frameworks = %v, want 3 built-ins", body["frameworks"])
+	}
+
+	req = httptest.NewRequest("GET", "/api/governance/compliance?framework=pci", nil)
+	w = httptest.NewRecorder()
+	handleCompliance(w, req)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("unknown framework status = %d, want 404", w.Code)
+	}
+}
+
+func TestHandleResources_NilResult(t *testing.T) {
+	setupNilState()
+	req := httptest.NewRequest("GET", "/api/governance/resources", nil)
+	w := httptest.NewRecorder()
+
+	handleResources(w, req)
+
+	var body evaluator.ResourceSummary
+	json.NewDecoder(w.Body).Decode(&body)
+
+	if body.GatewaysFound != 0 {
+		t.Errorf("GatewaysFound = %d, want 0", body.GatewaysFound)
+	}
+}
+
+func TestHandleResources_WithResult(t *testing.T) {
+	setupTestState(sampleResult(), sampleCluster(), evaluator.DefaultPolicy())
+	req := httptest.NewRequest("GET", "/api/governance/resources", nil)
+	w := httptest.NewRecorder()
+
+	handleResources(w, req)
+
+	var body evaluator.ResourceSummary
+	json.NewDecoder(w.Body).Decode(&body)
+
+	if body.GatewaysFound != 1 {
+		t.Errorf("GatewaysFound = %d, want 1", body.GatewaysFound)
+	}
+	if body.AgentgatewayBackends != 2 {
+		t.Errorf("AgentgatewayBackends = %d, want 2", body.AgentgatewayBackends)
+	}
+	if body.KagentAgents != 3 {
+		t.Errorf("KagentAgents = %d, want 3", body.KagentAgents)
+	}
+}
+
+// ────────────────────────────────────────────────────────────────────────────
+// Namespaces Endpoint
+// ────────────────────────────────────────────────────────────────────────────
+
+func TestHandleNamespaces_NilResult(t *testing.T) {
+	setupNilState()
+	req := httptest.NewRequest("GET", "/api/governance/namespaces", nil)
+	w := httptest.NewRecorder()
+
+	handleNamespaces(w, req)
+
+	var body map[string]interface{}
+	json.NewDecoder(w.Body).Decode(&body)
+
+	ns := body["namespaces"].([]interface{})
+	if len(ns) != 0 {
+		t.Errorf("namespaces len = %d, want 0", len(ns))
+	}
+}
+
+func TestHandleNamespaces_WithResult(t *testing.T) {
+	setupTestState(sampleResult(), sampleCluster(), evaluator.DefaultPolicy())
+	req := httptest.NewRequest("GET", "/api/governance/namespaces", nil)
+	w := httptest.NewRecorder()
+
+	handleNamespaces(w, req)
+
+	var body map[string]interface{}
+	json.NewDecoder(w.Body).Decode(&body)
+
+	ns := body["namespaces"].([]interface{})
+	if len(ns) != 2 {
+		t.Errorf("namespaces len = %d, want 2", len(ns))
+	}
+}
+
+// ────────────────────────────────────────────────────────────────────────────
+// Breakdown Endpoint
+// ────────────────────────────────────────────────────────────────────────────
+
+func TestHandleBreakdown_NilResult(t *testing.T) {
+	setupNilState()
+	req := httptest.NewRequest("GET", "/api/governance/breakdown", nil)
+	w := httptest.NewRecorder()
+
+	handleBreakdown(w, req)
+
+	var body map[string]interface{}
+	json.NewDecoder(w.Body).Decode(&body)
+
+	if len(body) != 0 {
+		t.Errorf("breakdown should be empty when no result, got %d keys", len(body))
+	}
+}
+
+func TestHandleBreakdown_WithResult(t *testing.T) {
+	setupTestState(sampleResult(), sampleCluster(), evaluator.DefaultPolicy())
+	req := httptest.NewRequest("GET", "/api/governance/breakdown", nil)
+	w := httptest.NewRecorder()
+
+	handleBreakdown(w, req)
+
+	var body map[string]interface{}
+	json.NewDecoder(w.Body).Decode(&body)
+
+	// Default policy enables: AgentGateway, Auth, AuthZ, CORS, TLS, ToolScope (6 categories)
+	// PromptGuard and RateLimit are disabled by default
+	if _, ok := body["agentGatewayScore"]; !ok {
+		t.Error("breakdown should include agentGatewayScore")
+	}
+	if _, ok := body["authenticationScore"]; !ok {
+		t.Error("breakdown should include authenticationScore")
+	}
+	if _, ok := body["tlsScore"]; !ok {
+		t.Error("breakdown should include tlsScore")
+	}
+	// PromptGuard and RateLimit disabled by default
+	if _, ok := body["promptGuardScore"]; ok {
+		t.Error("breakdown should NOT include promptGuardScore (disabled)")
+	}
+	if _, ok := body["rateLimitScore"]; ok {
+		t.Error("breakdown should NOT include rateLimitScore (disabled)")
+	}
+}
+
+// ────────────────────────────────────────────────────────────────────────────
+// Full Evaluation Endpoint
+// ────────────────────────────────────────────────────────────────────────────
+
+func TestHandleFullEvaluation_NilResult(t *testing.T) {
+	setupNilState()
+	req := httptest.NewRequest("GET", "/api/governance/evaluation", nil)
+	w := httptest.NewRecorder()
+
+	handleFullEvaluation(w, req)
+
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("status = %d, want 503", w.Code)
+	}
+}
+
+func TestHandleFullEvaluation_WithResult(t *testing.T) {
+	setupTestState(sampleResult(), sampleCluster(), evaluator.DefaultPolicy())
+	req := httptest.NewRequest("GET", "/api/governance/evaluation", nil)
+	w := httptest.NewRecorder()
+
+	handleFullEvaluation(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("status = %d, want 200", w.Code)
+	}
+
+	var body map[string]interface{}
+	json.NewDecoder(w.Body).Decode(&body)
+
+	if body["Score"].(float64) != 72 {
+		t.Errorf("Score = %v, want 72", body["Score"])
+	}
+}
+
+// ────────────────────────────────────────────────────────────────────────────
+// Resource Detail Endpoint
+// ────────────────────────────────────────────────────────────────────────────
+
+func TestHandleResourceDetail_NilResult(t *testing.T) {
+	setupNilState()
+	req := httptest.NewRequest("GET", "/api/governance/resources/detail", nil)
+	w := httptest.NewRecorder()
+
+	handleResourceDetail(w, req)
+
+	var body map[string]interface{}
+	json.NewDecoder(w.Body).Decode(&body)
+
+	resources := body["resources"].([]interface{})
+	if len(resources) != 0 {
+		t.Errorf("resources len = %d, want 0", len(resources))
+	}
+}
+
+func TestHandleResourceDetail_WithResult(t *testing.T) {
+	setupTestState(sampleResult(), sampleCluster(), evaluator.DefaultPolicy())
+	req := httptest.NewRequest("GET", "/api/governance/resources/detail", nil)
+	w := httptest.NewRecorder()
+
+	handleResourceDetail(w, req)
+
+	var body map[string]interface{}
+	json.NewDecoder(w.Body).Decode(&body)
+
+	resources := body["resources"].([]interface{})
+	if len(resources) == 0 {
+		t.Error("resources should not be empty")
+	}
+
+	total := int(body["total"].(float64))
+	if total != len(resources) {
+		t.Errorf("total = %d, want %d", total, len(resources))
+	}
+}
+
+// ────────────────────────────────────────────────────────────────────────────
+// buildResourceDetail
+// ────────────────────────────────────────────────────────────────────────────
+
+func TestBuildResourceDetail_NoFindings(t *testing.T) {
+	p := evaluator.DefaultPolicy()
+	rd := buildResourceDetail("ref", "Gateway", "gw", "ns", nil, p)
+
+	if rd.Score != 100 {
+		t.Errorf("score = %d, want 100", rd.Score)
+	}
+	if rd.Status != "compliant" {
+		t.Errorf("status = %q, want 'compliant'", rd.Status)
+	}
+	if rd.Critical != 0 || rd.High != 0 || rd.Medium != 0 || rd.Low != 0 {
+		t.Error("severity counts should all be 0")
+	}
+	if rd.Findings == nil {
+		t.Error("Findings should be initialized to empty slice, not nil")
+	}
+}
+
+func TestBuildResourceDetail_CriticalFinding(t *testing.T) {
+	p := evaluator.DefaultPolicy()
+	findings := []evaluator.Finding{
+		{Severity: "Critical", Category: "AgentGateway"},
+	}
+
+	rd := buildResourceDetail("ref", "Gateway", "gw", "ns", findings, p)
+
+	if rd.Score != 0 {
+		t.Errorf("score = %d, want 0 (critical finding)", rd.Score)
+	}
+	if rd.Status != "critical" {
+		t.Errorf("status = %q, want 'critical'", rd.Status)
+	}
+	if rd.Critical != 1 {
+		t.Errorf("Critical = %d, want 1", rd.Critical)
+	}
+}
+
+func TestBuildResourceDetail_HighFinding(t *testing.T) {
+	p := evaluator.DefaultPolicy()
+	findings := []evaluator.Finding{
+		{Severity: "High", Category: "TLS"},
+	}
+
+	rd := buildResourceDetail("ref", "Backend", "b1", "ns", findings, p)
+
+	// 100 - 25 (default High penalty) = 75
+	if rd.Score != 75 {
+		t.Errorf("score = %d, want 75", rd.Score)
+	}
+	// buildResourceDetail status: High -> "failing"
+	if rd.Status != "failing" {
+		t.Errorf("status = %q, want 'failing'", rd.Status)
+	}
+	if rd.High != 1 {
+		t.Errorf("High = %d, want 1", rd.High)
+	}
+}
+
+func TestBuildResourceDetail_MediumFinding(t *testing.T) {
+	p := evaluator.DefaultPolicy()
+	findings := []evaluator.Finding{
+		{Severity: "Medium", Category: "CORS"},
+	}
+
+	rd := buildResourceDetail("ref", "Route", "r1", "ns", findings, p)
+
+	// 100 - 15 = 85
+	if rd.Score != 85 {
+		t.Errorf("score = %d, want 85", rd.Score)
+	}
+	// buildResourceDetail status: Medium -> "warning"
+	if rd.Status != "warning" {
+		t.Errorf("status = %q, want 'warning'", rd.Status)
+	}
+	if rd.Medium != 1 {
+		t.Errorf("Medium = %d, want 1", rd.Medium)
+	}
+}
+
+func TestBuildResourceDetail_LowFinding(t *testing.T) {
+	p := evaluator.DefaultPolicy()
+	findings := []evaluator.Finding{
+		{Severity: "Low", Category: "CORS"},
+	}
+
+	rd := buildResourceDetail("ref", "Route", "r1", "ns", findings, p)
+
+	// 100 - 5 = 95
+	if rd.Score != 95 {
+		t.Errorf("score = %d, want 95", rd.Score)
+	}
+	// buildResourceDetail status: Low -> "info"
+	if rd.Status != "info" {
+		t.Errorf("status = %q, want 'info'", rd.Status)
+	}
+	if rd.Low != 1 {
+		t.Errorf("Low = %d, want 1", rd.Low)
+	}
+}
+
+func TestBuildResourceDetail_ScoreFloor(t *testing.T) {
+	p := evaluator.DefaultPolicy()
+	// 5 High findings: 5 * 25 = 125, should floor at 0
+	findings := []evaluator.Finding{
+		{Severity: "High"},
+		{Severity: "High"},
+		{Severity: "High"},
+		{Severity: "High"},
+		{Severity: "High"},
+	}
+
+	rd := buildResourceDetail("ref", "Backend", "b1", "ns", findings, p)
+
+	if rd.Score != 0 {
+		t.Errorf("score = %d, want 0 (floor)", rd.Score)
+	}
+}
+
+// ────────────────────────────────────────────────────────────────────────────
+// Trend Recording
+// ────────────────────────────────────────────────────────────────────────────
+
+func TestRecordTrendPoint_Nil(t *testing.T) {
+	trendMu.Lock()
+	trendHistory = nil
+	trendMu.Unlock()
+
+	recordTrendPoint(nil)
+
+	trendMu.RLock()
+	defer trendMu.RUnlock()
+	if len(trendHistory) != 0 {
+		t.Errorf("nil result should not add trend point, got %d", len(trendHistory))
+	}
+}
+
+func TestRecordTrendPoint_AddsPoint(t *testing.T) {
+	trendMu.Lock()
+	trendHistory = nil
+	trendMu.Unlock()
+
+	result := &evaluator.EvaluationResult{
+		Score:     50,
+		Timestamp: time.Now(),
+		Findings: []evaluator.Finding{
+			{Severity: "Critical"},
+			{Severity: "High"},
+			{Severity: "Medium"},
+			{Severity: "Low"},
+			{Severity: "Low"},
+		},
+	}
+	recordTrendPoint(result)
+
+	trendMu.RLock()
+	defer trendMu.RUnlock()
+
+	if len(trendHistory) != 1 {
+		t.Fatalf("len = %d, want 1", len(trendHistory))
+	}
+	tp := trendHistory[0]
+	if tp.Score != 50 {
+		t.Errorf("Score = %d, want 50", tp.Score)
+	}
+	if tp.Findings != 5 {
+		t.Errorf("Findings = %d, want 5", tp.Findings)
+	}
+	if tp.Critical != 1 {
+		t.Errorf("Critical = %d, want 1", tp.Critical)
+	}
+	if tp.High != 1 {
+		t.Errorf("High = %d, want 1", tp.High)
+	}
+	if tp.Medium != 1 {
+		t.Errorf("Medium = %d, want 1", tp.Medium)
+	}
+	if tp.Low != 2 {
+		t.Errorf("Low = %d, want 2", tp.Low)
+	}
+}
+
+func TestRecordTrendPoint_MaxHistory(t *testing.T) {
+	trendMu.Lock()
+	trendHistory = nil
+	trendMu.Unlock()
+
+	// Add 110 points — should trim to last 100
+	for i := 0; i < 110; i++ {
+		recordTrendPoint(&evaluator.EvaluationResult{
+			Score:     i,
+			Timestamp: time.Now(),
+		})
+	}
+
+	trendMu.RLock()
+	defer trendMu.RUnlock()
+
+	if len(trendHistory) != 100 {
+		t.Errorf("len = %d, want 100 (max)", len(trendHistory))
+	}
+	// First entry should be score=10 (items 0-9 trimmed)
+	if trendHistory[0].Score != 10 {
+		t.Errorf("first score = %d, want 10", trendHistory[0].Score)
+	}
+}
+
+// ────────────────────────────────────────────────────────────────────────────
+// Trends Endpoint
+// ────────────────────────────────────────────────────────────────────────────
+
+func TestHandleTrends_Empty(t *testing.T) {
+	trendMu.Lock()
+	trendHistory = nil
+	trendMu.Unlock()
+
+	req := httptest.NewRequest("GET", "/api/governance/trends", nil)
+	w := httptest.NewRecorder()
+
+	handleTrends(w, req)
+
+	var body map[string]interface{}
+	json.NewDecoder(w.Body).Decode(&body)
+
+	trends := body["trends"].([]interface{})
+	if len(trends) != 0 {
+		t.Errorf("trends len = %d, want 0", len(trends))
+	}
+}
+
+func TestHandleTrends_WithData(t *testing.T) {
+	trendMu.Lock()
+	trendHistory = []TrendPoint{
+		{Timestamp: "2026-02-11T12:00:00Z", Score: 50, Findings: 5},
+		{Timestamp: "2026-02-11T12:00:30Z", Score: 55, Findings: 4},
+	}
+	trendMu.Unlock()
+
+	req := httptest.NewRequest("GET", "/api/governance/trends", nil)
+	w := httptest.NewRecorder()
+
+	handleTrends(w, req)
+
+	var body map[string]interface{}
+	json.NewDecoder(w.Body).Decode(&body)
+
+	trends := body["trends"].([]interface{})
+	if len(trends) != 2 {
+		t.Errorf("trends len = %d, want 2", len(trends))
+	}
+}
+
+// ────────────────────────────────────────────────────────────────────────────
+// CORS Middleware
+// ────────────────────────────────────────────────────────────────────────────
+
+func TestCORSMiddleware_Headers(t *testing.T) {
+	handler := corsMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest("GET", "/api/health", nil)
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, req)
+
+	if w.Header().Get("Access-Control-Allow-Origin") != "*" {
+		t.Error("CORS Allow-Origin header missing")
+	}
+	if w.Header().Get("Access-Control-Allow-Methods") == "" {
+		t.Error("CORS Allow-Methods header missing")
+	}
+	if w.Header().Get("Access-Control-Allow-Headers") == "" {
+		t.Error("CORS Allow-Headers header missing")
+	}
+}
+
+func TestCORSMiddleware_Preflight(t *testing.T) {
+	handler := corsMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot) // should not reach here
+	}))
+
+	req := httptest.NewRequest("OPTIONS", "/api/health", nil)
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("OPTIONS status = %d, want 200", w.Code)
+	}
+}
+
+// ────────────────────────────────────────────────────────────────────────────
+// JSON Response Helper
+// ────────────────────────────────────────────────────────────────────────────
+
+func TestJSONResponse(t *testing.T) {
+	w := httptest.NewRecorder()
+	jsonResponse(w, map[string]string{"key": "value"})
+
+	if w.Header().Get("Content-Type") != "application/json" {
+		t.Errorf("Content-Type = %q, want 'application/json'", w.Header().Get("Content-Type"))
+	}
+
+	var body map[string]string
+	json.NewDecoder(w.Body).Decode(&body)
+	if body["key"] != "value" {
+		t.Errorf("body['key'] = %q, want 'value'", body["key"])
+	}
+}
+
+func TestHandleTeams(t *testing.T) {
+	res := sampleResult()
+	res.Findings[3].Owner = "platform"
+	res.MCPServerViews = []evaluator.MCPServerView{
+		{ID: "KagentRemoteMCPServer/system/b1", Name: "b1", Namespace: "system", Score: 40, Grade: "D", Owner: "platform"},
+	}
+	res.TeamScores = []evaluator.TeamScore{
+		{Team: "platform", Score: 40, Grade: "D", ServerCount: 1, OpenFindings: 1,
+			FindingsBySeverity: map[string]int{"High": 1}},
+	}
+	setupTestState(res, sampleCluster(), evaluator.DefaultPolicy())
+
+	req := httptest.NewRequest("GET", "/api/governance/teams", nil)
+	w := httptest.NewRecorder()
+	handleTeams(w, req)
+	var list map[string][]evaluator.TeamScore
+	json.NewDecoder(w.Body).Decode(&list)
+	if len(list["teams"]) != 1 || list["teams"][0].Team != "platform" {
+		t.Fatalf("teams = %+v", list)
+	}
+
+	req = httptest.NewRequest("GET", "/api/governance/teams/platform", nil)
+	req.SetPathValue("team", "platform")
+	w = httptest.NewRecorder()
+	handleTeamDetail(w, req)
+	var detail evaluator.TeamDetail
+	json.NewDecoder(w.Body).Decode(&detail)
+	if len(detail.Servers) != 1 || len(detail.Findings) != 1 || detail.Findings[0].ID != "TLS-001-b1" {
+		t.Errorf("detail = %+v", detail)
+	}
+
+	req = httptest.NewRequest("GET", "/api/governance/teams/nobody", nil)
+	req.SetPathValue("team", "nobody")
+	w = httptest.NewRecorder()
+	handleTeamDetail(w, req)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("unknown team status = %d, want 404", w.Code)
+	}
+}
+
+func TestHandleBlastRadius(t *testing.T) {
+	setupTestState(sampleResult(), sampleCluster(), evaluator.DefaultPolicy())
+
+	cases := []struct {
+		query string
+		want  int
+	}{
+		{"", http.StatusBadRequest},
+		{"?agent=a1", http.StatusBadRequest},
+		{"?agent=default/nobody", http.StatusNotFound},
+		{"?agent=default/a1", http.StatusOK},
+	}
+	for _, c := range cases {
+		req := httptest.NewRequest("GET", "/api/governance/blast-radius"+c.query, nil)
+		w := httptest.NewRecorder()
+		handleBlastRadius(w, req)
+		if w.Code != c.want {
+			t.Errorf("%q: status = %d, want %d", c.query, w.Code, c.want)
+		}
+	}
+
+	setupTestState(nil, nil, evaluator.DefaultPolicy())
+	w := httptest.NewRecorder()
+	handleGraph(w, httptest.NewRequest("GET", "/api/governance/graph", nil))
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("graph without result: status = %d, want 503", w.Code)
+	}
+}
+
+func TestHandleAgents(t *testing.T) {
+	setupTestState(nil, nil, evaluator.DefaultPolicy())
+	w := httptest.NewRecorder()
+	handleAgents(w, httptest.NewRequest("GET", "/api/governance/agents", nil))
+	var empty map[string][]evaluator.AgentView
+	json.NewDecoder(w.Body).Decode(&empty)
+	if agents, ok := empty["agents"]; !ok || len(agents) != 0 {
+		t.Errorf("no result: body = %+v", empty)
+	}
+
+	res := sampleResult()
+	res.AgentViews = []evaluator.AgentView{
+		{ID: "Agent/default/a1", Name: "a1", Namespace: "default", Score: 60, Grade: "C", Status: "failing"},
+	}
+	setupTestState(res, sampleCluster(), evaluator.DefaultPolicy())
+
+	cases := []struct {
+		query string
+		want  int
+	}{
+		{"", http.StatusBadRequest},
+		{"?id=Agent/default/nobody", http.StatusNotFound},
+		{"?id=Agent/default/a1", http.StatusOK},
+	}
+	for _, c := range cases {
+		w := httptest.NewRecorder()
+		handleAgentDetail(w, httptest.NewRequest("GET", "/api/governance/agents/detail"+c.query, nil))
+		if w.Code != c.want {
+			t.Errorf("%q: status = %d, want %d", c.query, w.Code, c.want)
+		}
+	}
+}
@@ -36,6 +36,16 @@ type MCPGovernancePolicySpec struct {
 	MaxToolsWarning int `json:"maxToolsWarning,omitempty"`
 	// MaxToolsCritical generates a Critical finding if an MCP server exposes more than this many tools (0 = disabled)
 	MaxToolsCritical int `json:"maxToolsCritical,omitempty"`
+	// CertExpiryWarningDays flags Gateway listener certificates expiring within this many days (default 30)
+	CertExpiryWarningDays int `json:"certExpiryWarningDays,omitempty"`
+	// RequiredJWTClaims lists claims every JWT provider must require (AUTH-010)
+	RequiredJWTClaims []string `json:"requiredJWTClaims,omitempty"`
+	// AllowedCORSOrigins lists the origins an AgentgatewayPolicy CORS block may allow (CORS-005)
+	AllowedCORSOrigins []string `json:"allowedCORSOrigins,omitempty"`
+	// MaxRateLimitRPS flags local rate limits allowing more requests per second than this (default 100)
+	MaxRateLimitRPS int `json:"maxRateLimitRPS,omitempty"`
+	// RequiredPromptGuardBuiltins lists builtin detectors every prompt guard must configure (PG-005)
+	RequiredPromptGuardBuiltins []string `json:"requiredPromptGuardBuiltins,omitempty"`
 	// ScoringWeights defines the weights for the governance scoring model
 	ScoringWeights ScoringWeights `json:"scoringWeights,omitempty"`
 	// SeverityPenalties defines the point deductions per severity level
@@ -46,6 +56,8 @@ type MCPGovernancePolicySpec struct {
 	ExcludeNamespaces []string `json:"excludeNamespaces,omitempty"`
 	// RequireHardenedDeployment enables container security hardening checks (runAsNonRoot, readOnlyRootFilesystem, etc.)
 	RequireHardenedDeployment bool `json:"requireHardenedDeployment,omitempty"`
+	// RequireWorkloadRBAC enables RBAC analysis of the ServiceAccounts MCP server workloads run as
+	RequireWorkloadRBAC bool `json:"requireWorkloadRBAC,omitempty"`
 	// ScanInterval is the interval between governance evaluations (e.g. "5m", "10m", "1h")
 	ScanInterval string `json:"scanInterval,omitempty"`
 	// EnableAuditLogging enables structured JSON audit events on every evaluation (Tier 2 #16)
@@ -55,6 +67,136 @@ type MCPGovernancePolicySpec struct {
 	// VerifiedCatalogScoring configures scoring thresholds, category weights, and per-check max scores
 	// for the Verified Catalog (MCPServerCatalog inventory) scoring model.
 	VerifiedCatalogScoring *VerifiedCatalogScoringConfig `json:"verifiedCatalogScoring,omitempty"`
+	// Checks enables, disables or re-prioritises individual governance checks by check ID
+	// (e.g. "authentication", "cors", "hardened-deployment").
+	Checks map[string]CheckConfig `json:"checks,omitempty"`
+	// CheckOverrides adjusts individual findings by finding ID prefix
+	// (e.g. "TLS-003", "HDN-", "SKL-SEC-001"). The longest matching prefix wins.
+	CheckOverrides map[string]CheckOverride `json:"checkOverrides,omitempty"`
+	// CustomRules declares CEL-based house rules evaluated against discovered resources.
+	CustomRules []CustomRule `json:"customRules,omitempty"`
+	// Namespaces makes this a namespace-scoped policy that can only tighten the
+	// cluster-wide baseline for the listed namespaces (empty = cluster-wide baseline).
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Ownership configures the labels/annotations used to attribute findings and
+	// MCP servers to teams (checked on the resource, its workload, then its namespace).
+	Ownership *OwnershipConfig `json:"ownership,omitempty"`
+	// ToolSensitivity configures the read/write/destructive/exec tool classifier
+	// and how much exposed sensitive tools lower the ToolScope score.
+	ToolSensitivity *ToolSensitivityConfig `json:"toolSensitivity,omitempty"`
+	// ImageVerification configures offline cosign verification of workload
+	// images and the registries images may be pulled from.
+	ImageVerification *ImageVerificationConfig `json:"imageVerification,omitempty"`
+	// Vulnerabilities sets the CVE thresholds applied to the Trivy Operator
+	// VulnerabilityReports of MCP server workloads.
+	Vulnerabilities *VulnerabilityConfig `json:"vulnerabilities,omitempty"`
+	// MCPProbe configures live probing of MCP endpoints (initialize and
+	// tools/list) to compare served tools with declared tools.
+	MCPProbe *MCPProbeConfig `json:"mcpProbe,omitempty"`
+}
+
+// MCPProbeConfig configures live probing of MCP servers.
+type MCPProbeConfig struct {
+	// Enabled probes the MCP servers in AllowedNamespaces on every scan.
+	Enabled bool `json:"enabled,omitempty"`
+	// AllowedNamespaces lists the namespaces whose servers may be probed;
+	// "*" allows all. Empty probes nothing.
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty"`
+	// Concurrency is the number of servers probed at once. Default: 4
+	Concurrency int `json:"concurrency,omitempty"`
+	// TimeoutSeconds bounds the probe of one server. Default: 10
+	TimeoutSeconds int `json:"timeoutSeconds,omitempty"`
+	// AuthBypass also sends unauthenticated, expired-token and wrong-audience
+	// requests through the gateway and directly to the backend Service.
+	AuthBypass bool `json:"authBypass,omitempty"`
+}
+
+// VulnerabilityConfig configures vulnerability scoring of MCP workloads.
+type VulnerabilityConfig struct {
+	// Enabled scores MCP workloads against their Trivy reports. Default: true
+	Enabled bool `json:"enabled,omitempty"`
+	// MaxCritical critical CVEs are tolerated before VULN-001. Default: 0
+	MaxCritical int `json:"maxCritical,omitempty"`
+	// MaxHigh high CVEs are tolerated before VULN-002. Default: 5
+	MaxHigh int `json:"maxHigh,omitempty"`
+	// IgnoreUnfixed counts only CVEs with a fixed version toward the thresholds.
+	IgnoreUnfixed bool `json:"ignoreUnfixed,omitempty"`
+}
+
+// ImageVerificationConfig configures image signature and registry checks.
+type ImageVerificationConfig struct {
+	// Enabled verifies cosign signatures of every workload image.
+	Enabled bool `json:"enabled,omitempty"`
+	// PublicKeys are PEM-encoded cosign public keys trusted to sign images.
+	PublicKeys []string `json:"publicKeys,omitempty"`
+	// PublicKeysConfigMap ("namespace/name") holds additional PEM public keys.
+	PublicKeysConfigMap string `json:"publicKeysConfigMap,omitempty"`
+	// AllowedRegistries lists registry hosts or repository prefixes images may
+	// come from. Empty allows all registries.
+	AllowedRegistries []string `json:"allowedRegistries,omitempty"`
+	// RegistryMirror is the host[:port] of a registry holding the images and
+	// their signatures. Empty contacts each image's own registry.
+	RegistryMirror string `json:"registryMirror,omitempty"`
+	// InsecureRegistry uses plain HTTP to reach the registry.
+	InsecureRegistry bool `json:"insecureRegistry,omitempty"`
+}
+
+// ToolSensitivityConfig overrides tool classification patterns and penalties.
+type ToolSensitivityConfig struct {
+	// Patterns maps a class (write, destructive, exec) to tool name/verb patterns.
+	// Plain patterns match a word of the tool name; patterns with '*' are globs.
+	// A class listed here replaces its built-in patterns.
+	Patterns map[string][]string `json:"patterns,omitempty"`
+	// Penalties maps a class (read, write, destructive, exec) to the ToolScope
+	// points deducted per exposed tool. Defaults: 0, 2, 10, 15
+	Penalties map[string]int `json:"penalties,omitempty"`
+	// UnrestrictedMultiplier scales penalties for servers without an RBAC policy. Default: 2
+	UnrestrictedMultiplier int `json:"unrestrictedMultiplier,omitempty"`
+}
+
+// OwnershipConfig lists the label and annotation keys that name a resource's owning team.
+type OwnershipConfig struct {
+	// LabelKeys are checked in order. Default: ["team", "owner"]
+	LabelKeys []string `json:"labelKeys,omitempty"`
+	// AnnotationKeys are checked in order after LabelKeys. Default: ["team", "owner"]
+	AnnotationKeys []string `json:"annotationKeys,omitempty"`
+}
+
+// CustomRule is a CEL expression evaluated against every resource of TargetKind.
+// Resources for which the expression evaluates to true produce a finding.
+type CustomRule struct {
+	// ID identifies the rule; findings are reported as CUSTOM-<id>-<resource name>
+	ID string `json:"id"`
+	// Severity of the finding: Critical, High, Medium or Low
+	Severity string `json:"severity"`
+	// Category the finding counts toward (e.g. "ToolScope", "Authentication")
+	Category string `json:"category"`
+	// TargetKind is the resource kind the rule applies to (e.g. "RemoteMCPServer", "Workload")
+	TargetKind string `json:"targetKind"`
+	// Expression is the CEL expression; `resource` and `kind` are in scope
+	Expression string `json:"expression"`
+	// Title, Description and Remediation optionally customise the finding text
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Remediation string `json:"remediation,omitempty"`
+}
+
+// CheckConfig overrides the behaviour of a single registered governance check.
+type CheckConfig struct {
+	// Enabled toggles the check on/off. Default: true
+	Enabled *bool `json:"enabled,omitempty"`
+	// Severity replaces the severity of every finding the check reports (Critical, High, Medium, Low)
+	Severity string `json:"severity,omitempty"`
+}
+
+// CheckOverride overrides the severity or score penalty of matching findings, or disables them.
+type CheckOverride struct {
+	// Enabled toggles matching findings on/off. Default: true
+	Enabled *bool `json:"enabled,omitempty"`
+	// Severity replaces the severity of matching findings (Critical, High, Medium, Low)
+	Severity string `json:"severity,omitempty"`
+	// Penalty replaces the score penalty deducted for each matching finding
+	Penalty *int `json:"penalty,omitempty"`
 }
 
 // VerifiedCatalogScoringConfig allows users to customise the Verified Catalog scoring model
@@ -160,6 +302,7 @@ type GovernanceEvaluationStatus struct {
 	NamespaceScores         []NamespaceScore         `json:"namespaceScores,omitempty"`
 	VerifiedCatalogScores   []VerifiedCatalogScore   `json:"verifiedCatalogScores,omitempty"`
 	MCPServerScores         []MCPServerScore         `json:"mcpServerScores,omitempty"`
+	AgentScores             []AgentScore             `json:"agentScores,omitempty"`
 	FindingsCount           int                      `json:"findingsCount,omitempty"`
 }
 
@@ -259,6 +402,22 @@ type MCPServerScoreBreakdown struct {
 	ToolScope      int `json:"toolScope"`
 }
 
+// AgentScore stores the governance score for a kagent Agent, aggregated from
+// the MCP servers and agents it references
+type AgentScore struct {
+	Name               string       `json:"name"`
+	Namespace          string       `json:"namespace"`
+	Status             string       `json:"status"` // "compliant", "warning", "failing", "critical"
+	Score              int          `json:"score"`
+	Grade              string       `json:"grade"`
+	Owner              string       `json:"owner,omitempty"`
+	MCPServers         int          `json:"mcpServers"`
+	EffectiveToolCount int          `json:"effectiveToolCount"`
+	WeakestControl     string       `json:"weakestControl,omitempty"`
+	CriticalFindings   int          `json:"criticalFindings"`
+	LastEvaluated      *metav1.Time `json:"lastEvaluated,omitempty"`
+}
+
 // RelatedResourceSummary summarizes related resources for an MCP server
 type RelatedResourceSummary struct {
 	Gateways int `json:"gateways"`
@@ -274,3 +433,38 @@ type GovernanceEvaluationList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []GovernanceEvaluation `json:"items"`
 }
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GovernanceException is a namespaced, time-boxed waiver for governance findings.
+type GovernanceException struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              GovernanceExceptionSpec `json:"spec,omitempty"`
+}
+
+type GovernanceExceptionSpec struct {
+	// FindingIDPrefix matches findings whose ID starts with this prefix (e.g. "HDN-003")
+	FindingIDPrefix string `json:"findingIDPrefix"`
+	// ResourceRef optionally restricts the waiver to a single resource ("Kind/namespace/name")
+	ResourceRef string `json:"resourceRef,omitempty"`
+	// Justification explains why the risk is accepted
+	Justification string `json:"justification"`
+	// Approver identifies who accepted the risk
+	Approver string `json:"approver"`
+	// ExpiresAt is when the waiver stops applying (RFC3339)
+	ExpiresAt metav1.Time `json:"expiresAt"`
+	// ClusterScoped waives findings on cluster-scoped resources instead of
+	// findings in the exception's namespace
+	ClusterScoped bool `json:"clusterScoped,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type GovernanceExceptionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GovernanceException `json:"items"`
+}
+
@@ -20,6 +20,8 @@ const (
 	EventTypeFinding     EventType = "FINDING"
 	EventTypeScoreChange EventType = "SCORE_CHANGE"
 	EventTypePolicy      EventType = "POLICY"
+	EventTypeWaiver      EventType = "WAIVER"
+	EventTypeOverride    EventType = "OVERRIDE"
 )
 
 // AuditEvent is a single structured log entry emitted on stdout as JSON.
@@ -28,7 +30,7 @@ type AuditEvent struct {
 	// When the event was recorded (RFC3339 UTC)
 	Timestamp time.Time `json:"timestamp"`
 
-	// EventType is one of EVALUATION | FINDING | SCORE_CHANGE | POLICY
+	// EventType is one of EVALUATION | FINDING | SCORE_CHANGE | POLICY | WAIVER | OVERRIDE
 	EventType EventType `json:"eventType"`
 
 	// EvaluationID ties all events from a single Evaluate() call together
@@ -54,7 +56,15 @@ type AuditEvent struct {
 	// Policy context (populated for EventTypePolicy)
 	PolicyName string `json:"policyName,omitempty"`
 
-	// Action taken: CREATED | UPDATED | REMEDIATED
+	// Exception context (populated for EventTypeWaiver) — "namespace/name" of the GovernanceException
+	ExceptionName string `json:"exceptionName,omitempty"`
+
+	// Override context (populated for EventTypeOverride) — the spec.checkOverrides key
+	// that matched and the severity the check reported before the override
+	OverrideKey      string `json:"overrideKey,omitempty"`
+	OriginalSeverity string `json:"originalSeverity,omitempty"`
+
+	// Action taken: CREATED | UPDATED | REMEDIATED | WAIVED | OVERRIDDEN | DISABLED
 	Action string `json:"action,omitempty"`
 
 	// Human-readable summary
@@ -153,6 +163,50 @@ func (l *Logger) LogPolicy(evaluationID, policyName, message string) {
 	})
 }
 
+// LogWaiver records a finding that was waived by a GovernanceException.
+func (l *Logger) LogWaiver(evaluationID, findingID, severity, category, exceptionName, message string) {
+	if !l.enabled {
+		return
+	}
+	l.emit(AuditEvent{
+		Timestamp:       time.Now().UTC(),
+		EventType:       EventTypeWaiver,
+		EvaluationID:    evaluationID,
+		ClusterName:     l.clusterName,
+		FindingID:       findingID,
+		FindingSeverity: severity,
+		FindingCategory: category,
+		ExceptionName:   exceptionName,
+		Action:          "WAIVED",
+		Message:         message,
+	})
+}
+
+// LogOverride records a finding whose severity was changed, or which was
+// dropped, by an MCPGovernancePolicy spec.checkOverrides entry.
+func (l *Logger) LogOverride(evaluationID, findingID, originalSeverity, severity, category, overrideKey string, disabled bool, message string) {
+	if !l.enabled {
+		return
+	}
+	action := "OVERRIDDEN"
+	if disabled {
+		action = "DISABLED"
+	}
+	l.emit(AuditEvent{
+		Timestamp:        time.Now().UTC(),
+		EventType:        EventTypeOverride,
+		EvaluationID:     evaluationID,
+		ClusterName:      l.clusterName,
+		FindingID:        findingID,
+		FindingSeverity:  severity,
+		FindingCategory:  category,
+		OverrideKey:      overrideKey,
+		OriginalSeverity: originalSeverity,
+		Action:           action,
+		Message:          message,
+	})
+}
+
 // emit serialises the event as JSON and writes it to stdout.
 // The [AUDIT] prefix makes it easy to grep in mixed log streams.
 func (l *Logger) emit(event AuditEvent) {
@@ -0,0 +1,176 @@
+package compliance
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/evaluator"
+)
+
+// builtinCheckIDs lists every finding code emitted by the built-in checks.
+var builtinCheckIDs = []string{
+	"AGENT-001", "AGENT-002",
+	"AGW-001", "AGW-002", "AGW-003", "AGW-004", "AGW-100", "AGW-200",
+	"AUTH-001", "AUTH-002", "AUTH-005", "AUTH-006", "AUTH-007", "AUTH-008", "AUTH-009", "AUTH-010", "AUTH-011", "AUTH-012", "AUTH-100",
+	"CORS-001", "CORS-002", "CORS-003", "CORS-004", "CORS-005",
+	"EXP-001", "EXP-002", "EXP-003", "EXP-004", "EXP-005", "EXP-006",
+	"HDN-000", "HDN-001", "HDN-002", "HDN-003", "HDN-004", "HDN-005",
+	"HDN-006", "HDN-007", "HDN-008", "HDN-009", "HDN-010",
+	"HDN-011", "HDN-012", "HDN-013", "HDN-014", "HDN-015",
+	"HDN-016", "HDN-017", "HDN-018", "HDN-019",
+	"KRBAC-001", "KRBAC-002", "KRBAC-003", "KRBAC-004", "KRBAC-005",
+	"PG-001", "PG-002", "PG-003", "PG-004", "PG-005",
+	"PROBE-001", "PROBE-002", "PROBE-003",
+	"RBAC-001", "RBAC-002", "RBAC-003", "RBAC-004", "RBAC-005", "RBAC-006", "RBAC-100",
+	"RL-001", "RL-002", "RL-003", "RL-004",
+	"SKL-001", "SKL-002", "SKL-003", "SKL-004", "SKL-005", "SKL-006", "SKL-007", "SKL-008",
+	"SKL-SEC-001", "SKL-SEC-002", "SKL-SEC-003", "SKL-SEC-004", "SKL-SEC-005", "SKL-SEC-006", "SKL-SEC-007",
+	"SKL-SEC-008", "SKL-SEC-009", "SKL-SEC-010", "SKL-SEC-011", "SKL-SEC-012", "SKL-SEC-013",
+	"TLS-001", "TLS-002", "TLS-003", "TLS-004", "TLS-005", "TLS-006",
+	"TOOLS-000", "TOOLS-001", "TOOLS-002",
+	"VULN-001", "VULN-002", "VULN-003", "VULN-004", "VULN-005",
+}
+
+func TestDefaultFrameworks_MapEveryCheck(t *testing.T) {
+	frameworks := DefaultFrameworks()
+	for _, id := range []string{FrameworkOWASPMCP, FrameworkNIST, FrameworkSOC2} {
+		if _, ok := frameworks[id]; !ok {
+			t.Fatalf("Missing built-in framework %s", id)
+		}
+	}
+	for _, check := range builtinCheckIDs {
+		controls := ControlsForFinding(frameworks, check+"-resource")
+		for id := range frameworks {
+			if len(controls[id]) == 0 {
+				t.Errorf("%s is not mapped to any %s control", check, id)
+			}
+		}
+	}
+}
+
+func TestBuildReport_Statuses(t *testing.T) {
+	fw := Framework{
+		ID: "test",
+		Controls: []Control{
+			{ID: "C1", Checks: []string{"TLS-"}, ResourceKinds: []string{"AgentgatewayBackend"}},
+			{ID: "C2", Checks: []string{"HDN-"}, ResourceKinds: []string{"Workload"}},
+			{ID: "C3", Checks: []string{"SKL-"}, ResourceKinds: []string{"SkillCatalog"}},
+			{ID: "C4"},
+			{ID: "C5", Checks: []string{"AUTH-"}, ResourceKinds: []string{"AgentgatewayPolicy"}},
+		},
+	}
+	state := &evaluator.ClusterState{
+		AgentgatewayBackends: []evaluator.AgentgatewayBackendResource{{Name: "b", Namespace: "ns"}},
+		Workloads:            []evaluator.WorkloadResource{{Name: "w", Namespace: "ns", Kind: "Deployment"}},
+	}
+	result := &evaluator.EvaluationResult{
+		Findings: []evaluator.Finding{
+			{ID: "TLS-001-b", ResourceRef: "AgentgatewayBackend/ns/b"},
+		},
+		WaivedFindings: []evaluator.WaivedFinding{
+			{Finding: evaluator.Finding{ID: "AUTH-002"}},
+		},
+	}
+
+	rep := BuildReport(fw, result, state)
+
+	want := map[string]string{
+		"C1": StatusFail, "C2": StatusPass, "C3": StatusNotApplicable,
+		"C4": StatusNotApplicable, "C5": StatusNotApplicable,
+	}
+	for _, c := range rep.Controls {
+		if c.Status != want[c.ID] {
+			t.Errorf("%s status = %s, want %s", c.ID, c.Status, want[c.ID])
+		}
+	}
+	c1, c2, c5 := rep.Controls[0], rep.Controls[1], rep.Controls[4]
+	if len(c1.Findings) != 1 || len(c1.Resources) != 1 || c1.Resources[0] != "AgentgatewayBackend/ns/b" {
+		t.Errorf("C1 evidence = %+v", c1)
+	}
+	if len(c2.Resources) != 1 || c2.Resources[0] != "Deployment/ns/w" {
+		t.Errorf("C2 resources = %v", c2.Resources)
+	}
+	if len(c5.Waived) != 1 {
+		t.Errorf("C5 should list the waived finding, got %+v", c5.Waived)
+	}
+	if rep.Summary.Pass != 1 || rep.Summary.Fail != 1 || rep.Summary.NotApplicable != 3 || rep.Summary.PassRate != 50 {
+		t.Errorf("summary = %+v", rep.Summary)
+	}
+}
+
+func TestBuildReport_AgentFindings(t *testing.T) {
+	state := &evaluator.ClusterState{
+		Namespaces: []string{"apps"},
+		KagentAgents: []evaluator.KagentAgentResource{
+			{Name: "ops", Namespace: "apps", Tools: []evaluator.KagentToolRef{{Type: "McpServer", Kind: "RemoteMCPServer", Name: "ghost"}}},
+		},
+	}
+	policy := evaluator.DefaultPolicy()
+	mcp03 := func(result *evaluator.EvaluationResult) ControlResult {
+		for _, c := range BuildReport(DefaultFrameworks()[FrameworkOWASPMCP], result, state).Controls {
+			if c.ID == "MCP03" {
+				return c
+			}
+		}
+		t.Fatal("MCP03 missing from the OWASP MCP report")
+		return ControlResult{}
+	}
+
+	c := mcp03(evaluator.Evaluate(state, policy))
+	if c.Status != StatusFail || len(c.Findings) != 1 || c.Findings[0].ID != "AGENT-001-ops-ghost" {
+		t.Errorf("MCP03 = %s, findings %+v", c.Status, c.Findings)
+	}
+
+	state.GovernanceExceptions = []evaluator.GovernanceExceptionResource{{
+		Name: "ghost", Namespace: "apps", FindingIDPrefix: "AGENT-001",
+		Justification: "server is being migrated", Approver: "secops", ExpiresAt: time.Now().Add(24 * time.Hour),
+	}}
+	c = mcp03(evaluator.Evaluate(state, policy))
+	if len(c.Findings) != 0 || len(c.Waived) != 1 || c.Waived[0].ID != "AGENT-001-ops-ghost" {
+		t.Errorf("waived: findings %+v, waived %+v", c.Findings, c.Waived)
+	}
+}
+
+func TestMappingLoader_ConfigMapOverride(t *testing.T) {
+	dir := t.TempDir()
+	owasp := `
+name: OWASP MCP Top 10 (house mapping)
+controls:
+  - id: MCP07
+    title: Insufficient Authentication & Authorization
+    checks: [AUTH-, RBAC-]
+`
+	if err := os.WriteFile(filepath.Join(dir, "owasp-mcp.yaml"), []byte(owasp), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "iso-27001"), []byte(`{"name":"ISO 27001","controls":[{"id":"A.8.20","checks":["TLS-"]}]}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "broken"), []byte("controls: [unterminated"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	frameworks := NewMappingLoader(dir).Get()
+
+	if fw := frameworks[FrameworkOWASPMCP]; len(fw.Controls) != 1 || fw.Name != "OWASP MCP Top 10 (house mapping)" {
+		t.Errorf("owasp-mcp not overridden: %+v", fw)
+	}
+	if fw, ok := frameworks["iso-27001"]; !ok || fw.Controls[0].ID != "A.8.20" {
+		t.Errorf("ConfigMap should add new framework, got %+v", fw)
+	}
+	if _, ok := frameworks["broken"]; ok {
+		t.Error("Unparseable mapping should be skipped")
+	}
+	if len(frameworks[FrameworkNIST].Controls) == 0 {
+		t.Error("Frameworks without a key should keep their built-in mapping")
+	}
+}
+
+func TestMappingLoader_FallsBackToDefaults_WhenPathMissing(t *testing.T) {
+	frameworks := NewMappingLoader("/nonexistent/compliance").Get()
+	if len(frameworks) != len(DefaultFrameworks()) {
+		t.Errorf("Expected built-in frameworks, got %v", FrameworkIDs(frameworks))
+	}
+}
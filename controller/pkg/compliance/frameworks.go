@@ -0,0 +1,237 @@
+// Package compliance maps governance findings onto external compliance
+// frameworks (OWASP MCP Top 10, NIST SP 800-53, SOC 2) and builds
+// per-control pass / fail / not-applicable reports.
+//
+// Built-in mappings can be replaced per framework — or new frameworks added —
+// from a Kubernetes ConfigMap (mcp-governance-compliance) mounted into the
+// controller; see MappingLoader.
+package compliance
+
+// Framework identifiers accepted by the compliance API.
+const (
+	FrameworkOWASPMCP = "owasp-mcp"
+	FrameworkNIST     = "nist-800-53"
+	FrameworkSOC2     = "soc2"
+)
+
+// Framework is a compliance framework and the mapping of its controls to
+// governance checks.
+type Framework struct {
+	ID       string    `json:"id"`
+	Name     string    `json:"name"`
+	Version  string    `json:"version,omitempty"`
+	Controls []Control `json:"controls"`
+}
+
+// Control is a single framework control.
+type Control struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+
+	// Checks are finding ID prefixes evidencing this control, e.g. "TLS-003"
+	// for one check or "HDN-" for the whole hardening family. A control with
+	// no checks is not assessed automatically and reports not-applicable.
+	Checks []string `json:"checks"`
+
+	// ResourceKinds are the resource kinds the control applies to (see
+	// evaluator.CustomRuleTargetKinds). When the cluster has none of them and
+	// no mapped finding fired, the control is not-applicable.
+	ResourceKinds []string `json:"resourceKinds,omitempty"`
+}
+
+// DefaultFrameworks returns the built-in framework mappings keyed by ID.
+func DefaultFrameworks() map[string]Framework {
+	return map[string]Framework{
+		FrameworkOWASPMCP: owaspMCP(),
+		FrameworkNIST:     nist80053(),
+		FrameworkSOC2:     soc2(),
+	}
+}
+
+func owaspMCP() Framework {
+	return Framework{
+		ID:      FrameworkOWASPMCP,
+		Name:    "OWASP MCP Top 10",
+		Version: "2025",
+		Controls: []Control{
+			{
+				ID: "MCP01", Title: "Token Mismanagement & Secret Exposure",
+				Checks:        []string{"AUTH-005", "AUTH-006", "AUTH-007", "AUTH-008", "TLS-", "HDN-008", "HDN-009", "SKL-SEC-004", "SKL-SEC-008"},
+				ResourceKinds: []string{"AgentgatewayPolicy", "AgentgatewayBackend", "Workload", "SkillCatalog"},
+			},
+			{
+				ID: "MCP02", Title: "Privilege Escalation via Scope Creep",
+				Checks:        []string{"TOOLS-", "KRBAC-", "RBAC-100", "HDN-001", "HDN-003", "HDN-004", "HDN-011", "HDN-012", "HDN-013", "HDN-014", "SKL-SEC-002", "SKL-SEC-005", "SKL-SEC-009"},
+				ResourceKinds: []string{"RemoteMCPServer", "MCPServer", "AgentgatewayBackend", "Workload", "SkillCatalog"},
+			},
+			{
+				ID: "MCP03", Title: "Tool Poisoning",
+				Checks:        []string{"AGW-200", "AGENT-", "SKL-SEC-006", "SKL-SEC-010", "PROBE-"},
+				ResourceKinds: []string{"Agent", "SkillCatalog"},
+			},
+			{
+				ID: "MCP04", Title: "Software Supply Chain Attacks & Dependency Tampering",
+				Checks: []string{"HDN-006", "HDN-010", "HDN-016", "HDN-017", "HDN-018", "HDN-019", "VULN-",
+					"SKL-001", "SKL-002", "SKL-003", "SKL-004", "SKL-005", "SKL-006",
+					"SKL-007", "SKL-008", "SKL-SEC-007", "SKL-SEC-011", "SKL-SEC-013"},
+				ResourceKinds: []string{"Workload", "SkillCatalog"},
+			},
+			{
+				ID: "MCP05", Title: "Command Injection & Execution",
+				Checks:        []string{"HDN-002", "HDN-005", "SKL-SEC-012"},
+				ResourceKinds: []string{"Workload", "SkillCatalog"},
+			},
+			{
+				ID: "MCP06", Title: "Prompt Injection via Contextual Payloads",
+				Checks:        []string{"PG-", "SKL-SEC-001"},
+				ResourceKinds: []string{"AgentgatewayBackend", "SkillCatalog"},
+			},
+			{
+				ID: "MCP07", Title: "Insufficient Authentication & Authorization",
+				Checks:        []string{"AUTH-001", "AUTH-002", "AUTH-009", "AUTH-010", "AUTH-011", "AUTH-012", "AUTH-100", "RBAC-001", "RBAC-002", "RBAC-003", "RBAC-004", "RBAC-005", "RBAC-006", "CORS-"},
+				ResourceKinds: []string{"Gateway", "AgentgatewayBackend", "AgentgatewayPolicy"},
+			},
+			{
+				ID: "MCP08", Title: "Lack of Audit and Telemetry",
+			},
+			{
+				ID: "MCP09", Title: "Shadow MCP Servers",
+				Checks:        []string{"AGW-001", "AGW-002", "AGW-003", "AGW-004", "AGW-100", "EXP-", "HDN-000"},
+				ResourceKinds: []string{"MCPServer", "RemoteMCPServer", "Service", "AgentgatewayBackend"},
+			},
+			{
+				ID: "MCP10", Title: "Context Injection & Over-Sharing",
+				Checks:        []string{"HDN-007", "HDN-015", "RL-", "SKL-SEC-003"},
+				ResourceKinds: []string{"Workload", "AgentgatewayBackend", "SkillCatalog"},
+			},
+		},
+	}
+}
+
+func nist80053() Framework {
+	return Framework{
+		ID:      FrameworkNIST,
+		Name:    "NIST SP 800-53",
+		Version: "Rev. 5",
+		Controls: []Control{
+			{
+				ID: "AC-3", Title: "Access Enforcement",
+				Checks:        []string{"RBAC-"},
+				ResourceKinds: []string{"AgentgatewayBackend", "AgentgatewayPolicy"},
+			},
+			{
+				ID: "AC-4", Title: "Information Flow Enforcement",
+				Checks:        []string{"CORS-", "SKL-SEC-003"},
+				ResourceKinds: []string{"AgentgatewayBackend", "AgentgatewayPolicy", "SkillCatalog"},
+			},
+			{
+				ID: "AC-6", Title: "Least Privilege",
+				Checks:        []string{"TOOLS-", "KRBAC-", "HDN-001", "HDN-003", "HDN-004", "HDN-011", "HDN-012", "HDN-013", "HDN-014", "SKL-SEC-002", "SKL-SEC-005", "SKL-SEC-009"},
+				ResourceKinds: []string{"RemoteMCPServer", "MCPServer", "Workload", "SkillCatalog"},
+			},
+			{
+				ID: "AU-2", Title: "Event Logging",
+			},
+			{
+				ID: "CM-6", Title: "Configuration Settings",
+				Checks:        []string{"HDN-000", "HDN-002", "HDN-005", "SKL-004", "SKL-005", "SKL-006", "SKL-SEC-012", "SKL-SEC-013"},
+				ResourceKinds: []string{"Workload", "SkillCatalog"},
+			},
+			{
+				ID: "CM-8", Title: "System Component Inventory",
+				Checks:        []string{"AGW-200", "AGENT-", "SKL-002", "SKL-008", "PROBE-"},
+				ResourceKinds: []string{"Agent", "SkillCatalog"},
+			},
+			{
+				ID: "IA-2", Title: "Identification and Authentication",
+				Checks:        []string{"AUTH-001", "AUTH-002", "AUTH-009", "AUTH-010", "AUTH-011", "AUTH-012", "AUTH-100"},
+				ResourceKinds: []string{"AgentgatewayBackend", "AgentgatewayPolicy"},
+			},
+			{
+				ID: "IA-5", Title: "Authenticator Management",
+				Checks:        []string{"AUTH-005", "AUTH-006", "AUTH-007", "AUTH-008", "HDN-008", "HDN-009", "SKL-SEC-004", "SKL-SEC-008"},
+				ResourceKinds: []string{"AgentgatewayPolicy", "Workload", "SkillCatalog"},
+			},
+			{
+				ID: "SC-5", Title: "Denial-of-Service Protection",
+				Checks:        []string{"RL-", "HDN-015"},
+				ResourceKinds: []string{"AgentgatewayBackend", "Workload"},
+			},
+			{
+				ID: "SC-7", Title: "Boundary Protection",
+				Checks:        []string{"AGW-", "EXP-", "HDN-007", "HDN-012"},
+				ResourceKinds: []string{"Gateway", "MCPServer", "RemoteMCPServer", "Workload"},
+			},
+			{
+				ID: "SC-8", Title: "Transmission Confidentiality and Integrity",
+				Checks:        []string{"TLS-", "SKL-003"},
+				ResourceKinds: []string{"Gateway", "AgentgatewayBackend", "SkillCatalog"},
+			},
+			{
+				ID: "SI-2", Title: "Flaw Remediation",
+				Checks:        []string{"VULN-"},
+				ResourceKinds: []string{"Workload"},
+			},
+			{
+				ID: "SI-7", Title: "Software, Firmware, and Information Integrity",
+				Checks:        []string{"HDN-006", "HDN-010", "HDN-016", "HDN-017", "HDN-018", "HDN-019", "SKL-001", "SKL-007", "SKL-SEC-011"},
+				ResourceKinds: []string{"Workload", "SkillCatalog"},
+			},
+			{
+				ID: "SI-10", Title: "Information Input Validation",
+				Checks:        []string{"PG-", "SKL-SEC-001", "SKL-SEC-006", "SKL-SEC-007", "SKL-SEC-010"},
+				ResourceKinds: []string{"AgentgatewayBackend", "SkillCatalog"},
+			},
+		},
+	}
+}
+
+func soc2() Framework {
+	return Framework{
+		ID:      FrameworkSOC2,
+		Name:    "SOC 2 Trust Services Criteria",
+		Version: "2017 (rev. 2022)",
+		Controls: []Control{
+			{
+				ID: "CC6.1", Title: "Logical access security software, infrastructure and architectures",
+				Checks:        []string{"AUTH-", "RBAC-001", "RBAC-002", "RBAC-003", "RBAC-004", "RBAC-005", "RBAC-006", "HDN-008", "HDN-009", "SKL-SEC-004", "SKL-SEC-008"},
+				ResourceKinds: []string{"AgentgatewayBackend", "AgentgatewayPolicy", "Workload", "SkillCatalog"},
+			},
+			{
+				ID: "CC6.3", Title: "Role-based access and least privilege",
+				Checks:        []string{"TOOLS-", "KRBAC-", "RBAC-100", "HDN-001", "HDN-003", "HDN-004", "HDN-011", "HDN-012", "HDN-013", "HDN-014", "SKL-SEC-002", "SKL-SEC-005", "SKL-SEC-009"},
+				ResourceKinds: []string{"RemoteMCPServer", "MCPServer", "AgentgatewayBackend", "Workload", "SkillCatalog"},
+			},
+			{
+				ID: "CC6.6", Title: "Protection against threats from outside system boundaries",
+				Checks:        []string{"AGW-001", "AGW-002", "AGW-003", "AGW-004", "AGW-100", "EXP-", "HDN-007", "HDN-012", "HDN-015", "CORS-", "RL-"},
+				ResourceKinds: []string{"Gateway", "MCPServer", "RemoteMCPServer", "AgentgatewayBackend", "Workload"},
+			},
+			{
+				ID: "CC6.7", Title: "Restriction of data transmission and movement",
+				Checks:        []string{"TLS-", "SKL-003", "SKL-SEC-003"},
+				ResourceKinds: []string{"Gateway", "AgentgatewayBackend", "SkillCatalog"},
+			},
+			{
+				ID: "CC6.8", Title: "Prevention of unauthorized or malicious software",
+				Checks: []string{"HDN-006", "HDN-010", "HDN-016", "HDN-017", "HDN-018", "HDN-019",
+					"PG-", "SKL-SEC-001", "SKL-SEC-006", "SKL-SEC-007",
+					"SKL-SEC-010", "SKL-SEC-011", "SKL-SEC-012"},
+				ResourceKinds: []string{"Workload", "AgentgatewayBackend", "SkillCatalog"},
+			},
+			{
+				ID: "CC7.1", Title: "Detection of configuration changes and vulnerabilities",
+				Checks:        []string{"HDN-000", "HDN-002", "HDN-005", "VULN-", "SKL-004", "SKL-005", "SKL-006", "SKL-SEC-013"},
+				ResourceKinds: []string{"Workload", "SkillCatalog"},
+			},
+			{
+				ID: "CC7.2", Title: "Monitoring of system components for anomalies",
+			},
+			{
+				ID: "CC8.1", Title: "Change management",
+				Checks:        []string{"AGW-200", "AGENT-", "SKL-001", "SKL-002", "SKL-007", "SKL-008", "PROBE-"},
+				ResourceKinds: []string{"Agent", "SkillCatalog"},
+			},
+		},
+	}
+}
@@ -0,0 +1,119 @@
+package compliance
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// DefaultMountPath is where the mcp-governance-compliance ConfigMap is mounted.
+const DefaultMountPath = "/etc/mcp-governance/compliance"
+
+// MappingLoader loads framework mappings from a mounted ConfigMap directory.
+// Each key is a framework ID (optionally suffixed .yaml / .yml / .json) whose
+// value is a YAML or JSON Framework document; it replaces the built-in mapping
+// for that framework, or adds a new framework. Frameworks without a key keep
+// their built-in mapping.
+type MappingLoader struct {
+	mu         sync.RWMutex
+	mountPath  string
+	frameworks map[string]Framework
+	loadedAt   time.Time
+	reloadTTL  time.Duration
+}
+
+// NewMappingLoader creates a MappingLoader that reads from mountPath.
+func NewMappingLoader(mountPath string) *MappingLoader {
+	ml := &MappingLoader{
+		mountPath: mountPath,
+		reloadTTL: 30 * time.Second,
+	}
+	ml.load()
+	return ml
+}
+
+// Get returns the current framework mappings, reloading from disk when the TTL has expired.
+func (ml *MappingLoader) Get() map[string]Framework {
+	ml.mu.RLock()
+	needsReload := ml.frameworks == nil || time.Since(ml.loadedAt) > ml.reloadTTL
+	ml.mu.RUnlock()
+
+	if needsReload {
+		ml.load()
+	}
+
+	ml.mu.RLock()
+	defer ml.mu.RUnlock()
+	return ml.frameworks
+}
+
+// load reads the mount directory and overlays any framework documents on the
+// built-in defaults. Unparseable keys are logged and skipped.
+func (ml *MappingLoader) load() {
+	ml.mu.Lock()
+	defer ml.mu.Unlock()
+
+	frameworks := DefaultFrameworks()
+	entries, err := os.ReadDir(ml.mountPath)
+	if err != nil {
+		ml.frameworks = frameworks
+		ml.loadedAt = time.Now()
+		return
+	}
+
+	overridden := 0
+	for _, e := range entries {
+		// ConfigMap mounts expose keys as symlinks next to ..data dirs
+		if e.IsDir() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(ml.mountPath, e.Name()))
+		if err != nil {
+			continue
+		}
+		fw, err := ParseFramework(e.Name(), content)
+		if err != nil {
+			log.Printf("[compliance] Ignoring mapping %s: %v", e.Name(), err)
+			continue
+		}
+		frameworks[fw.ID] = fw
+		overridden++
+	}
+	if overridden > 0 {
+		log.Printf("[compliance] Loaded %d framework mapping(s) from %s", overridden, ml.mountPath)
+	}
+	ml.frameworks = frameworks
+	ml.loadedAt = time.Now()
+}
+
+// ParseFramework parses a framework document stored under the given
+// ConfigMap key. The framework ID defaults to the key without its extension.
+func ParseFramework(key string, content []byte) (Framework, error) {
+	var fw Framework
+	if err := yaml.Unmarshal(content, &fw); err != nil {
+		return Framework{}, err
+	}
+	if fw.ID == "" {
+		fw.ID = strings.TrimSuffix(key, filepath.Ext(key))
+	}
+	if fw.Name == "" {
+		fw.Name = fw.ID
+	}
+	return fw, nil
+}
+
+// FrameworkIDs returns the sorted IDs of the given frameworks.
+func FrameworkIDs(frameworks map[string]Framework) []string {
+	ids := make([]string, 0, len(frameworks))
+	for id := range frameworks {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
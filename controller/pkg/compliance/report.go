@@ -0,0 +1,154 @@
+package compliance
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/evaluator"
+)
+
+// Control statuses.
+const (
+	StatusPass          = "pass"
+	StatusFail          = "fail"
+	StatusNotApplicable = "not-applicable"
+)
+
+// ControlResult is the outcome of one control.
+type ControlResult struct {
+	ID        string                    `json:"id"`
+	Title     string                    `json:"title"`
+	Status    string                    `json:"status"`
+	Reason    string                    `json:"reason"`
+	Checks    []string                  `json:"checks"`
+	Findings  []evaluator.Finding       `json:"findings"`
+	Waived    []evaluator.WaivedFinding `json:"waived,omitempty"`
+	Resources []string                  `json:"resources"`
+}
+
+// ReportSummary counts control outcomes.
+type ReportSummary struct {
+	Pass          int `json:"pass"`
+	Fail          int `json:"fail"`
+	NotApplicable int `json:"notApplicable"`
+	// PassRate is the percentage of applicable controls that pass.
+	PassRate int `json:"passRate"`
+}
+
+// Report is a per-framework compliance report.
+type Report struct {
+	Framework string          `json:"framework"`
+	Name      string          `json:"name"`
+	Version   string          `json:"version,omitempty"`
+	Summary   ReportSummary   `json:"summary"`
+	Controls  []ControlResult `json:"controls"`
+}
+
+// matchesCheck reports whether a finding ID is evidence for any of checks.
+func matchesCheck(findingID string, checks []string) bool {
+	for _, c := range checks {
+		if c != "" && strings.HasPrefix(findingID, c) {
+			return true
+		}
+	}
+	return false
+}
+
+// BuildReport evaluates each control of fw against an evaluation result.
+// A control fails when any mapped finding is open, is not-applicable when it
+// has no mapped checks or none of its resource kinds exist in the cluster, and
+// passes otherwise. Waived findings are listed but do not fail a control.
+func BuildReport(fw Framework, result *evaluator.EvaluationResult, state *evaluator.ClusterState) Report {
+	report := Report{
+		Framework: fw.ID,
+		Name:      fw.Name,
+		Version:   fw.Version,
+		Controls:  make([]ControlResult, 0, len(fw.Controls)),
+	}
+	if result == nil {
+		result = &evaluator.EvaluationResult{}
+	}
+	if state == nil {
+		state = &evaluator.ClusterState{}
+	}
+
+	for _, c := range fw.Controls {
+		cr := ControlResult{
+			ID:        c.ID,
+			Title:     c.Title,
+			Checks:    append([]string{}, c.Checks...),
+			Findings:  []evaluator.Finding{},
+			Resources: []string{},
+		}
+		resources := map[string]bool{}
+		for _, f := range result.Findings {
+			if matchesCheck(f.ID, c.Checks) {
+				cr.Findings = append(cr.Findings, f)
+				if f.ResourceRef != "" {
+					resources[f.ResourceRef] = true
+				}
+			}
+		}
+		for _, wf := range result.WaivedFindings {
+			if matchesCheck(wf.ID, c.Checks) {
+				cr.Waived = append(cr.Waived, wf)
+			}
+		}
+
+		switch {
+		case len(c.Checks) == 0:
+			cr.Status = StatusNotApplicable
+			cr.Reason = "No automated check is mapped to this control"
+		case len(cr.Findings) > 0:
+			cr.Status = StatusFail
+			cr.Reason = "Open findings map to this control"
+		default:
+			for _, kind := range c.ResourceKinds {
+				for _, ref := range evaluator.ResourceRefsOfKind(state, kind) {
+					resources[ref] = true
+				}
+			}
+			if len(c.ResourceKinds) > 0 && len(resources) == 0 {
+				cr.Status = StatusNotApplicable
+				cr.Reason = "No in-scope resources in the cluster"
+			} else {
+				cr.Status = StatusPass
+				cr.Reason = "No open findings for the mapped checks"
+			}
+		}
+
+		for ref := range resources {
+			cr.Resources = append(cr.Resources, ref)
+		}
+		sort.Strings(cr.Resources)
+
+		switch cr.Status {
+		case StatusPass:
+			report.Summary.Pass++
+		case StatusFail:
+			report.Summary.Fail++
+		default:
+			report.Summary.NotApplicable++
+		}
+		report.Controls = append(report.Controls, cr)
+	}
+
+	if applicable := report.Summary.Pass + report.Summary.Fail; applicable > 0 {
+		report.Summary.PassRate = report.Summary.Pass * 100 / applicable
+	}
+	return report
+}
+
+// ControlsForFinding returns, per framework, the IDs of the controls a finding
+// ID is mapped to.
+func ControlsForFinding(frameworks map[string]Framework, findingID string) map[string][]string {
+	out := map[string][]string{}
+	for id, fw := range frameworks {
+		for _, c := range fw.Controls {
+			if matchesCheck(findingID, c.Checks) {
+				out[id] = append(out[id], c.ID)
+			}
+		}
+	}
+	return out
+}
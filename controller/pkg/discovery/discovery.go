@@ -2,14 +2,24 @@ package discovery
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"log"
 	"os"
 	"path/filepath"
+	"slices"
+	"sort"
 	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
@@ -73,7 +83,7 @@ func (d *K8sDiscoverer) DiscoverClusterState(ctx context.Context) *evaluator.Clu
 	state := &evaluator.ClusterState{}
 
 	// Discover namespaces
-	state.Namespaces = d.discoverNamespaces(ctx)
+	state.Namespaces, state.NamespaceMeta = d.discoverNamespaces(ctx)
 
 	// Discover Gateway API resources
 	state.Gateways = d.discoverGateways(ctx)
@@ -91,36 +101,60 @@ func (d *K8sDiscoverer) DiscoverClusterState(ctx context.Context) *evaluator.Clu
 	// Discover Services with MCP labels/appProtocol
 	state.Services = d.discoverServices(ctx)
 
+	// Discover Ingresses and OpenShift Routes for gateway bypass checks
+	state.Ingresses = d.discoverIngresses(ctx)
+	state.Routes = d.discoverRoutes(ctx)
+
 	// Discover workloads (Deployments + StatefulSets) for hardening checks
 	state.Workloads = d.discoverWorkloads(ctx)
 
 	// Discover NetworkPolicies for network segmentation checks
 	state.NetworkPolicies = d.discoverNetworkPolicies(ctx)
 
+	// Discover ServiceAccounts, Roles and bindings for workload RBAC checks
+	state.ServiceAccounts = d.discoverServiceAccounts(ctx)
+	state.Roles = d.discoverRoles(ctx)
+	state.RoleBindings = d.discoverRoleBindings(ctx)
+
+	// Discover Trivy Operator scan reports for vulnerability scoring
+	rsOwners := d.discoverReplicaSetOwners(ctx)
+	state.VulnerabilityReports = d.discoverVulnerabilityReports(ctx, rsOwners)
+	state.ConfigAuditReports = d.discoverConfigAuditReports(ctx, rsOwners)
+
 	// Discover SkillCatalog CRs (agentregistry.dev/v1alpha1)
 	state.SkillCatalogs = d.discoverSkillCatalogs(ctx)
 
-	log.Printf("[discovery] Found: %d gateways, %d backends, %d policies, %d routes, %d agents, %d mcpservers, %d remote-mcpservers, %d services, %d namespaces, %d workloads, %d networkpolicies, %d skillcatalogs",
+	// Discover GovernanceException waivers (governance.mcp.io/v1alpha1)
+	state.GovernanceExceptions = d.discoverGovernanceExceptions(ctx)
+
+	log.Printf("[discovery] Found: %d gateways, %d backends, %d policies, %d routes, %d agents, %d mcpservers, %d remote-mcpservers, %d services, %d ingresses, %d openshift-routes, %d namespaces, %d workloads, %d networkpolicies, %d serviceaccounts, %d roles, %d rolebindings, %d vulnerabilityreports, %d configauditreports, %d skillcatalogs, %d exceptions",
 		len(state.Gateways), len(state.AgentgatewayBackends), len(state.AgentgatewayPolicies),
 		len(state.HTTPRoutes), len(state.KagentAgents), len(state.KagentMCPServers),
-		len(state.KagentRemoteMCPServers), len(state.Services), len(state.Namespaces),
-		len(state.Workloads), len(state.NetworkPolicies), len(state.SkillCatalogs))
+		len(state.KagentRemoteMCPServers), len(state.Services), len(state.Ingresses),
+		len(state.Routes), len(state.Namespaces),
+		len(state.Workloads), len(state.NetworkPolicies), len(state.ServiceAccounts),
+		len(state.Roles), len(state.RoleBindings), len(state.VulnerabilityReports),
+		len(state.ConfigAuditReports), len(state.SkillCatalogs),
+		len(state.GovernanceExceptions))
 
 	return state
 }
 
-// discoverNamespaces lists all namespaces
-func (d *K8sDiscoverer) discoverNamespaces(ctx context.Context) []string {
+// discoverNamespaces lists all namespaces along with their labels and
+// annotations (used for team ownership resolution).
+func (d *K8sDiscoverer) discoverNamespaces(ctx context.Context) ([]string, map[string]evaluator.ObjectMeta) {
 	nsList, err := d.clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
 	if err != nil {
 		log.Printf("[discovery] Failed to list namespaces: %v", err)
-		return []string{"default"}
+		return []string{"default"}, nil
 	}
 	var namespaces []string
+	meta := make(map[string]evaluator.ObjectMeta, len(nsList.Items))
 	for _, ns := range nsList.Items {
 		namespaces = append(namespaces, ns.Name)
+		meta[ns.Name] = evaluator.ObjectMeta{Labels: ns.Labels, Annotations: ns.Annotations}
 	}
-	return namespaces
+	return namespaces, meta
 }
 
 // discoverGateways discovers Gateway API Gateway resources
@@ -141,6 +175,7 @@ func (d *K8sDiscoverer) discoverGatewayResources(ctx context.Context, gvr schema
 	}
 
 	var gateways []evaluator.GatewayResource
+	certCache := map[string]evaluator.CertificateInfo{}
 	for _, item := range list.Items {
 		gw := evaluator.GatewayResource{
 			Name:      item.GetName(),
@@ -162,6 +197,17 @@ func (d *K8sDiscoverer) discoverGatewayResources(ctx context.Context, gvr schema
 				li.Protocol, _ = getNestedString(lm, "protocol")
 				port, _ := getNestedInt(lm, "port")
 				li.Port = int(port)
+				li.Hostname, _ = getNestedString(lm, "hostname")
+				if tls, ok := getNestedMap(lm, "tls"); ok {
+					li.TLSMode, _ = getNestedString(tls, "mode")
+					if li.TLSMode == "" {
+						li.TLSMode = "Terminate"
+					}
+					li.CertificateRefs = listenerCertificateRefs(tls, gw.Namespace)
+					for _, ref := range li.CertificateRefs {
+						li.Certificates = append(li.Certificates, d.loadCertificate(ctx, ref, certCache))
+					}
+				}
 				gw.Listeners = append(gw.Listeners, li)
 			}
 		}
@@ -188,6 +234,283 @@ func (d *K8sDiscoverer) discoverGatewayResources(ctx context.Context, gvr schema
 	return gateways
 }
 
+// listenerCertificateRefs returns the namespace/name of the Secrets in a
+// listener's tls.certificateRefs. Refs default to the Gateway's namespace;
+// refs to kinds other than Secret are skipped.
+func listenerCertificateRefs(tls map[string]interface{}, gatewayNS string) []string {
+	var refs []string
+	certRefs, _ := getNestedSlice(tls, "certificateRefs")
+	for _, cr := range certRefs {
+		cm, ok := cr.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if kind, _ := getNestedString(cm, "kind"); kind != "" && kind != "Secret" {
+			continue
+		}
+		name, _ := getNestedString(cm, "name")
+		ns, _ := getNestedString(cm, "namespace")
+		if ns == "" {
+			ns = gatewayNS
+		}
+		if name != "" {
+			refs = append(refs, ns+"/"+name)
+		}
+	}
+	return refs
+}
+
+// loadCertificate reads the tls.crt of a listener's TLS Secret. Secrets shared
+// by several listeners are read once per discovery run.
+func (d *K8sDiscoverer) loadCertificate(ctx context.Context, ref string, cache map[string]evaluator.CertificateInfo) evaluator.CertificateInfo {
+	if info, ok := cache[ref]; ok {
+		return info
+	}
+	ns, name, _ := strings.Cut(ref, "/")
+	secret, err := d.clientset.CoreV1().Secrets(ns).Get(ctx, name, metav1.GetOptions{})
+	var info evaluator.CertificateInfo
+	if err != nil {
+		log.Printf("[discovery] Failed to read Gateway TLS Secret %s: %v", ref, err)
+		info = evaluator.CertificateInfo{Secret: ref, Error: err.Error()}
+	} else {
+		info = parseCertificate(ref, secret.Data[corev1.TLSCertKey])
+	}
+	cache[ref] = info
+	return info
+}
+
+// parseCertificate extracts expiry, SANs and key size from the leaf (first)
+// certificate of a PEM chain.
+func parseCertificate(ref string, pemData []byte) evaluator.CertificateInfo {
+	info := evaluator.CertificateInfo{Secret: ref}
+	block, _ := pem.Decode(pemData)
+	if block == nil || block.Type != "CERTIFICATE" {
+		info.Error = "no PEM certificate in " + corev1.TLSCertKey
+		return info
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		info.Error = err.Error()
+		return info
+	}
+	info.Subject = cert.Subject.String()
+	info.Issuer = cert.Issuer.String()
+	info.NotBefore = cert.NotBefore
+	info.NotAfter = cert.NotAfter
+	info.DNSNames = cert.DNSNames
+	switch key := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		info.KeyAlgorithm, info.KeySize = "RSA", key.N.BitLen()
+	case *ecdsa.PublicKey:
+		info.KeyAlgorithm, info.KeySize = "ECDSA", key.Curve.Params().BitSize
+	case ed25519.PublicKey:
+		info.KeyAlgorithm, info.KeySize = "Ed25519", 256
+	}
+	return info
+}
+
+// parseJWTProviders extracts the providers of a jwtAuthentication block. Both
+// the single-provider form (issuer/audiences/jwks next to mode) and a
+// providers list are accepted.
+func parseJWTProviders(jwt map[string]interface{}, policyNS string) []evaluator.JWTProvider {
+	var out []evaluator.JWTProvider
+	if providers, ok := getNestedSlice(jwt, "providers"); ok {
+		for _, pr := range providers {
+			if pm, ok := pr.(map[string]interface{}); ok {
+				out = append(out, parseJWTProvider(pm, policyNS))
+			}
+		}
+		return out
+	}
+	_, hasIssuer := jwt["issuer"]
+	_, hasJWKS := jwt["jwks"]
+	if hasIssuer || hasJWKS {
+		out = append(out, parseJWTProvider(jwt, policyNS))
+	}
+	return out
+}
+
+// parseJWTProvider reads the issuer, audiences, JWKS source, algorithms and
+// required claims of one JWT provider. Algorithms implied by inline JWKS keys
+// (their "alg", or HS256 for symmetric "oct" keys) are included.
+func parseJWTProvider(pm map[string]interface{}, policyNS string) evaluator.JWTProvider {
+	prov := evaluator.JWTProvider{}
+	prov.Issuer, _ = getNestedString(pm, "issuer")
+	audiences, _ := getNestedSlice(pm, "audiences")
+	prov.Audiences = toStringSlice(audiences)
+
+	for _, key := range []string{"algorithms", "allowedAlgorithms"} {
+		algs, _ := getNestedSlice(pm, key)
+		prov.Algorithms = append(prov.Algorithms, toStringSlice(algs)...)
+	}
+	switch claims := pm["requiredClaims"].(type) {
+	case []interface{}:
+		prov.RequiredClaims = toStringSlice(claims)
+	case map[string]interface{}:
+		for c := range claims {
+			prov.RequiredClaims = append(prov.RequiredClaims, c)
+		}
+		sort.Strings(prov.RequiredClaims)
+	}
+
+	if uri, ok := getNestedString(pm, "jwksUri"); ok && uri != "" {
+		prov.JWKSSource, prov.JWKSURL = "remote", uri
+	}
+	jwks, _ := getNestedMap(pm, "jwks")
+	if remote, ok := getNestedMap(jwks, "remote"); ok {
+		prov.JWKSSource = "remote"
+		for _, key := range []string{"url", "uri", "jwksUri"} {
+			if u, _ := getNestedString(remote, key); u != "" {
+				prov.JWKSURL = u
+			}
+		}
+		if prov.JWKSURL == "" {
+			path, _ := getNestedString(remote, "jwksPath")
+			if ref, ok := getNestedMap(remote, "backendRef"); ok {
+				name, _ := getNestedString(ref, "name")
+				ns, _ := getNestedString(ref, "namespace")
+				if ns == "" {
+					ns = policyNS
+				}
+				prov.JWKSURL = ns + "/" + name + path
+			}
+		}
+	}
+	if inline, ok := jwks["inline"]; ok {
+		prov.JWKSSource = "inline"
+		for _, alg := range inlineJWKSAlgorithms(inline) {
+			if !slices.Contains(prov.Algorithms, alg) {
+				prov.Algorithms = append(prov.Algorithms, alg)
+			}
+		}
+	}
+	return prov
+}
+
+// parseCORSConfig reads the origins, methods, headers, credentials flag and
+// max age of a cors block.
+func parseCORSConfig(cors map[string]interface{}) *evaluator.CORSConfig {
+	c := &evaluator.CORSConfig{}
+	origins, _ := getNestedSlice(cors, "allowOrigins")
+	c.AllowOrigins = toStringSlice(origins)
+	methods, _ := getNestedSlice(cors, "allowMethods")
+	c.AllowMethods = toStringSlice(methods)
+	headers, _ := getNestedSlice(cors, "allowHeaders")
+	c.AllowHeaders = toStringSlice(headers)
+	c.AllowCredentials, _ = cors["allowCredentials"].(bool)
+	if maxAge, ok := getNestedInt(cors, "maxAge"); ok {
+		c.MaxAge = int(maxAge)
+	}
+	return c
+}
+
+// parseRateLimits reads the local rules (requests, unit, burst) of a
+// rateLimit block, given as a list or a single object, plus one entry for a
+// global (remote) rate limit, whose limits live in the rate limit service.
+func parseRateLimits(rl map[string]interface{}) []evaluator.RateLimitRule {
+	var out []evaluator.RateLimitRule
+	var locals []interface{}
+	switch local := rl["local"].(type) {
+	case []interface{}:
+		locals = local
+	case map[string]interface{}:
+		locals = []interface{}{local}
+	}
+	for _, l := range locals {
+		lm, ok := l.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		r := evaluator.RateLimitRule{Type: "local"}
+		r.Unit, _ = getNestedString(lm, "unit")
+		if v, ok := getNestedInt(lm, "requests"); ok {
+			r.Requests = int(v)
+		}
+		if v, ok := getNestedInt(lm, "burst"); ok {
+			r.Burst = int(v)
+		}
+		out = append(out, r)
+	}
+	if global, ok := getNestedMap(rl, "global"); ok && global != nil {
+		out = append(out, evaluator.RateLimitRule{Type: "global"})
+	}
+	return out
+}
+
+// parsePromptGuardRules reads the request and response guards of a
+// promptGuard block. Each entry may hold several guards (regex,
+// openAIModeration, webhook, ...); each becomes one rule. Entries may be
+// given as a list or a single object.
+func parsePromptGuardRules(pg map[string]interface{}) []evaluator.PromptGuardRule {
+	var out []evaluator.PromptGuardRule
+	for _, phase := range []string{"request", "response"} {
+		var entries []interface{}
+		switch v := pg[phase].(type) {
+		case []interface{}:
+			entries = v
+		case map[string]interface{}:
+			entries = []interface{}{v}
+		}
+		for _, e := range entries {
+			em, ok := e.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			kinds := make([]string, 0, len(em))
+			for k := range em {
+				kinds = append(kinds, k)
+			}
+			sort.Strings(kinds)
+			for _, kind := range kinds {
+				gm, ok := em[kind].(map[string]interface{})
+				// "response" inside a request entry is the rejection message.
+				if !ok || kind == "response" || kind == "rejection" {
+					continue
+				}
+				r := evaluator.PromptGuardRule{Phase: phase, Kind: kind}
+				r.Action, _ = getNestedString(gm, "action")
+				matches, _ := getNestedSlice(gm, "matches")
+				r.Matches = toStringSlice(matches)
+				builtins, _ := getNestedSlice(gm, "builtins")
+				r.Builtins = toStringSlice(builtins)
+				out = append(out, r)
+			}
+		}
+	}
+	return out
+}
+
+// inlineJWKSAlgorithms returns the algorithms of the keys in an inline JWKS,
+// given either as a JSON string or as an object.
+func inlineJWKSAlgorithms(inline interface{}) []string {
+	set, ok := inline.(map[string]interface{})
+	if s, isString := inline.(string); isString {
+		if err := json.Unmarshal([]byte(s), &set); err != nil {
+			return nil
+		}
+		ok = true
+	}
+	if !ok {
+		return nil
+	}
+	var algs []string
+	keys, _ := getNestedSlice(set, "keys")
+	for _, k := range keys {
+		km, ok := k.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		alg, _ := getNestedString(km, "alg")
+		if kty, _ := getNestedString(km, "kty"); alg == "" && kty == "oct" {
+			alg = "HS256"
+		}
+		if alg != "" && !slices.Contains(algs, alg) {
+			algs = append(algs, alg)
+		}
+	}
+	return algs
+}
+
 // discoverHTTPRoutes discovers HTTPRoute resources
 func (d *K8sDiscoverer) discoverHTTPRoutes(ctx context.Context) []evaluator.HTTPRouteResource {
 	gvr := schema.GroupVersionResource{
@@ -222,8 +545,12 @@ func (d *K8sDiscoverer) discoverHTTPRoutes(ctx context.Context) []evaluator.HTTP
 				hr.ParentGateway = name
 				ns, _ := getNestedString(pm, "namespace")
 				hr.ParentGatewayNamespace = ns
+				hr.ParentSectionName, _ = getNestedString(pm, "sectionName")
 			}
 
+			hostnames, _ := getNestedSlice(spec, "hostnames")
+			hr.Hostnames = toStringSlice(hostnames)
+
 			// Get backend refs and paths from rules
 			rules, _ := getNestedSlice(spec, "rules")
 			for _, r := range rules {
@@ -239,6 +566,11 @@ func (d *K8sDiscoverer) discoverHTTPRoutes(ctx context.Context) []evaluator.HTTP
 					}
 					name, _ := getNestedString(bm, "name")
 					hr.BackendRefs = append(hr.BackendRefs, name)
+					group, _ := getNestedString(bm, "group")
+					kind, _ := getNestedString(bm, "kind")
+					if group == "" && (kind == "" || kind == "Service") {
+						hr.ServiceBackendRefs = append(hr.ServiceBackendRefs, name)
+					}
 				}
 
 				// Extract path from matches
@@ -293,8 +625,10 @@ func (d *K8sDiscoverer) discoverAgentgatewayBackends(ctx context.Context) []eval
 	var backends []evaluator.AgentgatewayBackendResource
 	for _, item := range list.Items {
 		b := evaluator.AgentgatewayBackendResource{
-			Name:      item.GetName(),
-			Namespace: item.GetNamespace(),
+			Name:        item.GetName(),
+			Namespace:   item.GetNamespace(),
+			Labels:      item.GetLabels(),
+			Annotations: item.GetAnnotations(),
 		}
 
 		spec, _ := getNestedMap(item.Object, "spec")
@@ -405,6 +739,7 @@ func (d *K8sDiscoverer) discoverAgentgatewayPolicies(ctx context.Context) []eval
 			Name:      item.GetName(),
 			Namespace: item.GetNamespace(),
 		}
+		var authzRules []evaluator.AuthorizationRule
 
 		spec, _ := getNestedMap(item.Object, "spec")
 		if spec != nil {
@@ -456,12 +791,21 @@ func (d *K8sDiscoverer) discoverAgentgatewayPolicies(ctx context.Context) []eval
 							}
 						}
 					}
+					p.JWTProviders = parseJWTProviders(jwt, p.Namespace)
+					for _, prov := range p.JWTProviders {
+						for _, a := range prov.Audiences {
+							if !slices.Contains(p.JWTAudiences, a) {
+								p.JWTAudiences = append(p.JWTAudiences, a)
+							}
+						}
+					}
 				}
 
 				// CORS
 				cors, _ := getNestedMap(traffic, "cors")
 				if cors != nil {
 					p.HasCORS = true
+					p.CORS = parseCORSConfig(cors)
 				}
 
 				// CSRF
@@ -474,35 +818,21 @@ func (d *K8sDiscoverer) discoverAgentgatewayPolicies(ctx context.Context) []eval
 				rateLimit, _ := getNestedMap(traffic, "rateLimit")
 				if rateLimit != nil {
 					p.HasRateLimit = true
+					p.RateLimits = append(p.RateLimits, parseRateLimits(rateLimit)...)
 				}
 
-				// Authorization (RBAC) + extract allowed tools from CEL expressions
+				// Authorization (RBAC); the CEL rules are analysed below
 				authz, _ := getNestedMap(traffic, "authorization")
 				if authz != nil {
 					p.HasRBAC = true
-					action, _ := getNestedString(authz, "action")
-					if action == "Allow" {
-						policy, _ := getNestedMap(authz, "policy")
-						if policy != nil {
-							exprs, _ := getNestedSlice(policy, "matchExpressions")
-							for _, expr := range exprs {
-								exprStr, ok := expr.(string)
-								if !ok {
-									continue
-								}
-								// Extract tool names from CEL like:
-								// "mcp.tool.name in ['tool1', 'tool2', ...]"
-								tools := extractToolNamesFromCEL(exprStr)
-								p.AllowedTools = append(p.AllowedTools, tools...)
-							}
-						}
-					}
+					authzRules = append(authzRules, authorizationRules(authz, "traffic")...)
 				}
 
 				// Prompt guard
 				pg, _ := getNestedMap(traffic, "promptGuard")
 				if pg != nil {
 					p.HasPromptGuard = true
+					p.PromptGuardRules = append(p.PromptGuardRules, parsePromptGuardRules(pg)...)
 				}
 
 				// External auth (also serves as prompt guard/screening service)
@@ -515,29 +845,13 @@ func (d *K8sDiscoverer) discoverAgentgatewayPolicies(ctx context.Context) []eval
 			// Parse backend section (MCP-specific backend authorization)
 			backend, _ := getNestedMap(spec, "backend")
 			if backend != nil {
-				// MCP backend authorization + extract allowed tools from CEL expressions
+				// MCP backend (tool-level) authorization
 				mcp, _ := getNestedMap(backend, "mcp")
 				if mcp != nil {
 					authz, _ := getNestedMap(mcp, "authorization")
 					if authz != nil {
 						p.HasRBAC = true
-						action, _ := getNestedString(authz, "action")
-						if action == "Allow" {
-							policy, _ := getNestedMap(authz, "policy")
-							if policy != nil {
-								exprs, _ := getNestedSlice(policy, "matchExpressions")
-								for _, expr := range exprs {
-									exprStr, ok := expr.(string)
-									if !ok {
-										continue
-									}
-									// Extract tool names from CEL like:
-									// "mcp.tool.name in ['tool1', 'tool2', ...]"
-									tools := extractToolNamesFromCEL(exprStr)
-									p.AllowedTools = append(p.AllowedTools, tools...)
-								}
-							}
-						}
+						authzRules = append(authzRules, authorizationRules(authz, "backend.mcp")...)
 					}
 				}
 			}
@@ -567,6 +881,9 @@ func (d *K8sDiscoverer) discoverAgentgatewayPolicies(ctx context.Context) []eval
 				cors, _ := getNestedMap(defaults, "cors")
 				if cors != nil {
 					p.HasCORS = true
+					if p.CORS == nil {
+						p.CORS = parseCORSConfig(cors)
+					}
 				}
 				csrf, _ := getNestedMap(defaults, "csrf")
 				if csrf != nil {
@@ -575,6 +892,7 @@ func (d *K8sDiscoverer) discoverAgentgatewayPolicies(ctx context.Context) []eval
 				rateLimit, _ := getNestedMap(defaults, "rateLimit")
 				if rateLimit != nil {
 					p.HasRateLimit = true
+					p.RateLimits = append(p.RateLimits, parseRateLimits(rateLimit)...)
 				}
 				rbac, _ := getNestedMap(defaults, "rbac")
 				if rbac != nil {
@@ -583,6 +901,7 @@ func (d *K8sDiscoverer) discoverAgentgatewayPolicies(ctx context.Context) []eval
 				pg, _ := getNestedMap(defaults, "promptGuard")
 				if pg != nil {
 					p.HasPromptGuard = true
+					p.PromptGuardRules = append(p.PromptGuardRules, parsePromptGuardRules(pg)...)
 				}
 			}
 
@@ -594,11 +913,17 @@ func (d *K8sDiscoverer) discoverAgentgatewayPolicies(ctx context.Context) []eval
 					pg, _ := getNestedMap(ai, "promptGuard")
 					if pg != nil {
 						p.HasPromptGuard = true
+						p.PromptGuardRules = append(p.PromptGuardRules, parsePromptGuardRules(pg)...)
 					}
 				}
 			}
 		}
 
+		if len(authzRules) > 0 {
+			p.Authorization = evaluator.AnalyzeAuthorization(authzRules)
+			p.AllowedTools = p.Authorization.AllowedToolNames(nil)
+		}
+
 		policies = append(policies, p)
 	}
 	return policies
@@ -621,8 +946,10 @@ func (d *K8sDiscoverer) discoverKagentAgents(ctx context.Context) []evaluator.Ka
 	var agents []evaluator.KagentAgentResource
 	for _, item := range list.Items {
 		a := evaluator.KagentAgentResource{
-			Name:      item.GetName(),
-			Namespace: item.GetNamespace(),
+			Name:        item.GetName(),
+			Namespace:   item.GetNamespace(),
+			Labels:      item.GetLabels(),
+			Annotations: item.GetAnnotations(),
 		}
 
 		spec, _ := getNestedMap(item.Object, "spec")
@@ -709,8 +1036,10 @@ func (d *K8sDiscoverer) discoverKagentMCPServers(ctx context.Context) []evaluato
 	var servers []evaluator.KagentMCPServerResource
 	for _, item := range list.Items {
 		s := evaluator.KagentMCPServerResource{
-			Name:      item.GetName(),
-			Namespace: item.GetNamespace(),
+			Name:        item.GetName(),
+			Namespace:   item.GetNamespace(),
+			Labels:      item.GetLabels(),
+			Annotations: item.GetAnnotations(),
 		}
 
 		spec, _ := getNestedMap(item.Object, "spec")
@@ -753,13 +1082,16 @@ func (d *K8sDiscoverer) discoverKagentRemoteMCPServers(ctx context.Context) []ev
 	var servers []evaluator.KagentRemoteMCPServerResource
 	for _, item := range list.Items {
 		s := evaluator.KagentRemoteMCPServerResource{
-			Name:      item.GetName(),
-			Namespace: item.GetNamespace(),
+			Name:        item.GetName(),
+			Namespace:   item.GetNamespace(),
+			Labels:      item.GetLabels(),
+			Annotations: item.GetAnnotations(),
 		}
 
 		spec, _ := getNestedMap(item.Object, "spec")
 		if spec != nil {
 			s.URL, _ = getNestedString(spec, "url")
+			s.Protocol, _ = getNestedString(spec, "protocol")
 		}
 
 		// Count discovered tools from status and collect tool names
@@ -802,14 +1134,32 @@ func (d *K8sDiscoverer) discoverServices(ctx context.Context) []evaluator.Servic
 		}
 
 		sr := evaluator.ServiceResource{
-			Name:      svc.Name,
-			Namespace: svc.Namespace,
+			Name:        svc.Name,
+			Namespace:   svc.Namespace,
+			Labels:      svc.Labels,
+			Annotations: svc.Annotations,
+			Type:        string(svc.Spec.Type),
+			Selector:    svc.Spec.Selector,
+			ExternalIPs: svc.Spec.ExternalIPs,
+		}
+		if sr.Type == "" {
+			sr.Type = string(corev1.ServiceTypeClusterIP)
+		}
+		for _, lb := range svc.Status.LoadBalancer.Ingress {
+			if lb.Hostname != "" {
+				sr.LoadBalancerAddresses = append(sr.LoadBalancerAddresses, lb.Hostname)
+			} else if lb.IP != "" {
+				sr.LoadBalancerAddresses = append(sr.LoadBalancerAddresses, lb.IP)
+			}
 		}
 
 		// Check for MCP-related labels or appProtocol
 		isMCP := false
 		for _, port := range svc.Spec.Ports {
 			sr.Ports = append(sr.Ports, int(port.Port))
+			if port.NodePort != 0 {
+				sr.NodePorts = append(sr.NodePorts, int(port.NodePort))
+			}
 			if port.AppProtocol != nil {
 				sr.AppProtocol = *port.AppProtocol
 				if strings.Contains(*port.AppProtocol, "mcp") || strings.Contains(*port.AppProtocol, "kgateway.dev/mcp") {
@@ -831,8 +1181,126 @@ func (d *K8sDiscoverer) discoverServices(ctx context.Context) []evaluator.Servic
 	return services
 }
 
-// discoverWorkloads lists all Deployments and StatefulSets across all namespaces
-// and extracts pod-level security context fields needed for hardening checks.
+// discoverIngresses lists networking.k8s.io/v1 Ingresses across all namespaces.
+func (d *K8sDiscoverer) discoverIngresses(ctx context.Context) []evaluator.IngressResource {
+	list, err := d.clientset.NetworkingV1().Ingresses("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("[discovery] Failed to list Ingresses: %v", err)
+		return nil
+	}
+	var out []evaluator.IngressResource
+	for _, ing := range list.Items {
+		out = append(out, convertIngress(ing))
+	}
+	return out
+}
+
+// convertIngress flattens an Ingress into one rule per host/path/backend. The
+// default backend becomes a rule without host or path.
+func convertIngress(ing networkingv1.Ingress) evaluator.IngressResource {
+	ir := evaluator.IngressResource{
+		Name:      ing.Name,
+		Namespace: ing.Namespace,
+		HasTLS:    len(ing.Spec.TLS) > 0,
+	}
+	if ing.Spec.IngressClassName != nil {
+		ir.IngressClassName = *ing.Spec.IngressClassName
+	} else if cls := ing.Annotations["kubernetes.io/ingress.class"]; cls != "" {
+		ir.IngressClassName = cls
+	}
+	addRule := func(host, path string, backend *networkingv1.IngressBackend) {
+		if backend == nil || backend.Service == nil {
+			return
+		}
+		ir.Rules = append(ir.Rules, evaluator.IngressRule{
+			Host:        host,
+			Path:        path,
+			ServiceName: backend.Service.Name,
+			ServicePort: int(backend.Service.Port.Number),
+		})
+	}
+	addRule("", "", ing.Spec.DefaultBackend)
+	for _, r := range ing.Spec.Rules {
+		if r.HTTP == nil {
+			continue
+		}
+		for _, p := range r.HTTP.Paths {
+			addRule(r.Host, p.Path, &p.Backend)
+		}
+	}
+	for _, lb := range ing.Status.LoadBalancer.Ingress {
+		if lb.Hostname != "" {
+			ir.Addresses = append(ir.Addresses, lb.Hostname)
+		} else if lb.IP != "" {
+			ir.Addresses = append(ir.Addresses, lb.IP)
+		}
+	}
+	return ir
+}
+
+// discoverRoutes discovers OpenShift Routes (route.openshift.io/v1).
+func (d *K8sDiscoverer) discoverRoutes(ctx context.Context) []evaluator.RouteResource {
+	gvr := schema.GroupVersionResource{
+		Group:    "route.openshift.io",
+		Version:  "v1",
+		Resource: "routes",
+	}
+	list, err := d.dynamicClient.Resource(gvr).Namespace("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("[discovery] OpenShift Route CRD not available: %v", err)
+		return nil
+	}
+	var out []evaluator.RouteResource
+	for _, item := range list.Items {
+		out = append(out, parseRoute(item))
+	}
+	return out
+}
+
+// parseRoute extracts the host, path and target Services of an OpenShift Route.
+func parseRoute(item unstructured.Unstructured) evaluator.RouteResource {
+	rt := evaluator.RouteResource{
+		Name:      item.GetName(),
+		Namespace: item.GetNamespace(),
+	}
+	spec, _ := getNestedMap(item.Object, "spec")
+	if spec == nil {
+		return rt
+	}
+	rt.Host, _ = getNestedString(spec, "host")
+	rt.Path, _ = getNestedString(spec, "path")
+	if rt.Host == "" {
+		// spec.host is optional; the router records the generated one in status
+		status, _ := getNestedMap(item.Object, "status")
+		ingress, _ := getNestedSlice(status, "ingress")
+		if len(ingress) > 0 {
+			if im, ok := ingress[0].(map[string]interface{}); ok {
+				rt.Host, _ = getNestedString(im, "host")
+			}
+		}
+	}
+	addTarget := func(t interface{}) {
+		tm, ok := t.(map[string]interface{})
+		if !ok {
+			return
+		}
+		kind, _ := getNestedString(tm, "kind")
+		name, _ := getNestedString(tm, "name")
+		if name != "" && (kind == "" || kind == "Service") {
+			rt.Services = append(rt.Services, name)
+		}
+	}
+	addTarget(spec["to"])
+	alternates, _ := getNestedSlice(spec, "alternateBackends")
+	for _, a := range alternates {
+		addTarget(a)
+	}
+	_, rt.HasTLS = getNestedMap(spec, "tls")
+	return rt
+}
+
+// discoverWorkloads lists all Deployments, StatefulSets, DaemonSets, Jobs,
+// CronJobs and bare Pods across all namespaces and extracts pod-level security context fields needed for hardening checks.
 func (d *K8sDiscoverer) discoverWorkloads(ctx context.Context) []evaluator.WorkloadResource {
 	var workloads []evaluator.WorkloadResource
 
@@ -920,6 +1388,30 @@ func (d *K8sDiscoverer) discoverWorkloads(ctx context.Context) []evaluator.Workl
 					w.PlaintextEnvVarNames = append(w.PlaintextEnvVarNames, env.Name)
 				}
 			}
+
+			// CPU and memory limits
+			if _, ok := c.Resources.Limits[corev1.ResourceCPU]; !ok {
+				w.ContainersWithoutLimits = append(w.ContainersWithoutLimits, c.Name)
+			} else if _, ok := c.Resources.Limits[corev1.ResourceMemory]; !ok {
+				w.ContainersWithoutLimits = append(w.ContainersWithoutLimits, c.Name)
+			}
+		}
+
+		// Privileged mode and added capabilities — init containers run with the
+		// same node access, so they count too.
+		for _, c := range append(append([]corev1.Container{}, podSpec.InitContainers...), containers...) {
+			sc := c.SecurityContext
+			if sc == nil {
+				continue
+			}
+			if sc.Privileged != nil && *sc.Privileged {
+				w.PrivilegedContainers = append(w.PrivilegedContainers, c.Name)
+			}
+			if sc.Capabilities != nil {
+				for _, add := range sc.Capabilities.Add {
+					w.AddedCapabilities = append(w.AddedCapabilities, string(add))
+				}
+			}
 		}
 
 		w.AllContainersNonRoot = allNonRoot
@@ -929,6 +1421,10 @@ func (d *K8sDiscoverer) discoverWorkloads(ctx context.Context) []evaluator.Workl
 	}
 
 	extractPodMeta := func(podSpec corev1.PodSpec, annotations map[string]string, w *evaluator.WorkloadResource) {
+		// Pod identity (workload RBAC checks)
+		w.ServiceAccountName = podSpec.ServiceAccountName
+		w.AutomountServiceAccountToken = podSpec.AutomountServiceAccountToken
+
 		// Pod-level security context
 		if psc := podSpec.SecurityContext; psc != nil {
 			if psc.RunAsNonRoot != nil {
@@ -951,6 +1447,16 @@ func (d *K8sDiscoverer) discoverWorkloads(ctx context.Context) []evaluator.Workl
 			}
 		}
 
+		// Host namespaces and hostPath volumes
+		w.HostNetwork = podSpec.HostNetwork
+		w.HostPID = podSpec.HostPID
+		w.HostIPC = podSpec.HostIPC
+		for _, v := range podSpec.Volumes {
+			if v.HostPath != nil {
+				w.HostPathVolumes = append(w.HostPathVolumes, v.Name+"="+v.HostPath.Path)
+			}
+		}
+
 		// Vault / ESO annotations
 		for k, v := range annotations {
 			if k == "vault.hashicorp.com/agent-inject" && v == "true" {
@@ -969,20 +1475,29 @@ func (d *K8sDiscoverer) discoverWorkloads(ctx context.Context) []evaluator.Workl
 		}
 	}
 
+	// addWorkload builds a WorkloadResource from a controller's metadata and
+	// pod template. Workload metadata wins over the template's for ownership.
+	addWorkload := func(kind string, meta metav1.ObjectMeta, tmpl corev1.PodTemplateSpec) {
+		w := evaluator.WorkloadResource{
+			Name:        meta.Name,
+			Namespace:   meta.Namespace,
+			Kind:        kind,
+			Labels:      mergeMeta(tmpl.Labels, meta.Labels),
+			Annotations: mergeMeta(tmpl.Annotations, meta.Annotations),
+			PodLabels:   tmpl.Labels,
+		}
+		processContainers(tmpl.Spec.Containers, tmpl.Spec, &w)
+		extractPodMeta(tmpl.Spec, tmpl.Annotations, &w)
+		workloads = append(workloads, w)
+	}
+
 	// Deployments
 	deploys, err := d.clientset.AppsV1().Deployments("").List(ctx, metav1.ListOptions{})
 	if err != nil {
 		log.Printf("[discovery] Failed to list Deployments: %v", err)
 	} else {
 		for _, dep := range deploys.Items {
-			w := evaluator.WorkloadResource{
-				Name:      dep.Name,
-				Namespace: dep.Namespace,
-				Kind:      "Deployment",
-			}
-			processContainers(dep.Spec.Template.Spec.Containers, dep.Spec.Template.Spec, &w)
-			extractPodMeta(dep.Spec.Template.Spec, dep.Spec.Template.Annotations, &w)
-			workloads = append(workloads, w)
+			addWorkload("Deployment", dep.ObjectMeta, dep.Spec.Template)
 		}
 	}
 
@@ -992,20 +1507,70 @@ func (d *K8sDiscoverer) discoverWorkloads(ctx context.Context) []evaluator.Workl
 		log.Printf("[discovery] Failed to list StatefulSets: %v", err)
 	} else {
 		for _, ss := range ssets.Items {
-			w := evaluator.WorkloadResource{
-				Name:      ss.Name,
-				Namespace: ss.Namespace,
-				Kind:      "StatefulSet",
+			addWorkload("StatefulSet", ss.ObjectMeta, ss.Spec.Template)
+		}
+	}
+
+	// DaemonSets
+	dsets, err := d.clientset.AppsV1().DaemonSets("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("[discovery] Failed to list DaemonSets: %v", err)
+	} else {
+		for _, ds := range dsets.Items {
+			addWorkload("DaemonSet", ds.ObjectMeta, ds.Spec.Template)
+		}
+	}
+
+	// CronJobs
+	cronJobs, err := d.clientset.BatchV1().CronJobs("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("[discovery] Failed to list CronJobs: %v", err)
+	} else {
+		for _, cj := range cronJobs.Items {
+			addWorkload("CronJob", cj.ObjectMeta, cj.Spec.JobTemplate.Spec.Template)
+		}
+	}
+
+	// Jobs — those created by a CronJob are already covered by its template
+	jobs, err := d.clientset.BatchV1().Jobs("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("[discovery] Failed to list Jobs: %v", err)
+	} else {
+		for _, job := range jobs.Items {
+			if hasControllerOwner(job.OwnerReferences) {
+				continue
+			}
+			addWorkload("Job", job.ObjectMeta, job.Spec.Template)
+		}
+	}
+
+	// Bare Pods — pods without a controlling owner are not covered above
+	pods, err := d.clientset.CoreV1().Pods("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("[discovery] Failed to list Pods: %v", err)
+	} else {
+		for _, pod := range pods.Items {
+			if hasControllerOwner(pod.OwnerReferences) {
+				continue
 			}
-			processContainers(ss.Spec.Template.Spec.Containers, ss.Spec.Template.Spec, &w)
-			extractPodMeta(ss.Spec.Template.Spec, ss.Spec.Template.Annotations, &w)
-			workloads = append(workloads, w)
+			addWorkload("Pod", pod.ObjectMeta, corev1.PodTemplateSpec{ObjectMeta: pod.ObjectMeta, Spec: pod.Spec})
 		}
 	}
 
 	return workloads
 }
 
+// hasControllerOwner reports whether an object is managed by a controller
+// (ReplicaSet, Job, CronJob, Node for static pods, ...).
+func hasControllerOwner(refs []metav1.OwnerReference) bool {
+	for _, r := range refs {
+		if r.Controller != nil && *r.Controller {
+			return true
+		}
+	}
+	return false
+}
+
 // discoverNetworkPolicies lists all NetworkPolicy resources across all namespaces.
 func (d *K8sDiscoverer) discoverNetworkPolicies(ctx context.Context) []evaluator.NetworkPolicyResource {
 	netPols, err := d.clientset.NetworkingV1().NetworkPolicies("").List(ctx, metav1.ListOptions{})
@@ -1017,19 +1582,391 @@ func (d *K8sDiscoverer) discoverNetworkPolicies(ctx context.Context) []evaluator
 	var policies []evaluator.NetworkPolicyResource
 	for _, np := range netPols.Items {
 		p := evaluator.NetworkPolicyResource{
-			Name:              np.Name,
-			Namespace:         np.Namespace,
-			HasIngressRules:   len(np.Spec.Ingress) > 0,
-			HasEgressRules:    len(np.Spec.Egress) > 0,
-			PodSelectorLabels: np.Spec.PodSelector.MatchLabels,
+			Name:                   np.Name,
+			Namespace:              np.Namespace,
+			HasIngressRules:        len(np.Spec.Ingress) > 0,
+			HasEgressRules:         len(np.Spec.Egress) > 0,
+			PodSelectorLabels:      np.Spec.PodSelector.MatchLabels,
+			PodSelectorExpressions: convertSelector(&np.Spec.PodSelector).MatchExpressions,
+		}
+		for _, t := range np.Spec.PolicyTypes {
+			p.PolicyTypes = append(p.PolicyTypes, string(t))
+		}
+		for _, r := range np.Spec.Ingress {
+			p.Ingress = append(p.Ingress, convertNetworkPolicyRule(r.From, r.Ports))
+		}
+		for _, r := range np.Spec.Egress {
+			p.Egress = append(p.Egress, convertNetworkPolicyRule(r.To, r.Ports))
 		}
 		policies = append(policies, p)
 	}
 	return policies
 }
 
-// DiscoverGovernancePolicy discovers MCPGovernancePolicy resources
+// convertNetworkPolicyRule flattens the peers and ports of an ingress or egress rule.
+func convertNetworkPolicyRule(peers []networkingv1.NetworkPolicyPeer, ports []networkingv1.NetworkPolicyPort) evaluator.NetworkPolicyRule {
+	var rule evaluator.NetworkPolicyRule
+	for _, peer := range peers {
+		p := evaluator.NetworkPolicyPeer{
+			PodSelector:       convertSelector(peer.PodSelector),
+			NamespaceSelector: convertSelector(peer.NamespaceSelector),
+		}
+		if peer.IPBlock != nil {
+			p.IPBlock = peer.IPBlock.CIDR
+			p.IPBlockExcept = peer.IPBlock.Except
+		}
+		rule.Peers = append(rule.Peers, p)
+	}
+	for _, port := range ports {
+		proto := "TCP"
+		if port.Protocol != nil {
+			proto = string(*port.Protocol)
+		}
+		if port.Port == nil {
+			rule.Ports = append(rule.Ports, proto)
+			continue
+		}
+		rule.Ports = append(rule.Ports, fmt.Sprintf("%s/%s", proto, port.Port.String()))
+	}
+	return rule
+}
+
+// convertSelector copies a label selector; nil stays nil ("not set").
+func convertSelector(sel *metav1.LabelSelector) *evaluator.LabelSelector {
+	if sel == nil {
+		return nil
+	}
+	out := &evaluator.LabelSelector{MatchLabels: sel.MatchLabels}
+	for _, r := range sel.MatchExpressions {
+		out.MatchExpressions = append(out.MatchExpressions, evaluator.LabelSelectorRequirement{
+			Key:      r.Key,
+			Operator: string(r.Operator),
+			Values:   r.Values,
+		})
+	}
+	return out
+}
+
+// discoverServiceAccounts lists all ServiceAccounts across all namespaces.
+func (d *K8sDiscoverer) discoverServiceAccounts(ctx context.Context) []evaluator.ServiceAccountResource {
+	sas, err := d.clientset.CoreV1().ServiceAccounts("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("[discovery] Failed to list ServiceAccounts: %v", err)
+		return nil
+	}
+
+	var out []evaluator.ServiceAccountResource
+	for _, sa := range sas.Items {
+		out = append(out, evaluator.ServiceAccountResource{
+			Name:           sa.Name,
+			Namespace:      sa.Namespace,
+			AutomountToken: sa.AutomountServiceAccountToken,
+		})
+	}
+	return out
+}
+
+// discoverRoles lists all Roles and ClusterRoles. Aggregated ClusterRoles
+// already carry the aggregated rules.
+func (d *K8sDiscoverer) discoverRoles(ctx context.Context) []evaluator.RoleResource {
+	var out []evaluator.RoleResource
+
+	roles, err := d.clientset.RbacV1().Roles("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("[discovery] Failed to list Roles: %v", err)
+	} else {
+		for _, r := range roles.Items {
+			out = append(out, evaluator.RoleResource{Kind: "Role", Name: r.Name, Namespace: r.Namespace, Rules: convertPolicyRules(r.Rules)})
+		}
+	}
+
+	clusterRoles, err := d.clientset.RbacV1().ClusterRoles().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("[discovery] Failed to list ClusterRoles: %v", err)
+	} else {
+		for _, r := range clusterRoles.Items {
+			out = append(out, evaluator.RoleResource{Kind: "ClusterRole", Name: r.Name, Rules: convertPolicyRules(r.Rules)})
+		}
+	}
+	return out
+}
+
+// discoverRoleBindings lists all RoleBindings and ClusterRoleBindings.
+func (d *K8sDiscoverer) discoverRoleBindings(ctx context.Context) []evaluator.RoleBindingResource {
+	var out []evaluator.RoleBindingResource
+
+	bindings, err := d.clientset.RbacV1().RoleBindings("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("[discovery] Failed to list RoleBindings: %v", err)
+	} else {
+		for _, b := range bindings.Items {
+			out = append(out, evaluator.RoleBindingResource{
+				Kind:      "RoleBinding",
+				Name:      b.Name,
+				Namespace: b.Namespace,
+				RoleKind:  b.RoleRef.Kind,
+				RoleName:  b.RoleRef.Name,
+				Subjects:  convertSubjects(b.Subjects),
+			})
+		}
+	}
+
+	clusterBindings, err := d.clientset.RbacV1().ClusterRoleBindings().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("[discovery] Failed to list ClusterRoleBindings: %v", err)
+	} else {
+		for _, b := range clusterBindings.Items {
+			out = append(out, evaluator.RoleBindingResource{
+				Kind:     "ClusterRoleBinding",
+				Name:     b.Name,
+				RoleKind: b.RoleRef.Kind,
+				RoleName: b.RoleRef.Name,
+				Subjects: convertSubjects(b.Subjects),
+			})
+		}
+	}
+	return out
+}
+
+func convertPolicyRules(rules []rbacv1.PolicyRule) []evaluator.PolicyRule {
+	out := make([]evaluator.PolicyRule, 0, len(rules))
+	for _, r := range rules {
+		out = append(out, evaluator.PolicyRule{
+			APIGroups:       r.APIGroups,
+			Resources:       r.Resources,
+			ResourceNames:   r.ResourceNames,
+			Verbs:           r.Verbs,
+			NonResourceURLs: r.NonResourceURLs,
+		})
+	}
+	return out
+}
+
+func convertSubjects(subjects []rbacv1.Subject) []evaluator.RBACSubject {
+	out := make([]evaluator.RBACSubject, 0, len(subjects))
+	for _, s := range subjects {
+		out = append(out, evaluator.RBACSubject{Kind: s.Kind, Name: s.Name, Namespace: s.Namespace})
+	}
+	return out
+}
+
+// trivyReportGVR returns the GroupVersionResource of a Trivy Operator report kind.
+func trivyReportGVR(resource string) schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    "aquasecurity.github.io",
+		Version:  "v1alpha1",
+		Resource: resource,
+	}
+}
+
+// discoverReplicaSetOwners maps "namespace/replicaset" to the name of the
+// Deployment controlling it, so Trivy reports (owned by ReplicaSets) can be
+// attributed to Deployments.
+func (d *K8sDiscoverer) discoverReplicaSetOwners(ctx context.Context) map[string]string {
+	rsets, err := d.clientset.AppsV1().ReplicaSets("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("[discovery] Failed to list ReplicaSets: %v", err)
+		return nil
+	}
+	owners := make(map[string]string, len(rsets.Items))
+	for _, rs := range rsets.Items {
+		for _, ref := range rs.OwnerReferences {
+			if ref.Kind == "Deployment" && ref.Controller != nil && *ref.Controller {
+				owners[rs.Namespace+"/"+rs.Name] = ref.Name
+			}
+		}
+	}
+	return owners
+}
+
+// discoverVulnerabilityReports discovers Trivy Operator VulnerabilityReport CRs.
+func (d *K8sDiscoverer) discoverVulnerabilityReports(ctx context.Context, rsOwners map[string]string) []evaluator.VulnerabilityReportResource {
+	list, err := d.dynamicClient.Resource(trivyReportGVR("vulnerabilityreports")).Namespace("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("[discovery] Trivy VulnerabilityReport CRD not available: %v", err)
+		return nil
+	}
+	var out []evaluator.VulnerabilityReportResource
+	for _, item := range list.Items {
+		out = append(out, parseVulnerabilityReport(item, rsOwners))
+	}
+	return out
+}
+
+// discoverConfigAuditReports discovers Trivy Operator ConfigAuditReport CRs.
+func (d *K8sDiscoverer) discoverConfigAuditReports(ctx context.Context, rsOwners map[string]string) []evaluator.ConfigAuditReportResource {
+	list, err := d.dynamicClient.Resource(trivyReportGVR("configauditreports")).Namespace("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("[discovery] Trivy ConfigAuditReport CRD not available: %v", err)
+		return nil
+	}
+	var out []evaluator.ConfigAuditReportResource
+	for _, item := range list.Items {
+		out = append(out, parseConfigAuditReport(item, rsOwners))
+	}
+	return out
+}
+
+// trivyReportOwner returns the workload a Trivy report was produced for: its
+// controlling owner reference, falling back to the trivy-operator.resource.*
+// labels. ReplicaSets are resolved to their Deployment.
+func trivyReportOwner(item unstructured.Unstructured, rsOwners map[string]string) (kind, name string) {
+	for _, ref := range item.GetOwnerReferences() {
+		if ref.Controller != nil && *ref.Controller {
+			kind, name = ref.Kind, ref.Name
+			break
+		}
+	}
+	if name == "" {
+		labels := item.GetLabels()
+		kind, name = labels["trivy-operator.resource.kind"], labels["trivy-operator.resource.name"]
+	}
+	if kind == "ReplicaSet" {
+		if deploy, ok := rsOwners[item.GetNamespace()+"/"+name]; ok {
+			return "Deployment", deploy
+		}
+	}
+	return kind, name
+}
+
+// trivySummary reads the per-severity counts of report.summary.
+func trivySummary(report map[string]interface{}) (critical, high, medium, low int) {
+	summary, _ := getNestedMap(report, "summary")
+	c, _ := getNestedInt(summary, "criticalCount")
+	h, _ := getNestedInt(summary, "highCount")
+	m, _ := getNestedInt(summary, "mediumCount")
+	l, _ := getNestedInt(summary, "lowCount")
+	return int(c), int(h), int(m), int(l)
+}
+
+// isCriticalOrHigh reports whether a Trivy severity is CRITICAL or HIGH.
+func isCriticalOrHigh(severity string) bool {
+	s := strings.ToUpper(severity)
+	return s == "CRITICAL" || s == "HIGH"
+}
+
+func parseVulnerabilityReport(item unstructured.Unstructured, rsOwners map[string]string) evaluator.VulnerabilityReportResource {
+	r := evaluator.VulnerabilityReportResource{
+		Name:      item.GetName(),
+		Namespace: item.GetNamespace(),
+		Container: item.GetLabels()["trivy-operator.container.name"],
+	}
+	r.OwnerKind, r.OwnerName = trivyReportOwner(item, rsOwners)
+
+	report, _ := getNestedMap(item.Object, "report")
+	if report == nil {
+		return r
+	}
+	r.Critical, r.High, r.Medium, r.Low = trivySummary(report)
+
+	server := ""
+	if registry, ok := getNestedMap(report, "registry"); ok {
+		server, _ = getNestedString(registry, "server")
+	}
+	if artifact, ok := getNestedMap(report, "artifact"); ok {
+		repo, _ := getNestedString(artifact, "repository")
+		tag, _ := getNestedString(artifact, "tag")
+		r.Digest, _ = getNestedString(artifact, "digest")
+		r.Image = repo
+		if server != "" {
+			r.Image = server + "/" + repo
+		}
+		if tag != "" {
+			r.Image += ":" + tag
+		}
+	}
+
+	vulns, _ := getNestedSlice(report, "vulnerabilities")
+	for _, v := range vulns {
+		vm, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		vuln := evaluator.Vulnerability{}
+		vuln.Severity, _ = getNestedString(vm, "severity")
+		if !isCriticalOrHigh(vuln.Severity) {
+			continue
+		}
+		vuln.ID, _ = getNestedString(vm, "vulnerabilityID")
+		vuln.Package, _ = getNestedString(vm, "resource")
+		vuln.InstalledVersion, _ = getNestedString(vm, "installedVersion")
+		vuln.FixedVersion, _ = getNestedString(vm, "fixedVersion")
+		vuln.Title, _ = getNestedString(vm, "title")
+		r.Vulnerabilities = append(r.Vulnerabilities, vuln)
+	}
+	return r
+}
+
+func parseConfigAuditReport(item unstructured.Unstructured, rsOwners map[string]string) evaluator.ConfigAuditReportResource {
+	r := evaluator.ConfigAuditReportResource{
+		Name:      item.GetName(),
+		Namespace: item.GetNamespace(),
+	}
+	r.OwnerKind, r.OwnerName = trivyReportOwner(item, rsOwners)
+
+	report, _ := getNestedMap(item.Object, "report")
+	if report == nil {
+		return r
+	}
+	r.Critical, r.High, r.Medium, r.Low = trivySummary(report)
+
+	checks, _ := getNestedSlice(report, "checks")
+	for _, c := range checks {
+		cm, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if success, _ := cm["success"].(bool); success {
+			continue
+		}
+		check := evaluator.ConfigAuditCheck{}
+		check.Severity, _ = getNestedString(cm, "severity")
+		if !isCriticalOrHigh(check.Severity) {
+			continue
+		}
+		check.ID, _ = getNestedString(cm, "checkID")
+		check.Title, _ = getNestedString(cm, "title")
+		r.FailedChecks = append(r.FailedChecks, check)
+	}
+	return r
+}
+
+// LoadPublicKeys returns the PEM public keys stored in the data entries of a
+// ConfigMap referenced as "namespace/name". Entries without a PEM public key
+// are ignored.
+func (d *K8sDiscoverer) LoadPublicKeys(ctx context.Context, ref string) ([]string, error) {
+	ns, name, ok := strings.Cut(ref, "/")
+	if !ok || ns == "" || name == "" {
+		return nil, fmt.Errorf("invalid ConfigMap reference %q, want namespace/name", ref)
+	}
+	cm, err := d.clientset.CoreV1().ConfigMaps(ns).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for _, v := range cm.Data {
+		if strings.Contains(v, "-----BEGIN PUBLIC KEY-----") {
+			keys = append(keys, v)
+		}
+	}
+	return keys, nil
+}
+
+// DiscoverGovernancePolicy discovers MCPGovernancePolicy resources and resolves
+// them into a single effective policy (see evaluator.ResolvePolicies).
 func (d *K8sDiscoverer) DiscoverGovernancePolicy(ctx context.Context) *evaluator.Policy {
+	policies := d.DiscoverGovernancePolicies(ctx)
+	if len(policies) == 0 {
+		return nil
+	}
+	resolved := evaluator.ResolvePolicies(policies)
+	return &resolved
+}
+
+// DiscoverGovernancePolicies lists every MCPGovernancePolicy and parses each one.
+// Policies with spec.namespaces set are namespace-scoped and can only tighten
+// the cluster-wide baseline.
+func (d *K8sDiscoverer) DiscoverGovernancePolicies(ctx context.Context) []evaluator.Policy {
 	gvr := schema.GroupVersionResource{
 		Group:    "governance.mcp.io",
 		Version:  "v1alpha1",
@@ -1047,18 +1984,23 @@ func (d *K8sDiscoverer) DiscoverGovernancePolicy(ctx context.Context) *evaluator
 		return nil
 	}
 
-	// Use the first policy found (typically there should only be one cluster-wide policy)
-	policyObj := list.Items[0]
-	spec, found, err := unstructured.NestedMap(policyObj.Object, "spec")
-	if !found || err != nil {
-		log.Printf("[discovery] Failed to parse MCPGovernancePolicy spec: %v. Using default policy.", err)
-		return nil
-	}
-
-	policy := &evaluator.Policy{
-		Name: policyObj.GetName(),
+	var policies []evaluator.Policy
+	for _, policyObj := range list.Items {
+		spec, found, err := unstructured.NestedMap(policyObj.Object, "spec")
+		if !found || err != nil {
+			log.Printf("[discovery] Failed to parse MCPGovernancePolicy %s spec: %v. Skipping.", policyObj.GetName(), err)
+			continue
+		}
+		policies = append(policies, *parsePolicySpec(policyObj.GetName(), spec))
 	}
+	return policies
+}
 
+// ApplyPolicySpec overlays the fields present in an MCPGovernancePolicy spec onto
+// policy. Fields absent from spec are left untouched, so it can be used both to
+// parse a full spec and to apply a partial one (e.g. for what-if simulation).
+// Numeric fields must be int64, as produced by the unstructured decoder.
+func ApplyPolicySpec(policy *evaluator.Policy, spec map[string]interface{}) {
 	// Parse boolean requirements
 	if val, ok := spec["requireAgentGateway"].(bool); ok {
 		policy.RequireAgentGateway = val
@@ -1084,6 +2026,9 @@ func (d *K8sDiscoverer) DiscoverGovernancePolicy(ctx context.Context) *evaluator
 	if val, ok := spec["requireHardenedDeployment"].(bool); ok {
 		policy.RequireHardenedDeployment = val
 	}
+	if val, ok := spec["requireWorkloadRBAC"].(bool); ok {
+		policy.RequireWorkloadRBAC = val
+	}
 
 	// Tier 2 #16: audit logging fields
 	if val, ok := spec["enableAuditLogging"].(bool); ok {
@@ -1133,10 +2078,25 @@ func (d *K8sDiscoverer) DiscoverGovernancePolicy(ctx context.Context) *evaluator
 	if val, ok := spec["maxToolsCritical"].(int64); ok {
 		policy.MaxToolsCritical = int(val)
 	}
+	if val, ok := spec["certExpiryWarningDays"].(int64); ok {
+		policy.CertExpiryWarningDays = int(val)
+	}
+	if claims, ok := spec["requiredJWTClaims"].([]interface{}); ok {
+		policy.RequiredJWTClaims = toStringSlice(claims)
+	}
+	if origins, ok := spec["allowedCORSOrigins"].([]interface{}); ok && len(origins) > 0 {
+		policy.AllowedCORSOrigins = toStringSlice(origins)
+	}
+	if val, ok := spec["maxRateLimitRPS"].(int64); ok {
+		policy.MaxRateLimitRPS = int(val)
+	}
+	if builtins, ok := spec["requiredPromptGuardBuiltins"].([]interface{}); ok {
+		policy.RequiredPromptGuardBuiltins = toStringSlice(builtins)
+	}
 
 	// Parse scoring weights
 	if weightsMap, ok := spec["scoringWeights"].(map[string]interface{}); ok {
-		weights := evaluator.ScoringWeights{}
+		weights := policy.Weights
 		if val, ok := weightsMap["agentGatewayIntegration"].(int64); ok {
 			weights.AgentGatewayIntegration = int(val)
 		}
@@ -1164,12 +2124,18 @@ func (d *K8sDiscoverer) DiscoverGovernancePolicy(ctx context.Context) *evaluator
 		if val, ok := weightsMap["hardenedDeployment"].(int64); ok {
 			weights.HardenedDeployment = int(val)
 		}
+		if val, ok := weightsMap["workloadRBAC"].(int64); ok {
+			weights.WorkloadRBAC = int(val)
+		}
+		if val, ok := weightsMap["vulnerabilities"].(int64); ok {
+			weights.Vulnerabilities = int(val)
+		}
 		policy.Weights = weights
 	}
 
 	// Parse severity penalties
 	if penaltiesMap, ok := spec["severityPenalties"].(map[string]interface{}); ok {
-		penalties := evaluator.DefaultSeverityPenalties()
+		penalties := policy.SeverityPenalties
 		if val, ok := penaltiesMap["critical"].(int64); ok {
 			penalties.Critical = int(val)
 		}
@@ -1183,29 +2149,16 @@ func (d *K8sDiscoverer) DiscoverGovernancePolicy(ctx context.Context) *evaluator
 			penalties.Low = int(val)
 		}
 		policy.SeverityPenalties = penalties
-	} else {
-		policy.SeverityPenalties = evaluator.DefaultSeverityPenalties()
 	}
 
 	// Parse target namespaces (optional – empty means scan all namespaces)
 	if nsList, ok := spec["targetNamespaces"].([]interface{}); ok {
-		for _, ns := range nsList {
-			if s, ok := ns.(string); ok {
-				policy.TargetNamespaces = append(policy.TargetNamespaces, s)
-			}
-		}
+		policy.TargetNamespaces = toStringSlice(nsList)
 	}
 
-	// Parse exclude namespaces; fall back to sensible defaults when not specified
+	// Parse exclude namespaces
 	if nsList, ok := spec["excludeNamespaces"].([]interface{}); ok {
-		for _, ns := range nsList {
-			if s, ok := ns.(string); ok {
-				policy.ExcludeNamespaces = append(policy.ExcludeNamespaces, s)
-			}
-		}
-	}
-	if len(policy.ExcludeNamespaces) == 0 {
-		policy.ExcludeNamespaces = evaluator.DefaultExcludeNamespaces()
+		policy.ExcludeNamespaces = toStringSlice(nsList)
 	}
 
 	// Parse verifiedCatalogScoring configuration
@@ -1239,8 +2192,9 @@ func (d *K8sDiscoverer) DiscoverGovernancePolicy(ctx context.Context) *evaluator
 
 	// Parse skillGovernance configuration
 	if sgMap, ok := spec["skillGovernance"].(map[string]interface{}); ok {
-		sg := evaluator.SkillGovernancePolicy{
-			PatternMountPath: "/etc/mcp-governance/skill-patterns",
+		sg := policy.SkillGovernance
+		if sg.PatternMountPath == "" {
+			sg.PatternMountPath = "/etc/mcp-governance/skill-patterns"
 		}
 		if val, ok := sgMap["enabled"].(bool); ok {
 			sg.Enabled = val
@@ -1261,21 +2215,198 @@ func (d *K8sDiscoverer) DiscoverGovernancePolicy(ctx context.Context) *evaluator
 			sg.FailOnPrivilegeEscalation = val
 		}
 		if domainList, ok := sgMap["allowedExternalDomains"].([]interface{}); ok {
-			for _, d := range domainList {
-				if s, ok := d.(string); ok {
-					sg.AllowedExternalDomains = append(sg.AllowedExternalDomains, s)
+			sg.AllowedExternalDomains = toStringSlice(domainList)
+		}
+		if cats, ok := sgMap["requireSafetyGuardrails"].([]interface{}); ok {
+			sg.RequireSafetyGuardrails = toStringSlice(cats)
+		}
+		policy.SkillGovernance = sg
+	}
+
+	// Parse per-check overrides (enable/disable/re-prioritise by check ID)
+	if checksMap, ok := spec["checks"].(map[string]interface{}); ok {
+		checks := make(map[string]evaluator.CheckConfig, len(policy.Checks)+len(checksMap))
+		for id, cfg := range policy.Checks {
+			checks[id] = cfg
+		}
+		for id, v := range checksMap {
+			cm, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			cfg := checks[id]
+			if val, ok := cm["enabled"].(bool); ok {
+				cfg.Disabled = !val
+			}
+			if val, ok := cm["severity"].(string); ok {
+				cfg.Severity = val
+			}
+			checks[id] = cfg
+		}
+		policy.Checks = checks
+	}
+
+	// Parse per-finding overrides keyed by finding ID prefix
+	if overridesMap, ok := spec["checkOverrides"].(map[string]interface{}); ok {
+		overrides := make(map[string]evaluator.CheckOverride, len(policy.CheckOverrides)+len(overridesMap))
+		for prefix, o := range policy.CheckOverrides {
+			overrides[prefix] = o
+		}
+		for prefix, v := range overridesMap {
+			om, ok := v.(map[string]interface{})
+			if !ok || prefix == "" {
+				continue
+			}
+			o := overrides[prefix]
+			if val, ok := om["enabled"].(bool); ok {
+				o.Disabled = !val
+			}
+			if val, ok := om["severity"].(string); ok {
+				o.Severity = val
+			}
+			if val, ok := om["penalty"].(int64); ok {
+				penalty := int(val)
+				o.Penalty = &penalty
+			}
+			overrides[prefix] = o
+		}
+		policy.CheckOverrides = overrides
+	}
+
+	// Parse team ownership keys
+	if om, ok := spec["ownership"].(map[string]interface{}); ok {
+		if keys, ok := om["labelKeys"].([]interface{}); ok {
+			policy.Ownership.LabelKeys = toStringSlice(keys)
+		}
+		if keys, ok := om["annotationKeys"].([]interface{}); ok {
+			policy.Ownership.AnnotationKeys = toStringSlice(keys)
+		}
+	}
+
+	// Parse tool sensitivity classification
+	if tm, ok := spec["toolSensitivity"].(map[string]interface{}); ok {
+		if pats, ok := tm["patterns"].(map[string]interface{}); ok {
+			patterns := make(map[string][]string, len(policy.ToolSensitivity.Patterns)+len(pats))
+			for class, list := range policy.ToolSensitivity.Patterns {
+				patterns[class] = list
+			}
+			for class, v := range pats {
+				if list, ok := v.([]interface{}); ok {
+					patterns[class] = toStringSlice(list)
 				}
 			}
+			policy.ToolSensitivity.Patterns = patterns
 		}
-		if cats, ok := sgMap["requireSafetyGuardrails"].([]interface{}); ok {
-			for _, c := range cats {
-				if s, ok := c.(string); ok {
-					sg.RequireSafetyGuardrails = append(sg.RequireSafetyGuardrails, s)
+		if pens, ok := tm["penalties"].(map[string]interface{}); ok {
+			penalties := make(map[string]int, len(policy.ToolSensitivity.Penalties)+len(pens))
+			for class, v := range policy.ToolSensitivity.Penalties {
+				penalties[class] = v
+			}
+			for class, v := range pens {
+				if val, ok := v.(int64); ok {
+					penalties[class] = int(val)
 				}
 			}
+			policy.ToolSensitivity.Penalties = penalties
 		}
-		policy.SkillGovernance = sg
-	} else {
+		if val, ok := tm["unrestrictedMultiplier"].(int64); ok {
+			policy.ToolSensitivity.UnrestrictedMultiplier = int(val)
+		}
+	}
+
+	// Parse cosign image verification settings
+	if im, ok := spec["imageVerification"].(map[string]interface{}); ok {
+		if val, ok := im["enabled"].(bool); ok {
+			policy.ImageVerification.Enabled = val
+		}
+		if keys, ok := im["publicKeys"].([]interface{}); ok {
+			policy.ImageVerification.PublicKeys = toStringSlice(keys)
+		}
+		if val, ok := im["publicKeysConfigMap"].(string); ok {
+			policy.ImageVerification.PublicKeysConfigMap = val
+		}
+		if regs, ok := im["allowedRegistries"].([]interface{}); ok {
+			policy.ImageVerification.AllowedRegistries = toStringSlice(regs)
+		}
+		if val, ok := im["registryMirror"].(string); ok {
+			policy.ImageVerification.RegistryMirror = val
+		}
+		if val, ok := im["insecureRegistry"].(bool); ok {
+			policy.ImageVerification.InsecureRegistry = val
+		}
+	}
+
+	// Parse live MCP probe settings
+	if pm, ok := spec["mcpProbe"].(map[string]interface{}); ok {
+		if val, ok := pm["enabled"].(bool); ok {
+			policy.MCPProbe.Enabled = val
+		}
+		if nss, ok := pm["allowedNamespaces"].([]interface{}); ok {
+			policy.MCPProbe.AllowedNamespaces = toStringSlice(nss)
+		}
+		if val, ok := pm["concurrency"].(int64); ok && val > 0 {
+			policy.MCPProbe.Concurrency = int(val)
+		}
+		if val, ok := pm["timeoutSeconds"].(int64); ok && val > 0 {
+			policy.MCPProbe.TimeoutSeconds = int(val)
+		}
+		if val, ok := pm["authBypass"].(bool); ok {
+			policy.MCPProbe.AuthBypass = val
+		}
+	}
+
+	// Parse CVE thresholds for Trivy VulnerabilityReports
+	if vm, ok := spec["vulnerabilities"].(map[string]interface{}); ok {
+		if val, ok := vm["enabled"].(bool); ok {
+			policy.Vulnerabilities.Enabled = val
+		}
+		if val, ok := vm["maxCritical"].(int64); ok {
+			policy.Vulnerabilities.MaxCritical = int(val)
+		}
+		if val, ok := vm["maxHigh"].(int64); ok {
+			policy.Vulnerabilities.MaxHigh = int(val)
+		}
+		if val, ok := vm["ignoreUnfixed"].(bool); ok {
+			policy.Vulnerabilities.IgnoreUnfixed = val
+		}
+	}
+
+	// Parse CEL custom rules
+	if rules, ok := spec["customRules"].([]interface{}); ok {
+		policy.CustomRules = nil
+		for _, r := range rules {
+			rm, ok := r.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			rule := evaluator.CustomRule{}
+			rule.ID, _ = getNestedString(rm, "id")
+			rule.Severity, _ = getNestedString(rm, "severity")
+			rule.Category, _ = getNestedString(rm, "category")
+			rule.TargetKind, _ = getNestedString(rm, "targetKind")
+			rule.Expression, _ = getNestedString(rm, "expression")
+			rule.Title, _ = getNestedString(rm, "title")
+			rule.Description, _ = getNestedString(rm, "description")
+			rule.Remediation, _ = getNestedString(rm, "remediation")
+			policy.CustomRules = append(policy.CustomRules, rule)
+		}
+	}
+}
+
+// parsePolicySpec converts an MCPGovernancePolicy spec into an evaluator.Policy.
+func parsePolicySpec(name string, spec map[string]interface{}) *evaluator.Policy {
+	policy := &evaluator.Policy{
+		Name:              name,
+		SeverityPenalties: evaluator.DefaultSeverityPenalties(),
+		MCPProbe:          evaluator.DefaultMCPProbePolicy(),
+	}
+	ApplyPolicySpec(policy, spec)
+
+	// Fall back to sensible defaults for settings the spec leaves out
+	if len(policy.ExcludeNamespaces) == 0 {
+		policy.ExcludeNamespaces = evaluator.DefaultExcludeNamespaces()
+	}
+	if _, ok := spec["skillGovernance"].(map[string]interface{}); !ok {
 		// Default: enable skill governance with metadata checks only (no repo scanning)
 		policy.SkillGovernance = evaluator.SkillGovernancePolicy{
 			Enabled:                   true,
@@ -1288,14 +2419,73 @@ func (d *K8sDiscoverer) DiscoverGovernancePolicy(ctx context.Context) *evaluator
 		}
 	}
 
-	log.Printf("[discovery] Loaded MCPGovernancePolicy: %s/%s (targetNS=%v, excludeNS=%v)",
-		policyObj.GetNamespace(), policyObj.GetName(), policy.TargetNamespaces, policy.ExcludeNamespaces)
+	// Parse namespace scope. A namespace-scoped policy only tightens the baseline,
+	// so settings it leaves unset must not contribute defaults to the merge.
+	if nsList, ok := spec["namespaces"].([]interface{}); ok {
+		for _, ns := range nsList {
+			if s, ok := ns.(string); ok && s != "" {
+				policy.ScopeNamespaces = append(policy.ScopeNamespaces, s)
+			}
+		}
+	}
+	if len(policy.ScopeNamespaces) > 0 {
+		if _, ok := spec["severityPenalties"]; !ok {
+			policy.SeverityPenalties = evaluator.SeverityPenalties{}
+		}
+	} else if _, ok := spec["vulnerabilities"].(map[string]interface{}); !ok {
+		policy.Vulnerabilities = evaluator.DefaultVulnerabilityPolicy()
+	}
+
+	log.Printf("[discovery] Loaded MCPGovernancePolicy: %s (scope=%v, targetNS=%v, excludeNS=%v)",
+		name, policy.ScopeNamespaces, policy.TargetNamespaces, policy.ExcludeNamespaces)
 	return policy
 }
 
 // UpdatePolicyStatus updates the status subresource of the MCPGovernancePolicy CR
 // with the latest evaluation result (score, phase, timestamp, conditions).
 func (d *K8sDiscoverer) UpdatePolicyStatus(ctx context.Context, policyName string, result *evaluator.EvaluationResult) error {
+	message := fmt.Sprintf("Cluster governance score: %d/100 (%s). %d finding(s) detected.", result.Score, scorePhase(result.Score), len(result.Findings))
+	return d.writePolicyStatus(ctx, policyName, result.Score, message, result.Timestamp)
+}
+
+// UpdateScopedPolicyStatus updates the status of a namespace-scoped MCPGovernancePolicy
+// with the average score and finding count of the namespaces it applies to.
+func (d *K8sDiscoverer) UpdateScopedPolicyStatus(ctx context.Context, policyName string, namespaces []string, result *evaluator.EvaluationResult) error {
+	inScope := make(map[string]bool, len(namespaces))
+	for _, ns := range namespaces {
+		inScope[ns] = true
+	}
+	total, count, findings := 0, 0, 0
+	for _, ns := range result.NamespaceScores {
+		if inScope[ns.Namespace] {
+			total += ns.Score
+			findings += ns.Findings
+			count++
+		}
+	}
+	score := 100
+	if count > 0 {
+		score = total / count
+	}
+	message := fmt.Sprintf("Namespace governance score: %d/100 (%s) across %v. %d finding(s) detected.", score, scorePhase(score), namespaces, findings)
+	return d.writePolicyStatus(ctx, policyName, score, message, result.Timestamp)
+}
+
+// scorePhase maps a governance score to the policy status phase.
+func scorePhase(score int) string {
+	switch {
+	case score >= 90:
+		return "Compliant"
+	case score >= 70:
+		return "PartiallyCompliant"
+	case score >= 50:
+		return "NonCompliant"
+	}
+	return "Critical"
+}
+
+// writePolicyStatus sets phase, score and the Evaluated condition on an MCPGovernancePolicy.
+func (d *K8sDiscoverer) writePolicyStatus(ctx context.Context, policyName string, score int, message string, ts time.Time) error {
 	gvr := schema.GroupVersionResource{
 		Group:    "governance.mcp.io",
 		Version:  "v1alpha1",
@@ -1308,18 +2498,8 @@ func (d *K8sDiscoverer) UpdatePolicyStatus(ctx context.Context, policyName strin
 		return fmt.Errorf("failed to get MCPGovernancePolicy %q: %w", policyName, err)
 	}
 
-	// Determine phase from score
-	phase := "Critical"
-	switch {
-	case result.Score >= 90:
-		phase = "Compliant"
-	case result.Score >= 70:
-		phase = "PartiallyCompliant"
-	case result.Score >= 50:
-		phase = "NonCompliant"
-	}
-
-	now := result.Timestamp.Format(time.RFC3339)
+	phase := scorePhase(score)
+	now := ts.Format(time.RFC3339)
 
 	// Build conditions
 	conditions := []interface{}{
@@ -1327,7 +2507,7 @@ func (d *K8sDiscoverer) UpdatePolicyStatus(ctx context.Context, policyName strin
 			"type":               "Evaluated",
 			"status":             "True",
 			"reason":             "EvaluationComplete",
-			"message":            fmt.Sprintf("Cluster governance score: %d/100 (%s). %d finding(s) detected.", result.Score, phase, len(result.Findings)),
+			"message":            message,
 			"lastTransitionTime": now,
 		},
 	}
@@ -1335,7 +2515,7 @@ func (d *K8sDiscoverer) UpdatePolicyStatus(ctx context.Context, policyName strin
 	// Set the status
 	status := map[string]interface{}{
 		"phase":              phase,
-		"clusterScore":       int64(result.Score),
+		"clusterScore":       int64(score),
 		"lastEvaluationTime": now,
 		"conditions":         conditions,
 	}
@@ -1350,7 +2530,7 @@ func (d *K8sDiscoverer) UpdatePolicyStatus(ctx context.Context, policyName strin
 		return fmt.Errorf("failed to update MCPGovernancePolicy status: %w", err)
 	}
 
-	log.Printf("[discovery] Updated MCPGovernancePolicy %q status: score=%d, phase=%s", policyName, result.Score, phase)
+	log.Printf("[discovery] Updated MCPGovernancePolicy %q status: score=%d, phase=%s", policyName, score, phase)
 	return nil
 }
 
@@ -1516,6 +2696,36 @@ func (d *K8sDiscoverer) UpdateEvaluationStatus(ctx context.Context, policyName s
 			}
 		}
 
+		// Build agentScores
+		agentScores := make([]interface{}, 0, len(result.AgentViews))
+		for _, av := range result.AgentViews {
+			mcpServers := 0
+			for _, ref := range av.References {
+				if ref.Type != "Agent" {
+					mcpServers++
+				}
+			}
+			criticalFindings := 0
+			for _, f := range av.Findings {
+				if f.Severity == "Critical" {
+					criticalFindings++
+				}
+			}
+			agentScores = append(agentScores, map[string]interface{}{
+				"name":               av.Name,
+				"namespace":          av.Namespace,
+				"status":             av.Status,
+				"score":              int64(av.Score),
+				"grade":              av.Grade,
+				"owner":              av.Owner,
+				"mcpServers":         int64(mcpServers),
+				"effectiveToolCount": int64(len(av.EffectiveToolNames)),
+				"weakestControl":     av.WeakestControl,
+				"criticalFindings":   int64(criticalFindings),
+				"lastEvaluated":      result.Timestamp.Format(time.RFC3339),
+			})
+		}
+
 		// Build skillCatalogScores
 		skillCatalogScores := make([]interface{}, 0)
 		for _, scs := range result.SkillCatalogScores {
@@ -1553,6 +2763,7 @@ func (d *K8sDiscoverer) UpdateEvaluationStatus(ctx context.Context, policyName s
 			"namespaceScores":     nsScores,
 			"verifiedCatalogScores": verifiedCatalogScores,
 			"mcpServerScores":     mcpServerScores,
+			"agentScores":         agentScores,
 			"skillCatalogScores":  skillCatalogScores,
 			"lastEvaluationTime":  now,
 			"findingsCount":       int64(len(result.Findings)),
@@ -1633,32 +2844,20 @@ func getNestedSlice(obj map[string]interface{}, key string) ([]interface{}, bool
 // Silence unused import warning
 var _ = unstructured.Unstructured{}
 
-// extractToolNamesFromCEL parses tool names from CEL expressions like:
-//   "mcp.tool.name in ['tool1', 'tool2', 'tool3']"
-// Returns the list of tool names found.
-func extractToolNamesFromCEL(expr string) []string {
-	// Look for patterns like mcp.tool.name in [...]
-	if !strings.Contains(expr, "mcp.tool.name") {
-		return nil
+// authorizationRules returns the matchExpressions of an authorization block
+// with its action (Allow when omitted) and section.
+func authorizationRules(authz map[string]interface{}, section string) []evaluator.AuthorizationRule {
+	action, _ := getNestedString(authz, "action")
+	if action == "" {
+		action = "Allow"
 	}
-	// Find the list part between [ and ]
-	start := strings.Index(expr, "[")
-	end := strings.LastIndex(expr, "]")
-	if start < 0 || end < 0 || end <= start {
-		return nil
-	}
-	listContent := expr[start+1 : end]
-
-	var tools []string
-	for _, part := range strings.Split(listContent, ",") {
-		part = strings.TrimSpace(part)
-		// Remove surrounding quotes (single or double)
-		part = strings.Trim(part, "'\"")
-		if part != "" {
-			tools = append(tools, part)
-		}
+	policy, _ := getNestedMap(authz, "policy")
+	exprs, _ := getNestedSlice(policy, "matchExpressions")
+	var out []evaluator.AuthorizationRule
+	for _, expr := range toStringSlice(exprs) {
+		out = append(out, evaluator.AuthorizationRule{Section: section, Action: action, Expression: expr})
 	}
-	return tools
+	return out
 }
 
 // discoverSkillCatalogs discovers SkillCatalog CRs from agentregistry.dev/v1alpha1.
@@ -1678,9 +2877,10 @@ func (d *K8sDiscoverer) discoverSkillCatalogs(ctx context.Context) []evaluator.S
 	var catalogs []evaluator.SkillCatalogResource
 	for _, item := range list.Items {
 		sc := evaluator.SkillCatalogResource{
-			Name:      item.GetName(),
-			Namespace: item.GetNamespace(),
-			Labels:    item.GetLabels(),
+			Name:        item.GetName(),
+			Namespace:   item.GetNamespace(),
+			Labels:      item.GetLabels(),
+			Annotations: item.GetAnnotations(),
 		}
 
 		// Extract well-known labels from agentregistry.dev
@@ -1723,3 +2923,78 @@ func (d *K8sDiscoverer) discoverSkillCatalogs(ctx context.Context) []evaluator.S
 
 	return catalogs
 }
+
+// discoverGovernanceExceptions lists GovernanceException CRs across all namespaces.
+func (d *K8sDiscoverer) discoverGovernanceExceptions(ctx context.Context) []evaluator.GovernanceExceptionResource {
+	gvr := schema.GroupVersionResource{
+		Group:    "governance.mcp.io",
+		Version:  "v1alpha1",
+		Resource: "governanceexceptions",
+	}
+
+	list, err := d.dynamicClient.Resource(gvr).Namespace("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("[discovery] GovernanceException CRD not available: %v", err)
+		return nil
+	}
+
+	var exceptions []evaluator.GovernanceExceptionResource
+	for _, item := range list.Items {
+		spec, _ := getNestedMap(item.Object, "spec")
+		exceptions = append(exceptions, parseGovernanceException(item.GetName(), item.GetNamespace(), spec))
+	}
+	return exceptions
+}
+
+// parseGovernanceException converts a GovernanceException spec into its evaluator form.
+// An unparseable expiresAt leaves ExpiresAt zero, which marks the exception as incomplete.
+func parseGovernanceException(name, namespace string, spec map[string]interface{}) evaluator.GovernanceExceptionResource {
+	exc := evaluator.GovernanceExceptionResource{
+		Name:      name,
+		Namespace: namespace,
+	}
+	if spec == nil {
+		return exc
+	}
+	exc.FindingIDPrefix, _ = getNestedString(spec, "findingIDPrefix")
+	exc.ResourceRef, _ = getNestedString(spec, "resourceRef")
+	exc.Justification, _ = getNestedString(spec, "justification")
+	exc.Approver, _ = getNestedString(spec, "approver")
+	exc.ClusterScoped, _ = spec["clusterScoped"].(bool)
+	if raw, _ := getNestedString(spec, "expiresAt"); raw != "" {
+		if t, err := time.Parse(time.RFC3339, raw); err == nil {
+			exc.ExpiresAt = t
+		} else if t, err := time.Parse("2006-01-02", raw); err == nil {
+			exc.ExpiresAt = t
+		} else {
+			log.Printf("[discovery] GovernanceException %s/%s has invalid expiresAt %q: %v", namespace, name, raw, err)
+		}
+	}
+	return exc
+}
+
+// mergeMeta returns base overlaid with override (override wins on conflicts).
+func mergeMeta(base, override map[string]string) map[string]string {
+	if len(base) == 0 && len(override) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(base)+len(override))
+	for k, v := range base {
+		out[k] = v
+	}
+	for k, v := range override {
+		out[k] = v
+	}
+	return out
+}
+
+// toStringSlice returns the string elements of an unstructured list.
+func toStringSlice(list []interface{}) []string {
+	out := make([]string, 0, len(list))
+	for _, v := range list {
+		if s, ok := v.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
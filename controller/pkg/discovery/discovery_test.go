@@ -1,7 +1,23 @@
 package discovery
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/evaluator"
 )
 
 // ────────────────────────────────────────────────────────────────────────────
@@ -217,3 +233,564 @@ func TestGetNestedSlice_Empty(t *testing.T) {
 		t.Errorf("len = %d, want 0", len(val))
 	}
 }
+
+// ────────────────────────────────────────────────────────────────────────────
+// parseGovernanceException
+// ────────────────────────────────────────────────────────────────────────────
+
+func TestParseGovernanceException_Full(t *testing.T) {
+	spec := map[string]interface{}{
+		"findingIDPrefix": "HDN-003",
+		"resourceRef":     "Deployment/mcp/legacy",
+		"justification":   "vendor image",
+		"approver":        "secops",
+		"expiresAt":       "2030-01-02T03:04:05Z",
+		"clusterScoped":   true,
+	}
+
+	exc := parseGovernanceException("legacy", "mcp", spec)
+
+	if exc.FindingIDPrefix != "HDN-003" || exc.ResourceRef != "Deployment/mcp/legacy" {
+		t.Errorf("match fields = %q / %q", exc.FindingIDPrefix, exc.ResourceRef)
+	}
+	if exc.Justification != "vendor image" || exc.Approver != "secops" {
+		t.Errorf("justification/approver = %q / %q", exc.Justification, exc.Approver)
+	}
+	if exc.ExpiresAt.Year() != 2030 || exc.ExpiresAt.Hour() != 3 {
+		t.Errorf("expiresAt = %v", exc.ExpiresAt)
+	}
+	if exc.Namespace != "mcp" || !exc.ClusterScoped {
+		t.Errorf("namespace/clusterScoped = %q / %v", exc.Namespace, exc.ClusterScoped)
+	}
+}
+
+func TestParseGovernanceException_DateOnlyExpiry(t *testing.T) {
+	exc := parseGovernanceException("e", "ns", map[string]interface{}{"expiresAt": "2030-06-30"})
+	if exc.ExpiresAt.IsZero() || exc.ExpiresAt.Month() != 6 {
+		t.Errorf("expiresAt = %v, want 2030-06-30", exc.ExpiresAt)
+	}
+}
+
+func TestParseGovernanceException_InvalidExpiry(t *testing.T) {
+	exc := parseGovernanceException("e", "ns", map[string]interface{}{"expiresAt": "next tuesday"})
+	if !exc.ExpiresAt.IsZero() {
+		t.Errorf("expiresAt = %v, want zero for unparseable value", exc.ExpiresAt)
+	}
+}
+
+// ────────────────────────────────────────────────────────────────────────────
+// parsePolicySpec
+// ────────────────────────────────────────────────────────────────────────────
+
+func TestParsePolicySpec_Baseline(t *testing.T) {
+	spec := map[string]interface{}{
+		"requireCORS":     true,
+		"maxToolsWarning": int64(7),
+	}
+
+	p := parsePolicySpec("baseline", spec)
+
+	if p.Name != "baseline" || !p.RequireCORS || p.MaxToolsWarning != 7 {
+		t.Errorf("parsed policy = %+v", p)
+	}
+	if len(p.ScopeNamespaces) != 0 {
+		t.Errorf("ScopeNamespaces = %v, want empty for baseline", p.ScopeNamespaces)
+	}
+	if p.SeverityPenalties.Critical != 40 {
+		t.Errorf("Baseline should default severity penalties, got %+v", p.SeverityPenalties)
+	}
+}
+
+func TestParsePolicySpec_NamespaceScoped(t *testing.T) {
+	spec := map[string]interface{}{
+		"namespaces":       []interface{}{"team-a", "team-b"},
+		"requireRateLimit": true,
+	}
+
+	p := parsePolicySpec("team", spec)
+
+	if len(p.ScopeNamespaces) != 2 || p.ScopeNamespaces[0] != "team-a" {
+		t.Errorf("ScopeNamespaces = %v", p.ScopeNamespaces)
+	}
+	if p.SeverityPenalties.Critical != 0 {
+		t.Errorf("Scoped policy without severityPenalties should not contribute defaults, got %+v", p.SeverityPenalties)
+	}
+}
+
+func TestParsePolicySpec_CheckOverrides(t *testing.T) {
+	spec := map[string]interface{}{
+		"checkOverrides": map[string]interface{}{
+			"TLS-003": map[string]interface{}{"severity": "High"},
+			"HDN-006": map[string]interface{}{"penalty": int64(5)},
+			"SKL-005": map[string]interface{}{"enabled": false},
+			"":        map[string]interface{}{"enabled": false},
+		},
+	}
+
+	p := parsePolicySpec("baseline", spec)
+
+	if len(p.CheckOverrides) != 3 {
+		t.Fatalf("CheckOverrides = %+v", p.CheckOverrides)
+	}
+	if p.CheckOverrides["TLS-003"].Severity != "High" {
+		t.Errorf("TLS-003 = %+v", p.CheckOverrides["TLS-003"])
+	}
+	if pen := p.CheckOverrides["HDN-006"].Penalty; pen == nil || *pen != 5 {
+		t.Errorf("HDN-006 penalty = %v", pen)
+	}
+	if !p.CheckOverrides["SKL-005"].Disabled {
+		t.Error("SKL-005 should be disabled")
+	}
+}
+
+func TestParsePolicySpec_Ownership(t *testing.T) {
+	spec := map[string]interface{}{
+		"ownership": map[string]interface{}{
+			"labelKeys":      []interface{}{"app.kubernetes.io/part-of", "team"},
+			"annotationKeys": []interface{}{"example.com/owner"},
+		},
+	}
+
+	p := parsePolicySpec("baseline", spec)
+
+	if len(p.Ownership.LabelKeys) != 2 || p.Ownership.LabelKeys[0] != "app.kubernetes.io/part-of" {
+		t.Errorf("LabelKeys = %v", p.Ownership.LabelKeys)
+	}
+	if len(p.Ownership.AnnotationKeys) != 1 || p.Ownership.AnnotationKeys[0] != "example.com/owner" {
+		t.Errorf("AnnotationKeys = %v", p.Ownership.AnnotationKeys)
+	}
+}
+
+func TestParsePolicySpec_ToolSensitivity(t *testing.T) {
+	spec := map[string]interface{}{
+		"toolSensitivity": map[string]interface{}{
+			"patterns": map[string]interface{}{
+				"destructive": []interface{}{"nuke", "*_forever"},
+			},
+			"penalties":              map[string]interface{}{"exec": int64(30)},
+			"unrestrictedMultiplier": int64(3),
+		},
+	}
+
+	p := parsePolicySpec("baseline", spec)
+
+	ts := p.ToolSensitivity
+	if len(ts.Patterns["destructive"]) != 2 {
+		t.Errorf("Patterns = %v", ts.Patterns)
+	}
+	if ts.Penalties["exec"] != 30 || ts.UnrestrictedMultiplier != 3 {
+		t.Errorf("ToolSensitivity = %+v", ts)
+	}
+	if got := ts.ClassifyTool("nuke_cluster"); got != "destructive" {
+		t.Errorf("nuke_cluster = %s", got)
+	}
+}
+
+func TestParsePolicySpec_WorkloadRBAC(t *testing.T) {
+	spec := map[string]interface{}{
+		"requireWorkloadRBAC": true,
+		"scoringWeights":      map[string]interface{}{"workloadRBAC": int64(20)},
+	}
+
+	p := parsePolicySpec("baseline", spec)
+
+	if !p.RequireWorkloadRBAC || p.Weights.WorkloadRBAC != 20 {
+		t.Errorf("RequireWorkloadRBAC = %v, weight = %d", p.RequireWorkloadRBAC, p.Weights.WorkloadRBAC)
+	}
+}
+
+func TestParsePolicySpec_Vulnerabilities(t *testing.T) {
+	spec := map[string]interface{}{
+		"vulnerabilities": map[string]interface{}{"enabled": true, "maxCritical": int64(1), "maxHigh": int64(10), "ignoreUnfixed": true},
+		"scoringWeights":  map[string]interface{}{"vulnerabilities": int64(20)},
+	}
+
+	p := parsePolicySpec("baseline", spec)
+
+	v := p.Vulnerabilities
+	if !v.Enabled || v.MaxCritical != 1 || v.MaxHigh != 10 || !v.IgnoreUnfixed || p.Weights.Vulnerabilities != 20 {
+		t.Errorf("Vulnerabilities = %+v, weight = %d", v, p.Weights.Vulnerabilities)
+	}
+
+	if got := parsePolicySpec("baseline", map[string]interface{}{}).Vulnerabilities; got != evaluator.DefaultVulnerabilityPolicy() {
+		t.Errorf("baseline without vulnerabilities = %+v, want defaults", got)
+	}
+	scoped := parsePolicySpec("team", map[string]interface{}{"namespaces": []interface{}{"team-a"}})
+	if scoped.Vulnerabilities.Enabled {
+		t.Error("a namespace-scoped policy must not contribute default vulnerability settings")
+	}
+}
+
+func TestParsePolicySpec_ImageVerification(t *testing.T) {
+	spec := map[string]interface{}{
+		"imageVerification": map[string]interface{}{
+			"enabled":             true,
+			"publicKeys":          []interface{}{"-----BEGIN PUBLIC KEY-----"},
+			"publicKeysConfigMap": "mcp-governance/cosign-keys",
+			"allowedRegistries":   []interface{}{"ghcr.io/my-org", "registry.local:5000"},
+			"registryMirror":      "registry.local:5000",
+			"insecureRegistry":    true,
+		},
+	}
+
+	iv := parsePolicySpec("baseline", spec).ImageVerification
+
+	if !iv.Enabled || len(iv.PublicKeys) != 1 || iv.PublicKeysConfigMap != "mcp-governance/cosign-keys" ||
+		len(iv.AllowedRegistries) != 2 || iv.RegistryMirror != "registry.local:5000" || !iv.InsecureRegistry {
+		t.Errorf("ImageVerification = %+v", iv)
+	}
+}
+
+func TestParsePolicySpec_MCPProbe(t *testing.T) {
+	spec := map[string]interface{}{
+		"mcpProbe": map[string]interface{}{
+			"enabled":           true,
+			"allowedNamespaces": []interface{}{"kagent", "tools"},
+			"concurrency":       int64(2),
+			"timeoutSeconds":    int64(0),
+			"authBypass":        true,
+		},
+	}
+
+	mp := parsePolicySpec("baseline", spec).MCPProbe
+
+	if !mp.Enabled || len(mp.AllowedNamespaces) != 2 || mp.Concurrency != 2 || mp.TimeoutSeconds != 10 || !mp.AuthBypass {
+		t.Errorf("MCPProbe = %+v", mp)
+	}
+	if !mp.AllowsNamespace("tools") || mp.AllowsNamespace("default") {
+		t.Errorf("AllowsNamespace with %v", mp.AllowedNamespaces)
+	}
+}
+
+// ────────────────────────────────────────────────────────────────────────────
+// NetworkPolicy conversion
+// ────────────────────────────────────────────────────────────────────────────
+
+func TestConvertNetworkPolicyRule(t *testing.T) {
+	udp := corev1.ProtocolUDP
+	port := intstr.FromInt32(53)
+	rule := convertNetworkPolicyRule(
+		[]networkingv1.NetworkPolicyPeer{
+			{NamespaceSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: metav1.LabelSelectorOpIn, Values: []string{"platform"}}},
+			}},
+			{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/8", Except: []string{"10.1.0.0/16"}}},
+		},
+		[]networkingv1.NetworkPolicyPort{{Protocol: &udp, Port: &port}, {}},
+	)
+
+	if len(rule.Peers) != 2 {
+		t.Fatalf("peers = %+v", rule.Peers)
+	}
+	ns := rule.Peers[0].NamespaceSelector
+	if ns == nil || rule.Peers[0].PodSelector != nil || len(ns.MatchExpressions) != 1 || ns.MatchExpressions[0].Operator != "In" {
+		t.Errorf("namespace peer = %+v", rule.Peers[0])
+	}
+	if rule.Peers[1].IPBlock != "10.0.0.0/8" || len(rule.Peers[1].IPBlockExcept) != 1 {
+		t.Errorf("ipBlock peer = %+v", rule.Peers[1])
+	}
+	if len(rule.Ports) != 2 || rule.Ports[0] != "UDP/53" || rule.Ports[1] != "TCP" {
+		t.Errorf("ports = %v", rule.Ports)
+	}
+}
+
+// ────────────────────────────────────────────────────────────────────────────
+// Workload ownership
+// ────────────────────────────────────────────────────────────────────────────
+
+func TestHasControllerOwner(t *testing.T) {
+	yes := true
+	if hasControllerOwner(nil) {
+		t.Error("bare pod should have no controller owner")
+	}
+	if hasControllerOwner([]metav1.OwnerReference{{Kind: "ConfigMap", Name: "x"}}) {
+		t.Error("non-controller owner reference should not count")
+	}
+	if !hasControllerOwner([]metav1.OwnerReference{{Kind: "CronJob", Name: "nightly", Controller: &yes}}) {
+		t.Error("Job created by a CronJob should have a controller owner")
+	}
+}
+
+// ────────────────────────────────────────────────────────────────────────────
+// Trivy Operator reports
+// ────────────────────────────────────────────────────────────────────────────
+
+func TestParseVulnerabilityReport(t *testing.T) {
+	controller := true
+	item := unstructured.Unstructured{Object: map[string]interface{}{
+		"report": map[string]interface{}{
+			"registry": map[string]interface{}{"server": "ghcr.io"},
+			"artifact": map[string]interface{}{"repository": "my-org/tools", "tag": "v1", "digest": "sha256:abc"},
+			"summary":  map[string]interface{}{"criticalCount": int64(1), "highCount": int64(2), "mediumCount": int64(3), "lowCount": int64(4)},
+			"vulnerabilities": []interface{}{
+				map[string]interface{}{"vulnerabilityID": "CVE-1", "severity": "CRITICAL", "resource": "openssl", "installedVersion": "3.0.1", "fixedVersion": "3.0.13"},
+				map[string]interface{}{"vulnerabilityID": "CVE-2", "severity": "MEDIUM", "resource": "bash"},
+			},
+		},
+	}}
+	item.SetName("replicaset-tools-5d9f-app")
+	item.SetNamespace("mcp")
+	item.SetLabels(map[string]string{"trivy-operator.container.name": "app"})
+	item.SetOwnerReferences([]metav1.OwnerReference{{Kind: "ReplicaSet", Name: "tools-5d9f", Controller: &controller}})
+
+	r := parseVulnerabilityReport(item, map[string]string{"mcp/tools-5d9f": "tools"})
+
+	if r.OwnerKind != "Deployment" || r.OwnerName != "tools" || r.Container != "app" {
+		t.Errorf("owner = %s/%s, container = %s", r.OwnerKind, r.OwnerName, r.Container)
+	}
+	if r.Image != "ghcr.io/my-org/tools:v1" || r.Digest != "sha256:abc" {
+		t.Errorf("image = %s@%s", r.Image, r.Digest)
+	}
+	if r.Critical != 1 || r.High != 2 || r.Medium != 3 || r.Low != 4 {
+		t.Errorf("summary = %d/%d/%d/%d", r.Critical, r.High, r.Medium, r.Low)
+	}
+	if len(r.Vulnerabilities) != 1 || r.Vulnerabilities[0].ID != "CVE-1" || r.Vulnerabilities[0].FixedVersion != "3.0.13" {
+		t.Errorf("only critical/high vulnerabilities should be kept: %+v", r.Vulnerabilities)
+	}
+}
+
+func TestParseConfigAuditReport_LabelOwner(t *testing.T) {
+	item := unstructured.Unstructured{Object: map[string]interface{}{
+		"report": map[string]interface{}{
+			"checks": []interface{}{
+				map[string]interface{}{"checkID": "KSV017", "severity": "HIGH", "success": false, "title": "Privileged"},
+				map[string]interface{}{"checkID": "KSV001", "severity": "HIGH", "success": true},
+				map[string]interface{}{"checkID": "KSV020", "severity": "LOW", "success": false},
+			},
+		},
+	}}
+	item.SetNamespace("mcp")
+	item.SetLabels(map[string]string{"trivy-operator.resource.kind": "StatefulSet", "trivy-operator.resource.name": "db"})
+
+	r := parseConfigAuditReport(item, nil)
+
+	if r.OwnerKind != "StatefulSet" || r.OwnerName != "db" {
+		t.Errorf("owner = %s/%s", r.OwnerKind, r.OwnerName)
+	}
+	if len(r.FailedChecks) != 1 || r.FailedChecks[0].ID != "KSV017" {
+		t.Errorf("failed checks = %+v", r.FailedChecks)
+	}
+}
+
+func TestConvertIngress(t *testing.T) {
+	class := "nginx"
+	pathType := networkingv1.PathTypePrefix
+	ing := networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "tools", Namespace: "mcp"},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: &class,
+			DefaultBackend: &networkingv1.IngressBackend{
+				Service: &networkingv1.IngressServiceBackend{Name: "fallback", Port: networkingv1.ServiceBackendPort{Number: 80}},
+			},
+			Rules: []networkingv1.IngressRule{{
+				Host: "mcp.example.com",
+				IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{{
+						Path: "/mcp", PathType: &pathType,
+						Backend: networkingv1.IngressBackend{
+							Service: &networkingv1.IngressServiceBackend{Name: "tools", Port: networkingv1.ServiceBackendPort{Number: 8080}},
+						},
+					}},
+				}},
+			}},
+		},
+		Status: networkingv1.IngressStatus{LoadBalancer: networkingv1.IngressLoadBalancerStatus{
+			Ingress: []networkingv1.IngressLoadBalancerIngress{{IP: "203.0.113.7"}},
+		}},
+	}
+
+	got := convertIngress(ing)
+	want := []evaluator.IngressRule{
+		{ServiceName: "fallback", ServicePort: 80},
+		{Host: "mcp.example.com", Path: "/mcp", ServiceName: "tools", ServicePort: 8080},
+	}
+	if got.IngressClassName != "nginx" || len(got.Rules) != 2 || got.Rules[0] != want[0] || got.Rules[1] != want[1] {
+		t.Errorf("convertIngress = %+v", got)
+	}
+	if len(got.Addresses) != 1 || got.Addresses[0] != "203.0.113.7" {
+		t.Errorf("Addresses = %v", got.Addresses)
+	}
+}
+
+func TestParseRoute(t *testing.T) {
+	item := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "tools", "namespace": "mcp"},
+		"spec": map[string]interface{}{
+			"path": "/mcp",
+			"to":   map[string]interface{}{"kind": "Service", "name": "tools"},
+			"alternateBackends": []interface{}{
+				map[string]interface{}{"kind": "Service", "name": "tools-canary"},
+			},
+			"tls": map[string]interface{}{"termination": "edge"},
+		},
+		"status": map[string]interface{}{
+			"ingress": []interface{}{map[string]interface{}{"host": "tools-mcp.apps.example.com"}},
+		},
+	}}
+
+	rt := parseRoute(item)
+	if rt.Host != "tools-mcp.apps.example.com" || rt.Path != "/mcp" || !rt.HasTLS {
+		t.Errorf("parseRoute = %+v", rt)
+	}
+	if len(rt.Services) != 2 || rt.Services[0] != "tools" || rt.Services[1] != "tools-canary" {
+		t.Errorf("Services = %v", rt.Services)
+	}
+}
+
+func TestListenerCertificateRefs(t *testing.T) {
+	tls := map[string]interface{}{
+		"certificateRefs": []interface{}{
+			map[string]interface{}{"name": "mcp-tls"},
+			map[string]interface{}{"kind": "Secret", "name": "shared-tls", "namespace": "certs"},
+			map[string]interface{}{"kind": "ConfigMap", "name": "ca"},
+		},
+	}
+	got := listenerCertificateRefs(tls, "agentgateway-system")
+	if len(got) != 2 || got[0] != "agentgateway-system/mcp-tls" || got[1] != "certs/shared-tls" {
+		t.Errorf("listenerCertificateRefs = %v", got)
+	}
+}
+
+func TestParseCertificate(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	notAfter := time.Now().Add(24 * time.Hour).Truncate(time.Second).UTC()
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "mcp.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+		DNSNames:     []string{"mcp.example.com", "*.mcp.example.com"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pemData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	info := parseCertificate("agentgateway-system/mcp-tls", pemData)
+	if info.Error != "" || !info.NotAfter.Equal(notAfter) || len(info.DNSNames) != 2 {
+		t.Errorf("parseCertificate = %+v", info)
+	}
+	if info.KeyAlgorithm != "ECDSA" || info.KeySize != 256 || info.Subject != "CN=mcp.example.com" {
+		t.Errorf("key/subject = %s %d %q", info.KeyAlgorithm, info.KeySize, info.Subject)
+	}
+
+	if bad := parseCertificate("ns/empty", nil); bad.Error == "" {
+		t.Error("a Secret without tls.crt should record an error")
+	}
+}
+
+func TestParseJWTProviders(t *testing.T) {
+	jwt := map[string]interface{}{
+		"mode": "Strict",
+		"providers": []interface{}{
+			map[string]interface{}{
+				"issuer":         "https://idp.example.com",
+				"audiences":      []interface{}{"mcp"},
+				"requiredClaims": []interface{}{"sub", "tenant"},
+				"jwks": map[string]interface{}{
+					"remote": map[string]interface{}{"jwksPath": "/jwks", "backendRef": map[string]interface{}{"name": "idp"}},
+				},
+			},
+			map[string]interface{}{
+				"issuer": "internal",
+				"jwks":   map[string]interface{}{"inline": `{"keys":[{"kty":"oct","k":"c2VjcmV0"},{"kty":"RSA","alg":"RS256"}]}`},
+			},
+		},
+	}
+	got := parseJWTProviders(jwt, "agentgateway-system")
+	if len(got) != 2 {
+		t.Fatalf("providers = %+v", got)
+	}
+	remote := got[0]
+	if remote.JWKSSource != "remote" || remote.JWKSURL != "agentgateway-system/idp/jwks" || len(remote.RequiredClaims) != 2 {
+		t.Errorf("remote provider = %+v", remote)
+	}
+	inline := got[1]
+	if inline.JWKSSource != "inline" || len(inline.Algorithms) != 2 || inline.Algorithms[0] != "HS256" || inline.Algorithms[1] != "RS256" {
+		t.Errorf("inline provider = %+v", inline)
+	}
+
+	single := parseJWTProviders(map[string]interface{}{
+		"issuer": "https://idp.example.com",
+		"jwks":   map[string]interface{}{"remote": map[string]interface{}{"url": "http://idp.example.com/jwks"}},
+	}, "ns")
+	if len(single) != 1 || single[0].JWKSURL != "http://idp.example.com/jwks" {
+		t.Errorf("single-provider form = %+v", single)
+	}
+	if none := parseJWTProviders(map[string]interface{}{"mode": "Strict"}, "ns"); len(none) != 0 {
+		t.Errorf("no provider configured = %+v", none)
+	}
+}
+
+func TestParseTrafficPolicyValues(t *testing.T) {
+	cors := parseCORSConfig(map[string]interface{}{
+		"allowOrigins":     []interface{}{"*"},
+		"allowMethods":     []interface{}{"GET", "POST"},
+		"allowCredentials": true,
+		"maxAge":           int64(3600),
+	})
+	if len(cors.AllowOrigins) != 1 || cors.AllowOrigins[0] != "*" || !cors.AllowCredentials || len(cors.AllowMethods) != 2 || cors.MaxAge != 3600 {
+		t.Errorf("cors = %+v", cors)
+	}
+
+	limits := parseRateLimits(map[string]interface{}{
+		"local": []interface{}{
+			map[string]interface{}{"unit": "Seconds", "requests": int64(1000000), "burst": int64(10)},
+		},
+		"global": map[string]interface{}{"domain": "mcp"},
+	})
+	if len(limits) != 2 || limits[0].Type != "local" || limits[0].Requests != 1000000 || limits[0].Unit != "Seconds" || limits[0].Burst != 10 || limits[1].Type != "global" {
+		t.Errorf("rate limits = %+v", limits)
+	}
+
+	rules := parsePromptGuardRules(map[string]interface{}{
+		"request": []interface{}{
+			map[string]interface{}{
+				"response": map[string]interface{}{"message": "Blocked"},
+				"regex": map[string]interface{}{
+					"action":   "Reject",
+					"matches":  []interface{}{"jailbreak"},
+					"builtins": []interface{}{"CreditCard"},
+				},
+			},
+		},
+		"response": map[string]interface{}{
+			"regex": map[string]interface{}{"action": "Mask", "builtins": []interface{}{"Email"}},
+		},
+	})
+	if len(rules) != 2 {
+		t.Fatalf("prompt guard rules = %+v", rules)
+	}
+	if r := rules[0]; r.Phase != "request" || r.Kind != "regex" || r.Action != "Reject" || len(r.Matches) != 1 || len(r.Builtins) != 1 {
+		t.Errorf("request rule = %+v", r)
+	}
+	if r := rules[1]; r.Phase != "response" || r.Action != "Mask" || r.Builtins[0] != "Email" {
+		t.Errorf("response rule = %+v", r)
+	}
+}
+
+func TestAuthorizationRules(t *testing.T) {
+	rules := authorizationRules(map[string]interface{}{
+		"policy": map[string]interface{}{
+			"matchExpressions": []interface{}{`jwt.sub == "alice"`, "mcp.tool.name in ['read']"},
+		},
+	}, "backend.mcp")
+	if len(rules) != 2 || rules[0].Action != "Allow" || rules[0].Section != "backend.mcp" || rules[1].Expression != "mcp.tool.name in ['read']" {
+		t.Errorf("rules = %+v", rules)
+	}
+
+	deny := authorizationRules(map[string]interface{}{
+		"action": "Deny",
+		"policy": map[string]interface{}{"matchExpressions": []interface{}{`mcp.tool.name == "exec"`}},
+	}, "traffic")
+	if len(deny) != 1 || deny[0].Action != "Deny" || deny[0].Section != "traffic" {
+		t.Errorf("deny rules = %+v", deny)
+	}
+	if none := authorizationRules(map[string]interface{}{"action": "Allow"}, "traffic"); len(none) != 0 {
+		t.Errorf("no expressions = %+v", none)
+	}
+}
@@ -0,0 +1,367 @@
+package evaluator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// AgentView is the agent-centric governance view of a kagent Agent: the MCP
+// servers and agents it references, the tools it can actually call and a
+// score aggregated from the paths it depends on.
+type AgentView struct {
+	ID        string `json:"id"` // "Agent/ns/name"
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Type      string `json:"type,omitempty"` // "Declarative", "BYO"
+	Ready     bool   `json:"ready"`
+
+	References []AgentReference `json:"references"`
+
+	// Tools across all MCP server references
+	DeclaredToolNames  []string        `json:"declaredToolNames"`  // toolNames listed on the Agent
+	EffectiveToolNames []string        `json:"effectiveToolNames"` // tools the agent can call after policy enforcement
+	ExposedToolRisk    ToolRiskSummary `json:"exposedToolRisk"`
+
+	// WeakestControl is the lowest-scoring required control on any path.
+	WeakestControl      string `json:"weakestControl,omitempty"`
+	WeakestControlScore int    `json:"weakestControlScore"`
+
+	Owner       string `json:"owner"`
+	OwnerSource string `json:"ownerSource,omitempty"`
+
+	Score    int       `json:"score"`
+	Grade    string    `json:"grade"`
+	Status   string    `json:"status"` // "compliant", "warning", "failing", "critical"
+	Findings []Finding `json:"findings"`
+}
+
+// AgentReference is one entry of an Agent's spec tools: an MCP server or
+// another agent.
+type AgentReference struct {
+	Type     string `json:"type"` // "McpServer", "Agent"
+	Kind     string `json:"kind,omitempty"`
+	Name     string `json:"name"`
+	TargetID string `json:"targetId,omitempty"` // MCPServerView or AgentView ID
+	Found    bool   `json:"found"`              // false when the target was not discovered
+	Score    int    `json:"score"`
+	Grade    string `json:"grade,omitempty"`
+
+	// MCP server references only
+	DeclaredToolNames   []string `json:"declaredToolNames,omitempty"`
+	EffectiveToolNames  []string `json:"effectiveToolNames,omitempty"`
+	BlockedToolNames    []string `json:"blockedToolNames,omitempty"` // declared but denied by policy
+	WeakestControl      string   `json:"weakestControl,omitempty"`
+	WeakestControlScore int      `json:"weakestControlScore"`
+}
+
+// BuildAgentViews builds an AgentView for every kagent Agent. An agent is only
+// as strong as the weakest path it uses: its score is the lowest score among
+// the MCP servers and agents it references, minus penalties for findings on
+// the Agent itself.
+func BuildAgentViews(state *ClusterState, views []MCPServerView, findings []Finding, policy Policy) []AgentView {
+	b := &agentViewBuilder{
+		views:    views,
+		findings: findings,
+		policy:   policy,
+		owners:   newOwnerResolver(state, policy.Ownership),
+		agents:   make(map[string]KagentAgentResource, len(state.KagentAgents)),
+		built:    make(map[string]*AgentView, len(state.KagentAgents)),
+		visiting: make(map[string]bool),
+	}
+	for _, a := range state.KagentAgents {
+		b.agents[agentViewID(a.Namespace, a.Name)] = a
+	}
+
+	out := make([]AgentView, 0, len(state.KagentAgents))
+	for _, a := range state.KagentAgents {
+		out = append(out, *b.build(agentViewID(a.Namespace, a.Name)))
+	}
+	return out
+}
+
+func agentViewID(namespace, name string) string {
+	return fmt.Sprintf("Agent/%s/%s", namespace, name)
+}
+
+type agentViewBuilder struct {
+	views    []MCPServerView
+	findings []Finding
+	policy   Policy
+	owners   *ownerResolver
+	agents   map[string]KagentAgentResource
+	built    map[string]*AgentView
+	visiting map[string]bool // guards against agent reference cycles
+}
+
+func (b *agentViewBuilder) build(id string) *AgentView {
+	if av, ok := b.built[id]; ok {
+		return av
+	}
+	a := b.agents[id]
+	b.visiting[id] = true
+	defer delete(b.visiting, id)
+
+	av := &AgentView{
+		ID:                 id,
+		Name:               a.Name,
+		Namespace:          a.Namespace,
+		Type:               a.Type,
+		Ready:              a.Ready,
+		References:         []AgentReference{},
+		DeclaredToolNames:  []string{},
+		EffectiveToolNames: []string{},
+		Findings:           []Finding{},
+	}
+	av.Owner, av.OwnerSource = b.owners.resolve("Agent", a.Namespace, a.Name)
+
+	nsPolicy := b.policy.ForNamespace(a.Namespace)
+	score := 100
+	weakest := -1
+	declared := map[string]bool{}
+	effective := map[string]bool{}
+
+	for _, t := range a.Tools {
+		ref := AgentReference{Type: t.Type, Kind: t.Kind, Name: t.Name}
+		if t.Type == "Agent" {
+			subID := agentViewID(a.Namespace, t.Name)
+			ref.TargetID = subID
+			if _, ok := b.agents[subID]; ok {
+				ref.Found = true
+				// A cycle back to an agent still being built adds no new paths.
+				if !b.visiting[subID] {
+					sub := b.build(subID)
+					ref.Score, ref.Grade = sub.Score, sub.Grade
+					if sub.Score < score {
+						score = sub.Score
+					}
+				}
+			}
+		} else if v := findAgentTarget(b.views, a.Namespace, t); v != nil {
+			ref.TargetID = v.ID
+			ref.Found = true
+			ref.Score, ref.Grade = v.Score, v.Grade
+			ref.DeclaredToolNames = t.ToolNames
+			ref.EffectiveToolNames, ref.BlockedToolNames = agentEffectiveTools(t.ToolNames, v)
+			ref.WeakestControl, ref.WeakestControlScore = weakestControl(v)
+			if ref.WeakestControl != "" && (weakest < 0 || ref.WeakestControlScore < weakest) {
+				weakest = ref.WeakestControlScore
+				av.WeakestControl, av.WeakestControlScore = ref.WeakestControl, ref.WeakestControlScore
+			}
+			for _, n := range t.ToolNames {
+				declared[n] = true
+			}
+			for _, n := range ref.EffectiveToolNames {
+				effective[n] = true
+			}
+			if v.Score < score {
+				score = v.Score
+			}
+		}
+		av.References = append(av.References, ref)
+	}
+
+	av.DeclaredToolNames = sortedKeys(declared)
+	av.EffectiveToolNames = sortedKeys(effective)
+	av.ExposedToolRisk = nsPolicy.ToolSensitivity.summarizeToolRisk(av.EffectiveToolNames)
+
+	for _, f := range b.findings {
+		if f.ResourceRef == id {
+			av.Findings = append(av.Findings, f)
+			score -= nsPolicy.findingPenalty(f)
+		}
+	}
+	if score < 0 {
+		score = 0
+	}
+	av.Score = score
+	av.Grade = scoreGrade(score)
+	av.Status = agentStatus(score, av.Findings)
+
+	b.built[id] = av
+	return av
+}
+
+// checkAgentReferences raises findings for Agent references governance cannot
+// follow: MCP servers and agents that were not discovered (AGENT-001) and
+// toolNames the referenced MCP server does not expose (AGENT-002).
+func checkAgentReferences(state *ClusterState, policy Policy) []Finding {
+	if len(state.KagentAgents) == 0 {
+		return nil
+	}
+	return agentReferenceFindings(state, BuildMCPServerViews(state, nil, policy))
+}
+
+// agentReferenceFindings resolves every Agent's references against the MCP
+// server views, the same way BuildAgentViews does.
+func agentReferenceFindings(state *ClusterState, views []MCPServerView) []Finding {
+	agents := make(map[string]bool, len(state.KagentAgents))
+	for _, a := range state.KagentAgents {
+		agents[agentViewID(a.Namespace, a.Name)] = true
+	}
+	var findings []Finding
+	ts := time.Now().Format(time.RFC3339)
+	for _, a := range state.KagentAgents {
+		id := agentViewID(a.Namespace, a.Name)
+		for _, t := range a.Tools {
+			found := false
+			if t.Type == "Agent" {
+				found = agents[agentViewID(a.Namespace, t.Name)]
+			} else if v := findAgentTarget(views, a.Namespace, t); v != nil {
+				found = true
+				if unknown := unknownAgentTools(t.ToolNames, v); len(unknown) > 0 {
+					findings = append(findings, Finding{
+						ID:          fmt.Sprintf("AGENT-002-%s-%s", a.Name, t.Name),
+						Severity:    SeverityLow,
+						Category:    CategoryGovernance,
+						Title:       fmt.Sprintf("Agent '%s' references tools MCP server '%s' does not expose", a.Name, t.Name),
+						Description: fmt.Sprintf("Agent '%s/%s' lists toolNames %s that MCP server '%s' does not expose.", a.Namespace, a.Name, strings.Join(unknown, ", "), v.ID),
+						Impact:      "Whatever tool later takes one of these names is granted to the agent without review.",
+						Remediation: "Remove the stale toolNames from the Agent, or correct them to the tools the server exposes.",
+						ResourceRef: id,
+						Namespace:   a.Namespace,
+						Timestamp:   ts,
+					})
+				}
+			}
+			if !found {
+				findings = append(findings, Finding{
+					ID:          fmt.Sprintf("AGENT-001-%s-%s", a.Name, t.Name),
+					Severity:    SeverityMedium,
+					Category:    CategoryGovernance,
+					Title:       fmt.Sprintf("Agent '%s' references unknown %s '%s'", a.Name, agentRefKind(t), t.Name),
+					Description: fmt.Sprintf("Agent '%s/%s' references %s '%s', which was not discovered in the evaluated namespaces. Its path is left out of the agent's score.", a.Namespace, a.Name, agentRefKind(t), t.Name),
+					Impact:      "The agent depends on a path governance cannot assess, and whoever creates a resource with that name later is trusted by the agent.",
+					Remediation: "Remove the stale reference from the Agent, or deploy the referenced resource in an evaluated namespace.",
+					ResourceRef: id,
+					Namespace:   a.Namespace,
+					Timestamp:   ts,
+				})
+			}
+		}
+	}
+	return findings
+}
+
+// findAgentTarget returns the MCP server view an agent tool reference points
+// to. References carry no namespace, so the agent's namespace is preferred.
+func findAgentTarget(views []MCPServerView, namespace string, t KagentToolRef) *MCPServerView {
+	source := ""
+	switch t.Kind {
+	case "MCPServer":
+		source = "KagentMCPServer"
+	case "RemoteMCPServer":
+		source = "KagentRemoteMCPServer"
+	}
+	var fallback *MCPServerView
+	for i := range views {
+		v := &views[i]
+		if v.Name != t.Name || (source != "" && v.Source != source) {
+			continue
+		}
+		if v.Namespace == namespace {
+			return v
+		}
+		if fallback == nil {
+			fallback = v
+		}
+	}
+	return fallback
+}
+
+// agentRefKind names the kind of resource an agent tool reference points to.
+func agentRefKind(t KagentToolRef) string {
+	switch {
+	case t.Type == "Agent":
+		return "agent"
+	case t.Kind != "":
+		return t.Kind
+	}
+	return "MCP server"
+}
+
+// unknownAgentTools returns the declared tools the server does not expose.
+// Servers whose tools were not discovered expose nothing to compare against.
+func unknownAgentTools(declared []string, v *MCPServerView) []string {
+	if len(v.ToolNames) == 0 {
+		return nil
+	}
+	exposed := make(map[string]bool, len(v.ToolNames))
+	for _, n := range v.ToolNames {
+		exposed[n] = true
+	}
+	var unknown []string
+	for _, n := range declared {
+		if !exposed[n] {
+			unknown = append(unknown, n)
+		}
+	}
+	return unknown
+}
+
+// agentEffectiveTools returns the tools an agent can call on the server and
+// the declared tools policy denies. An agent that declares no toolNames gets
+// every tool the server exposes after enforcement.
+func agentEffectiveTools(declared []string, v *MCPServerView) (effective, blocked []string) {
+	if len(declared) == 0 {
+		return append([]string{}, v.EffectiveToolNames...), nil
+	}
+	if !v.HasToolRestriction {
+		return append([]string{}, declared...), nil
+	}
+	allowed := make(map[string]bool, len(v.EffectiveToolNames))
+	for _, n := range v.EffectiveToolNames {
+		allowed[n] = true
+	}
+	effective = []string{}
+	for _, n := range declared {
+		if allowed[n] {
+			effective = append(effective, n)
+		} else {
+			blocked = append(blocked, n)
+		}
+	}
+	return effective, blocked
+}
+
+// weakestControl returns the lowest-scoring required control of a server.
+func weakestControl(v *MCPServerView) (string, int) {
+	name, score := "", 0
+	for _, e := range v.ScoreExplanations {
+		if e.Status == "not-required" {
+			continue
+		}
+		if name == "" || e.Score < score {
+			name, score = e.Category, e.Score
+		}
+	}
+	return name, score
+}
+
+func agentStatus(score int, findings []Finding) string {
+	for _, f := range findings {
+		if f.Severity == SeverityCritical {
+			return "critical"
+		}
+	}
+	switch {
+	case score >= 90:
+		return "compliant"
+	case score >= 70:
+		return "warning"
+	case score >= 30:
+		return "failing"
+	default:
+		return "critical"
+	}
+}
+
+func sortedKeys(m map[string]bool) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,186 @@
+package evaluator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func agentTestViews() []MCPServerView {
+	return []MCPServerView{
+		{
+			ID: "KagentRemoteMCPServer/apps/k8s", Name: "k8s", Namespace: "apps", Source: "KagentRemoteMCPServer",
+			ToolNames:          []string{"get_pods", "delete_pod", "exec_pod"},
+			EffectiveToolNames: []string{"get_pods", "delete_pod"},
+			HasToolRestriction: true,
+			Score:              80, Grade: "B",
+			ScoreExplanations: []ScoreExplanation{
+				{Category: "Authentication", Score: 100, Status: "pass"},
+				{Category: "Rate Limiting", Score: 0, Status: "not-required"},
+				{Category: "TLS Encryption", Score: 40, Status: "partial"},
+			},
+		},
+		{
+			ID: "KagentMCPServer/apps/files", Name: "files", Namespace: "apps", Source: "KagentMCPServer",
+			ToolNames:          []string{"read_file", "write_file"},
+			EffectiveToolNames: []string{"read_file", "write_file"},
+			Score:              55, Grade: "C",
+			ScoreExplanations: []ScoreExplanation{
+				{Category: "Gateway Routing", Score: 0, Status: "fail"},
+			},
+		},
+	}
+}
+
+func TestBuildAgentViews_PathsAndTools(t *testing.T) {
+	state := &ClusterState{
+		KagentAgents: []KagentAgentResource{
+			{Name: "ops", Namespace: "apps", Ready: true, Tools: []KagentToolRef{
+				{Type: "McpServer", Kind: "RemoteMCPServer", Name: "k8s", ToolNames: []string{"get_pods", "exec_pod"}},
+				{Type: "McpServer", Kind: "RemoteMCPServer", Name: "ghost"},
+			}},
+		},
+	}
+
+	findings := agentReferenceFindings(state, agentTestViews())
+	views := BuildAgentViews(state, agentTestViews(), findings, defaultPolicy())
+	if len(views) != 1 {
+		t.Fatalf("got %d views, want 1", len(views))
+	}
+	av := views[0]
+	// The weakest found path (80) less the penalty for the unknown "ghost" server
+	if want := 80 - defaultPolicy().SeverityPenalties.Medium; av.ID != "Agent/apps/ops" || av.Score != want || av.Status != "failing" {
+		t.Errorf("view = %s score=%d grade=%s status=%s, want score %d", av.ID, av.Score, av.Grade, av.Status, want)
+	}
+	if len(av.Findings) != 1 || av.Findings[0].ID != "AGENT-001-ops-ghost" || av.Findings[0].ResourceRef != "Agent/apps/ops" {
+		t.Errorf("findings = %+v", av.Findings)
+	}
+
+	k8s := av.References[0]
+	if !k8s.Found || k8s.TargetID != "KagentRemoteMCPServer/apps/k8s" {
+		t.Fatalf("k8s ref = %+v", k8s)
+	}
+	if !reflect.DeepEqual(k8s.EffectiveToolNames, []string{"get_pods"}) || !reflect.DeepEqual(k8s.BlockedToolNames, []string{"exec_pod"}) {
+		t.Errorf("effective = %v, blocked = %v", k8s.EffectiveToolNames, k8s.BlockedToolNames)
+	}
+	// Not-required controls are never the weakest
+	if k8s.WeakestControl != "TLS Encryption" || k8s.WeakestControlScore != 40 {
+		t.Errorf("weakest = %s (%d)", k8s.WeakestControl, k8s.WeakestControlScore)
+	}
+	if av.References[1].Found {
+		t.Error("undiscovered server should not be found")
+	}
+	if !reflect.DeepEqual(av.DeclaredToolNames, []string{"exec_pod", "get_pods"}) {
+		t.Errorf("declared = %v", av.DeclaredToolNames)
+	}
+}
+
+func TestBuildAgentViews_WeakestPathAndSubAgents(t *testing.T) {
+	state := &ClusterState{
+		KagentAgents: []KagentAgentResource{
+			{Name: "lead", Namespace: "apps", Tools: []KagentToolRef{
+				{Type: "McpServer", Kind: "RemoteMCPServer", Name: "k8s"},
+				{Type: "Agent", Name: "helper"},
+			}},
+			{Name: "helper", Namespace: "apps", Tools: []KagentToolRef{
+				{Type: "McpServer", Kind: "MCPServer", Name: "files"},
+				{Type: "Agent", Name: "lead"}, // cycle
+			}},
+		},
+	}
+	findings := []Finding{
+		{ID: "AGW-200-helper-files", Severity: SeverityMedium, ResourceRef: "Agent/apps/helper"},
+	}
+
+	views := BuildAgentViews(state, agentTestViews(), findings, defaultPolicy())
+	byName := map[string]AgentView{}
+	for _, v := range views {
+		byName[v.Name] = v
+	}
+
+	helper := byName["helper"]
+	wantHelper := 55 - defaultPolicy().findingPenalty(findings[0])
+	if helper.Score != wantHelper || len(helper.Findings) != 1 {
+		t.Errorf("helper score = %d, want %d (findings %d)", helper.Score, wantHelper, len(helper.Findings))
+	}
+	if helper.WeakestControl != "Gateway Routing" {
+		t.Errorf("helper weakest = %q", helper.WeakestControl)
+	}
+
+	lead := byName["lead"]
+	if lead.Score != helper.Score {
+		t.Errorf("lead score = %d, want the sub-agent's %d", lead.Score, helper.Score)
+	}
+	// No declared toolNames: every effective tool of the server is reachable
+	if !reflect.DeepEqual(lead.EffectiveToolNames, []string{"delete_pod", "get_pods"}) {
+		t.Errorf("lead effective = %v", lead.EffectiveToolNames)
+	}
+	if lead.ExposedToolRisk.Destructive != 1 {
+		t.Errorf("lead risk = %+v", lead.ExposedToolRisk)
+	}
+}
+
+func TestAgentReferenceFindings_UnknownTools(t *testing.T) {
+	state := &ClusterState{
+		KagentAgents: []KagentAgentResource{
+			{Name: "ops", Namespace: "apps", Tools: []KagentToolRef{
+				{Type: "McpServer", Kind: "MCPServer", Name: "files", ToolNames: []string{"read_file", "rm_rf"}},
+				{Type: "Agent", Name: "missing"},
+			}},
+		},
+	}
+	policy := defaultPolicy()
+
+	findings := agentReferenceFindings(state, agentTestViews())
+	av := BuildAgentViews(state, agentTestViews(), findings, policy)[0]
+
+	ids := map[string]bool{}
+	for _, f := range av.Findings {
+		ids[f.ID] = true
+	}
+	if len(ids) != 2 || !ids["AGENT-001-ops-missing"] || !ids["AGENT-002-ops-files"] {
+		t.Errorf("findings = %v", ids)
+	}
+	if want := 55 - policy.SeverityPenalties.Medium - policy.SeverityPenalties.Low; av.Score != want {
+		t.Errorf("score = %d, want %d", av.Score, want)
+	}
+}
+
+func TestEvaluate_AgentReferenceFindings(t *testing.T) {
+	state := emptyState()
+	state.Namespaces = []string{"apps"}
+	state.KagentAgents = []KagentAgentResource{
+		{Name: "ops", Namespace: "apps", Tools: []KagentToolRef{{Type: "McpServer", Kind: "RemoteMCPServer", Name: "ghost"}}},
+	}
+
+	result := Evaluate(state, defaultPolicy())
+	var got *Finding
+	for i, f := range result.Findings {
+		if f.ID == "AGENT-001-ops-ghost" {
+			got = &result.Findings[i]
+		}
+	}
+	if got == nil || got.Fingerprint == "" {
+		t.Fatalf("expected a fingerprinted AGENT-001-ops-ghost finding, got %+v", got)
+	}
+	if av := result.AgentViews[0]; len(av.Findings) != 1 || av.Findings[0].ID != got.ID {
+		t.Errorf("agent view findings = %+v", av.Findings)
+	}
+
+	policy := defaultPolicy()
+	policy.CheckOverrides = map[string]CheckOverride{"AGENT-": {Disabled: true}}
+	result = Evaluate(state, policy)
+	if av := result.AgentViews[0]; len(av.Findings) != 0 || av.Score != 100 {
+		t.Errorf("disabled: score = %d, findings = %+v", av.Score, av.Findings)
+	}
+}
+
+func TestEvaluate_PopulatesAgentViews(t *testing.T) {
+	state := fullCompliantState()
+	result := Evaluate(state, defaultPolicy())
+	if len(result.AgentViews) != len(state.KagentAgents) {
+		t.Fatalf("AgentViews = %d, want %d", len(result.AgentViews), len(state.KagentAgents))
+	}
+	if result.AgentViews[0].Grade == "" {
+		t.Error("agent view should be graded")
+	}
+}
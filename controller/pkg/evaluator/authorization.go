@@ -0,0 +1,911 @@
+package evaluator
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	celast "github.com/google/cel-go/common/ast"
+	"github.com/google/cel-go/common/operators"
+	"github.com/google/cel-go/parser"
+)
+
+// AuthorizationRule is one matchExpression of an AgentgatewayPolicy
+// authorization block.
+type AuthorizationRule struct {
+	Section    string `json:"section"` // "traffic" or "backend.mcp"
+	Action     string `json:"action"`  // "Allow" or "Deny"
+	Expression string `json:"expression"`
+}
+
+// ToolGrant is one way a rule can match a request: the tools it matches and
+// what the caller must present. A rule matches when any of its grants does.
+type ToolGrant struct {
+	Tools          []string          `json:"tools,omitempty"`          // matched tool names; empty with AnyTool
+	AnyTool        bool              `json:"anyTool,omitempty"`        // not restricted to named tools
+	ToolPatterns   []string          `json:"toolPatterns,omitempty"`   // glob patterns the tool name must match (startsWith/endsWith/contains)
+	ExcludedTools  []string          `json:"excludedTools,omitempty"`  // tool names excluded by != or !in
+	RequiredClaims []string          `json:"requiredClaims,omitempty"` // JWT claims the expression reads
+	ClaimValues    map[string]string `json:"claimValues,omitempty"`    // claim == literal constraints
+	Conditions     []string          `json:"conditions,omitempty"`     // other conditions, as CEL source
+}
+
+// RuleAnalysis is the parsed form of one authorization rule.
+type RuleAnalysis struct {
+	AuthorizationRule
+	Grants []ToolGrant `json:"grants,omitempty"`
+	Issue  string      `json:"issue,omitempty"`  // "invalid", "tautological", "contradictory" or "unreachable"
+	Detail string      `json:"detail,omitempty"` // parse error, dead branch or shadowing rule
+}
+
+// AuthorizationAnalysis is the parsed authorization of an AgentgatewayPolicy.
+type AuthorizationAnalysis struct {
+	Rules []RuleAnalysis `json:"rules"`
+}
+
+// ToolAccess is a tool admitted by a policy and the claims a caller needs for
+// it: the claims common to every grant admitting the tool, unioned across
+// sections (traffic and backend.mcp both apply).
+type ToolAccess struct {
+	Tool           string   `json:"tool"`
+	RequiredClaims []string `json:"requiredClaims,omitempty"`
+}
+
+// celMaxTerms caps the disjunctive normal form of a single expression;
+// larger expressions are treated as one opaque condition.
+const celMaxTerms = 256
+
+var (
+	authzEnvOnce sync.Once
+	authzEnv     *cel.Env
+	authzEnvErr  error
+)
+
+// authorizationEnv returns a CEL environment for parsing agentgateway
+// authorization expressions. Expressions are parsed, not type-checked: the
+// agentgateway variables (mcp, jwt, request, source) are not declared.
+func authorizationEnv() (*cel.Env, error) {
+	authzEnvOnce.Do(func() {
+		authzEnv, authzEnvErr = cel.NewEnv()
+	})
+	return authzEnv, authzEnvErr
+}
+
+// celTerm is one conjunction of the disjunctive normal form of an expression.
+type celTerm struct {
+	tools    map[string]bool // nil = any tool
+	patterns []string
+	excluded map[string]bool
+	claims   map[string]bool
+	claimEq  map[string]string
+	claimNe  map[string]map[string]bool
+	conds    []string
+	unsat    bool
+}
+
+func (t celTerm) clone() celTerm {
+	out := celTerm{patterns: append([]string{}, t.patterns...), conds: append([]string{}, t.conds...)}
+	if t.tools != nil {
+		out.tools = copySet(t.tools)
+	}
+	out.excluded = copySet(t.excluded)
+	out.claims = copySet(t.claims)
+	out.claimEq = map[string]string{}
+	for k, v := range t.claimEq {
+		out.claimEq[k] = v
+	}
+	out.claimNe = map[string]map[string]bool{}
+	for k, v := range t.claimNe {
+		out.claimNe[k] = copySet(v)
+	}
+	return out
+}
+
+func copySet(in map[string]bool) map[string]bool {
+	out := make(map[string]bool, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
+
+// admitsTool reports whether the term's tool constraint lets tool through.
+func (t celTerm) admitsTool(tool string) bool {
+	if t.tools != nil && !t.tools[tool] {
+		return false
+	}
+	if t.excluded[tool] {
+		return false
+	}
+	for _, p := range t.patterns {
+		if ok, _ := path.Match(p, tool); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// normalize folds tool patterns and exclusions into a named tool set and
+// marks the term unsatisfiable when its constraints conflict.
+func (t *celTerm) normalize() {
+	if t.tools != nil {
+		for tool := range t.tools {
+			if !t.admitsTool(tool) {
+				delete(t.tools, tool)
+			}
+		}
+		t.patterns, t.excluded = nil, map[string]bool{}
+		if len(t.tools) == 0 {
+			t.unsat = true
+		}
+	}
+	for claim, v := range t.claimEq {
+		if t.claimNe[claim][v] {
+			t.unsat = true
+		}
+	}
+	for _, c := range t.conds {
+		if containsString(t.conds, "!("+c+")") {
+			t.unsat = true
+		}
+	}
+}
+
+func (t celTerm) isTautology() bool {
+	return t.tools == nil && len(t.patterns) == 0 && len(t.excluded) == 0 &&
+		len(t.claims) == 0 && len(t.conds) == 0
+}
+
+func andTerm(a, b celTerm) celTerm {
+	out := a.clone()
+	if b.tools != nil {
+		if out.tools == nil {
+			out.tools = copySet(b.tools)
+		} else {
+			for tool := range out.tools {
+				if !b.tools[tool] {
+					delete(out.tools, tool)
+				}
+			}
+		}
+	}
+	for _, p := range b.patterns {
+		if !containsString(out.patterns, p) {
+			out.patterns = append(out.patterns, p)
+		}
+	}
+	for k := range b.excluded {
+		out.excluded[k] = true
+	}
+	for k := range b.claims {
+		out.claims[k] = true
+	}
+	for k, v := range b.claimEq {
+		if cur, ok := out.claimEq[k]; ok && cur != v {
+			out.unsat = true
+		}
+		out.claimEq[k] = v
+	}
+	for k, vs := range b.claimNe {
+		if out.claimNe[k] == nil {
+			out.claimNe[k] = map[string]bool{}
+		}
+		for v := range vs {
+			out.claimNe[k][v] = true
+		}
+	}
+	for _, c := range b.conds {
+		out.conds = appendUnique(out.conds, c)
+	}
+	out.unsat = out.unsat || b.unsat
+	out.normalize()
+	return out
+}
+
+// covers reports whether every request matched by b is also matched by a.
+func (a celTerm) covers(b celTerm) bool {
+	if b.tools != nil {
+		for tool := range b.tools {
+			if !a.admitsTool(tool) {
+				return false
+			}
+		}
+	} else {
+		if a.tools != nil {
+			return false
+		}
+		for _, p := range a.patterns {
+			if !containsString(b.patterns, p) {
+				return false
+			}
+		}
+		for tool := range a.excluded {
+			if !b.excluded[tool] {
+				return false
+			}
+		}
+	}
+	for c := range a.claims {
+		if !b.claims[c] {
+			return false
+		}
+	}
+	for k, v := range a.claimEq {
+		if b.claimEq[k] != v {
+			return false
+		}
+	}
+	for k, vs := range a.claimNe {
+		for v := range vs {
+			if !b.claimNe[k][v] && (b.claimEq[k] == "" || b.claimEq[k] == v) {
+				return false
+			}
+		}
+	}
+	for _, c := range a.conds {
+		if !containsString(b.conds, c) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t celTerm) grant() ToolGrant {
+	g := ToolGrant{AnyTool: t.tools == nil}
+	g.Tools = sortedKeys(t.tools)
+	g.ExcludedTools = sortedKeys(t.excluded)
+	g.RequiredClaims = sortedKeys(t.claims)
+	if len(t.patterns) > 0 {
+		g.ToolPatterns = append([]string{}, t.patterns...)
+	}
+	if len(t.claimEq) > 0 {
+		g.ClaimValues = t.claimEq
+	}
+	if len(t.conds) > 0 {
+		g.Conditions = append([]string{}, t.conds...)
+	}
+	return g
+}
+
+func newTerm() celTerm {
+	return celTerm{excluded: map[string]bool{}, claims: map[string]bool{}, claimEq: map[string]string{}, claimNe: map[string]map[string]bool{}}
+}
+
+// celAnalyzer converts a parsed expression into disjunctive normal form.
+type celAnalyzer struct {
+	env  *cel.Env
+	info *celast.SourceInfo
+	dead []string // branches of a disjunction that can never match
+}
+
+func (a *celAnalyzer) source(e celast.Expr) string {
+	s, err := parser.Unparse(e, a.info)
+	if err != nil {
+		return "<expr>"
+	}
+	return s
+}
+
+// analyze returns the DNF of e, or of !e when neg is set. No terms means
+// the expression can never match.
+func (a *celAnalyzer) analyze(e celast.Expr, neg bool) []celTerm {
+	if e.Kind() == celast.CallKind {
+		call := e.AsCall()
+		args := call.Args()
+		switch call.FunctionName() {
+		case operators.LogicalAnd, operators.LogicalOr:
+			conj := call.FunctionName() == operators.LogicalAnd
+			if neg {
+				conj = !conj
+			}
+			out := a.analyze(args[0], neg)
+			for _, arg := range args[1:] {
+				next := a.analyze(arg, neg)
+				if conj {
+					out = a.and(out, next, e)
+				} else {
+					out = a.or(out, next, args[0], arg)
+				}
+			}
+			return out
+		case operators.LogicalNot:
+			return a.analyze(args[0], !neg)
+		case operators.Equals, operators.NotEquals:
+			eq := (call.FunctionName() == operators.Equals) != neg
+			if lit, ok := stringLiteral(args[1]); ok && isToolRef(args[0]) {
+				return []celTerm{toolTerm([]string{lit}, eq)}
+			}
+			if lit, ok := stringLiteral(args[0]); ok && isToolRef(args[1]) {
+				return []celTerm{toolTerm([]string{lit}, eq)}
+			}
+			if claim, lit, ok := claimComparison(args); ok {
+				t := newTerm()
+				t.claims[claim] = true
+				if eq {
+					t.claimEq[claim] = lit
+				} else {
+					t.claimNe[claim] = map[string]bool{lit: true}
+				}
+				return []celTerm{t}
+			}
+		case operators.In:
+			if isToolRef(args[0]) && args[1].Kind() == celast.ListKind {
+				if names, ok := stringList(args[1]); ok {
+					if !neg && len(names) == 0 {
+						return nil
+					}
+					return []celTerm{toolTerm(names, !neg)}
+				}
+			}
+		case "startsWith", "endsWith", "contains":
+			if call.IsMemberFunction() && isToolRef(call.Target()) && !neg {
+				if lit, ok := stringLiteral(args[0]); ok {
+					t := newTerm()
+					pattern := map[string]string{"startsWith": lit + "*", "endsWith": "*" + lit, "contains": "*" + lit + "*"}[call.FunctionName()]
+					t.patterns = []string{pattern}
+					return []celTerm{t}
+				}
+			}
+		}
+	}
+	if e.Kind() == celast.LiteralKind {
+		if b, ok := e.AsLiteral().Value().(bool); ok {
+			if b != neg {
+				return []celTerm{newTerm()}
+			}
+			return nil
+		}
+	}
+	return a.atom(e, neg)
+}
+
+// atom handles a condition the analyzer does not model: a constant
+// expression is evaluated; anything else is kept as an opaque condition,
+// recording the JWT claims it reads.
+func (a *celAnalyzer) atom(e celast.Expr, neg bool) []celTerm {
+	src := a.source(e)
+	claims, idents := exprReferences(e)
+	if len(idents) == 0 {
+		if val, ok := a.evalConstant(src); ok {
+			if val != neg {
+				return []celTerm{newTerm()}
+			}
+			return nil
+		}
+	}
+	t := newTerm()
+	isPresence := e.Kind() == celast.SelectKind && e.AsSelect().IsTestOnly()
+	if !(neg && isPresence) {
+		for _, c := range claims {
+			t.claims[c] = true
+		}
+	}
+	if neg {
+		src = "!(" + src + ")"
+	}
+	t.conds = []string{src}
+	return []celTerm{t}
+}
+
+func (a *celAnalyzer) evalConstant(src string) (bool, bool) {
+	ast, iss := a.env.Compile(src)
+	if iss != nil && iss.Err() != nil {
+		return false, false
+	}
+	prg, err := a.env.Program(ast)
+	if err != nil {
+		return false, false
+	}
+	out, _, err := prg.Eval(map[string]interface{}{})
+	if err != nil {
+		return false, false
+	}
+	b, ok := out.Value().(bool)
+	return b, ok
+}
+
+func (a *celAnalyzer) and(x, y []celTerm, e celast.Expr) []celTerm {
+	var out []celTerm
+	for _, tx := range x {
+		for _, ty := range y {
+			if t := andTerm(tx, ty); !t.unsat {
+				out = append(out, t)
+			}
+		}
+	}
+	if len(out) > celMaxTerms {
+		return a.atom(e, false)
+	}
+	return out
+}
+
+func (a *celAnalyzer) or(x, y []celTerm, ex, ey celast.Expr) []celTerm {
+	if len(x) == 0 && len(y) > 0 {
+		a.dead = append(a.dead, a.source(ex))
+	}
+	if len(y) == 0 && len(x) > 0 {
+		a.dead = append(a.dead, a.source(ey))
+	}
+	return append(append([]celTerm{}, x...), y...)
+}
+
+func toolTerm(names []string, include bool) celTerm {
+	t := newTerm()
+	set := map[string]bool{}
+	for _, n := range names {
+		set[n] = true
+	}
+	if include {
+		t.tools = set
+	} else {
+		t.excluded = set
+	}
+	return t
+}
+
+// isToolRef reports whether e is mcp.tool.name.
+func isToolRef(e celast.Expr) bool {
+	return selectPath(e) == "mcp.tool.name"
+}
+
+// selectPath renders a chain of field selections rooted at an identifier
+// ("jwt.sub"), or "" for any other expression.
+func selectPath(e celast.Expr) string {
+	switch e.Kind() {
+	case celast.IdentKind:
+		return e.AsIdent()
+	case celast.SelectKind:
+		if e.AsSelect().IsTestOnly() {
+			return ""
+		}
+		if p := selectPath(e.AsSelect().Operand()); p != "" {
+			return p + "." + e.AsSelect().FieldName()
+		}
+	}
+	return ""
+}
+
+// jwtClaim returns the claim read by jwt.<claim> or jwt["<claim>"].
+func jwtClaim(e celast.Expr) (string, bool) {
+	switch e.Kind() {
+	case celast.SelectKind:
+		sel := e.AsSelect()
+		if sel.Operand().Kind() == celast.IdentKind && sel.Operand().AsIdent() == "jwt" {
+			return sel.FieldName(), true
+		}
+	case celast.CallKind:
+		call := e.AsCall()
+		if call.FunctionName() == operators.Index && len(call.Args()) == 2 {
+			if op := call.Args()[0]; op.Kind() == celast.IdentKind && op.AsIdent() == "jwt" {
+				return stringLiteral(call.Args()[1])
+			}
+		}
+	}
+	return "", false
+}
+
+// claimComparison matches jwt.<claim> compared with a string literal.
+func claimComparison(args []celast.Expr) (string, string, bool) {
+	if claim, ok := jwtClaim(args[0]); ok {
+		if lit, ok := stringLiteral(args[1]); ok {
+			return claim, lit, true
+		}
+	}
+	if claim, ok := jwtClaim(args[1]); ok {
+		if lit, ok := stringLiteral(args[0]); ok {
+			return claim, lit, true
+		}
+	}
+	return "", "", false
+}
+
+func stringLiteral(e celast.Expr) (string, bool) {
+	if e.Kind() != celast.LiteralKind {
+		return "", false
+	}
+	s, ok := e.AsLiteral().Value().(string)
+	return s, ok
+}
+
+func stringList(e celast.Expr) ([]string, bool) {
+	var out []string
+	for _, el := range e.AsList().Elements() {
+		s, ok := stringLiteral(el)
+		if !ok {
+			return nil, false
+		}
+		out = append(out, s)
+	}
+	return out, true
+}
+
+// exprReferences returns the JWT claims and the free root identifiers e reads.
+// Comprehension variables (e.g. the x in jwt.groups.exists(x, ...)) count as
+// identifiers, so such expressions are never constant-folded.
+func exprReferences(e celast.Expr) (claims, idents []string) {
+	bound := map[string]bool{} // comprehension variables
+	var walk func(celast.Expr)
+	walk = func(e celast.Expr) {
+		if c, ok := jwtClaim(e); ok {
+			claims = appendUnique(claims, c)
+		}
+		switch e.Kind() {
+		case celast.IdentKind:
+			idents = appendUnique(idents, e.AsIdent())
+		case celast.SelectKind:
+			walk(e.AsSelect().Operand())
+		case celast.CallKind:
+			call := e.AsCall()
+			if call.IsMemberFunction() {
+				walk(call.Target())
+			}
+			for _, arg := range call.Args() {
+				walk(arg)
+			}
+		case celast.ListKind:
+			for _, el := range e.AsList().Elements() {
+				walk(el)
+			}
+		case celast.MapKind:
+			for _, entry := range e.AsMap().Entries() {
+				walk(entry.AsMapEntry().Key())
+				walk(entry.AsMapEntry().Value())
+			}
+		case celast.ComprehensionKind:
+			comp := e.AsComprehension()
+			bound[comp.IterVar()] = true
+			bound[comp.AccuVar()] = true
+			walk(comp.IterRange())
+			walk(comp.AccuInit())
+			walk(comp.LoopCondition())
+			walk(comp.LoopStep())
+			walk(comp.Result())
+		}
+	}
+	walk(e)
+	free := idents[:0]
+	for _, id := range idents {
+		if !bound[id] {
+			free = append(free, id)
+		}
+	}
+	return claims, free
+}
+
+// AnalyzeAuthorization parses each rule's CEL expression and flags
+// tautological (always true), contradictory (never true) and unreachable
+// rules: dead branches, and rules shadowed by an earlier rule of the same
+// section and action.
+func AnalyzeAuthorization(rules []AuthorizationRule) *AuthorizationAnalysis {
+	out := &AuthorizationAnalysis{Rules: []RuleAnalysis{}}
+	env, err := authorizationEnv()
+	terms := make([][]celTerm, len(rules))
+	for i, r := range rules {
+		ra := RuleAnalysis{AuthorizationRule: r}
+		if err != nil {
+			ra.Issue, ra.Detail = "invalid", err.Error()
+			out.Rules = append(out.Rules, ra)
+			continue
+		}
+		ast, iss := env.Parse(r.Expression)
+		if iss != nil && iss.Err() != nil {
+			ra.Issue, ra.Detail = "invalid", strings.TrimSpace(iss.Err().Error())
+			out.Rules = append(out.Rules, ra)
+			continue
+		}
+		an := &celAnalyzer{env: env, info: ast.NativeRep().SourceInfo()}
+		terms[i] = an.analyze(ast.NativeRep().Expr(), false)
+		for _, t := range terms[i] {
+			ra.Grants = append(ra.Grants, t.grant())
+		}
+		switch {
+		case len(terms[i]) == 0:
+			ra.Issue = "contradictory"
+		case anyTautology(terms[i]):
+			ra.Issue = "tautological"
+		case len(an.dead) > 0:
+			ra.Issue, ra.Detail = "unreachable", "branch can never match: "+strings.Join(an.dead, "; ")
+		}
+		if ra.Issue == "" {
+			for j := 0; j < i; j++ {
+				prev := rules[j]
+				if prev.Section != r.Section || prev.Action != r.Action || len(terms[j]) == 0 {
+					continue
+				}
+				if termsCovered(terms[i], terms[j]) {
+					ra.Issue, ra.Detail = "unreachable", "shadowed by earlier rule: "+prev.Expression
+					break
+				}
+			}
+		}
+		out.Rules = append(out.Rules, ra)
+	}
+	return out
+}
+
+func anyTautology(terms []celTerm) bool {
+	for _, t := range terms {
+		if t.isTautology() {
+			return true
+		}
+	}
+	return false
+}
+
+// termsCovered reports whether every term of later is covered by a term of earlier.
+func termsCovered(later, earlier []celTerm) bool {
+	for _, t := range later {
+		covered := false
+		for _, e := range earlier {
+			if e.covers(t) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+// grantAdmits reports whether a grant matches tool.
+func grantAdmits(g ToolGrant, tool string) bool {
+	if !g.AnyTool && !containsString(g.Tools, tool) {
+		return false
+	}
+	if containsString(g.ExcludedTools, tool) {
+		return false
+	}
+	for _, p := range g.ToolPatterns {
+		if ok, _ := path.Match(p, tool); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// ResolveTools returns the tools the policy admits and the claims each
+// requires, and whether the policy restricts tools at all. Allow rules of a
+// section are OR-ed and sections are AND-ed; Deny rules without claims or
+// other conditions remove tools. Tools named in Allow rules are always
+// listed; grants matching any tool or a pattern are resolved against known.
+func (a *AuthorizationAnalysis) ResolveTools(known []string) ([]ToolAccess, bool) {
+	if a == nil {
+		return nil, false
+	}
+	var sections []string
+	for _, r := range a.Rules {
+		if r.Action == "Allow" && r.Issue != "invalid" && !containsString(sections, r.Section) {
+			sections = append(sections, r.Section)
+		}
+	}
+
+	restricted := false
+	var admitted map[string][]string // tool -> claims
+	for _, section := range sections {
+		unrestricted := false
+		claimSets := map[string][][]string{}
+		for _, r := range a.Rules {
+			if r.Section != section || r.Action != "Allow" {
+				continue
+			}
+			for _, g := range r.Grants {
+				if g.AnyTool && len(g.ToolPatterns) == 0 && len(g.ExcludedTools) == 0 {
+					unrestricted = true
+				}
+				candidates := g.Tools
+				if g.AnyTool {
+					candidates = known
+				}
+				for _, tool := range candidates {
+					if grantAdmits(g, tool) {
+						claimSets[tool] = append(claimSets[tool], g.RequiredClaims)
+					}
+				}
+			}
+		}
+		if unrestricted {
+			for _, tool := range known {
+				if _, ok := claimSets[tool]; !ok {
+					claimSets[tool] = [][]string{nil}
+				}
+			}
+		} else {
+			restricted = true
+		}
+		sectionTools := map[string][]string{}
+		for tool, sets := range claimSets {
+			sectionTools[tool] = intersectClaims(sets)
+		}
+		if admitted == nil {
+			admitted = sectionTools
+			continue
+		}
+		for tool, claims := range admitted {
+			more, ok := sectionTools[tool]
+			if !ok {
+				delete(admitted, tool)
+				continue
+			}
+			for _, c := range more {
+				claims = appendUnique(claims, c)
+			}
+			sort.Strings(claims)
+			admitted[tool] = claims
+		}
+	}
+	if admitted == nil {
+		admitted = map[string][]string{}
+		for _, tool := range known {
+			admitted[tool] = nil
+		}
+	}
+
+	for _, r := range a.Rules {
+		if r.Action != "Deny" {
+			continue
+		}
+		for _, g := range r.Grants {
+			if len(g.RequiredClaims) > 0 || len(g.Conditions) > 0 {
+				continue
+			}
+			for tool := range admitted {
+				if grantAdmits(g, tool) {
+					delete(admitted, tool)
+					restricted = true
+				}
+			}
+		}
+	}
+
+	out := make([]ToolAccess, 0, len(admitted))
+	for _, tool := range sortedKeys(boolSet(admitted)) {
+		out = append(out, ToolAccess{Tool: tool, RequiredClaims: admitted[tool]})
+	}
+	return out, restricted
+}
+
+func boolSet(m map[string][]string) map[string]bool {
+	out := make(map[string]bool, len(m))
+	for k := range m {
+		out[k] = true
+	}
+	return out
+}
+
+// intersectClaims returns the claims present in every set.
+func intersectClaims(sets [][]string) []string {
+	if len(sets) == 0 {
+		return nil
+	}
+	var out []string
+	for _, c := range sets[0] {
+		all := true
+		for _, s := range sets[1:] {
+			if !containsString(s, c) {
+				all = false
+				break
+			}
+		}
+		if all {
+			out = append(out, c)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// AllowedToolNames returns the tool names a policy admits when it restricts
+// tools, or nil when it does not.
+func (a *AuthorizationAnalysis) AllowedToolNames(known []string) []string {
+	access, restricted := a.ResolveTools(known)
+	if !restricted {
+		return nil
+	}
+	names := make([]string, 0, len(access))
+	for _, t := range access {
+		names = append(names, t.Tool)
+	}
+	return names
+}
+
+// checkAuthorizationRules flags authorization rules that are invalid CEL
+// (RBAC-006), always true (RBAC-003), never true (RBAC-004) or unreachable
+// (RBAC-005).
+func checkAuthorizationRules(state *ClusterState, policy Policy) []Finding {
+	var findings []Finding
+	if !policy.RequireRBAC {
+		return findings
+	}
+	ts := time.Now().Format(time.RFC3339)
+	for _, p := range state.AgentgatewayPolicies {
+		if p.Authorization == nil {
+			continue
+		}
+		byIssue := map[string][]RuleAnalysis{}
+		for _, r := range p.Authorization.Rules {
+			if r.Issue != "" {
+				byIssue[r.Issue] = append(byIssue[r.Issue], r)
+			}
+		}
+		for _, issue := range []string{"tautological", "contradictory", "unreachable", "invalid"} {
+			rules := byIssue[issue]
+			if len(rules) == 0 {
+				continue
+			}
+			var exprs []string
+			allow := false
+			for _, r := range rules {
+				line := fmt.Sprintf("[%s %s] %s", r.Section, r.Action, r.Expression)
+				if r.Detail != "" {
+					line += " (" + r.Detail + ")"
+				}
+				exprs = append(exprs, line)
+				allow = allow || r.Action == "Allow"
+			}
+			f := Finding{
+				Category:    CategoryAuthorization,
+				ResourceRef: agentgatewayPolicyRef(p),
+				Namespace:   p.Namespace,
+				Timestamp:   ts,
+			}
+			switch issue {
+			case "tautological":
+				f.ID = fmt.Sprintf("RBAC-003-%s", p.Name)
+				f.Severity = SeverityLow
+				f.Title = fmt.Sprintf("Authorization rule on policy '%s' always matches", p.Name)
+				f.Impact = "The Deny rule blocks every request, including legitimate tool calls."
+				if allow {
+					f.Severity = SeverityHigh
+					f.Impact = "The Allow rule admits every caller to every tool, so the policy's tool restrictions and claim checks have no effect."
+				}
+				f.Description = fmt.Sprintf("AgentgatewayPolicy '%s/%s' has rules that are true for every request: %s.", p.Namespace, p.Name, strings.Join(exprs, "; "))
+				f.Remediation = "Remove the always-true branch (e.g. a literal true OR-ed into the expression) so the rule only matches the intended tools and claims."
+			case "contradictory":
+				f.ID = fmt.Sprintf("RBAC-004-%s", p.Name)
+				f.Severity = SeverityMedium
+				f.Impact = "The Allow rule never matches, so the tools it was meant to grant are denied."
+				if !allow {
+					f.Severity = SeverityHigh
+					f.Impact = "The Deny rule never matches, so the requests it was meant to block are let through."
+				}
+				f.Title = fmt.Sprintf("Authorization rule on policy '%s' can never match", p.Name)
+				f.Description = fmt.Sprintf("AgentgatewayPolicy '%s/%s' has rules whose conditions contradict each other: %s.", p.Namespace, p.Name, strings.Join(exprs, "; "))
+				f.Remediation = "Fix the conflicting conditions (e.g. a tool or claim compared with two different values joined by &&)."
+			case "unreachable":
+				f.ID = fmt.Sprintf("RBAC-005-%s", p.Name)
+				f.Severity = SeverityLow
+				f.Title = fmt.Sprintf("Unreachable authorization rules on policy '%s'", p.Name)
+				f.Description = fmt.Sprintf("AgentgatewayPolicy '%s/%s' has rules or branches that can never take effect: %s.", p.Namespace, p.Name, strings.Join(exprs, "; "))
+				f.Impact = "Dead rules suggest the policy does not do what its author intended and hide real access rules during review."
+				f.Remediation = "Remove the shadowed rule or dead branch, or tighten the earlier rule that already matches these requests."
+			case "invalid":
+				f.ID = fmt.Sprintf("RBAC-006-%s", p.Name)
+				f.Severity = SeverityMedium
+				f.Title = fmt.Sprintf("Authorization rule on policy '%s' is not valid CEL", p.Name)
+				f.Description = fmt.Sprintf("AgentgatewayPolicy '%s/%s' has expressions that do not parse: %s.", p.Namespace, p.Name, strings.Join(exprs, "; "))
+				f.Impact = "agentgateway rejects or ignores the rule, so the intended tool access control is not applied."
+				f.Remediation = "Fix the CEL syntax of the matchExpressions."
+			}
+			findings = append(findings, f)
+		}
+	}
+	return findings
+}
+
+// isAuthorizationRuleFinding reports whether a finding ID is an
+// authorization rule issue (RBAC-003..006) that lowers the per-server
+// Authorization score even when RBAC is present.
+func isAuthorizationRuleFinding(id string) bool {
+	for _, code := range []string{"RBAC-003-", "RBAC-004-", "RBAC-005-", "RBAC-006-"} {
+		if strings.HasPrefix(id, code) {
+			return true
+		}
+	}
+	return false
+}
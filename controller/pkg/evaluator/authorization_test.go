@@ -0,0 +1,153 @@
+package evaluator
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func allowRules(exprs ...string) []AuthorizationRule {
+	var out []AuthorizationRule
+	for _, e := range exprs {
+		out = append(out, AuthorizationRule{Section: "backend.mcp", Action: "Allow", Expression: e})
+	}
+	return out
+}
+
+func TestAnalyzeAuthorization_Issues(t *testing.T) {
+	tests := []struct {
+		name      string
+		exprs     []string
+		wantIssue []string
+	}{
+		{"tool list", []string{"mcp.tool.name in ['a', 'b']"}, []string{""}},
+		{"claim and tool", []string{`jwt.sub == "alice" && mcp.tool.name == "a"`}, []string{""}},
+		{"literal true", []string{`true || mcp.tool.name == "x"`}, []string{"tautological"}},
+		{"constant comparison", []string{`1 == 1`}, []string{"tautological"}},
+		{"negated empty list", []string{`!(mcp.tool.name in [])`}, []string{"tautological"}},
+		{"two tool names", []string{`mcp.tool.name == "a" && mcp.tool.name == "b"`}, []string{"contradictory"}},
+		{"claim values", []string{`jwt.role == "admin" && jwt.role == "viewer"`}, []string{"contradictory"}},
+		{"negation", []string{`mcp.tool.name == "a" && !(mcp.tool.name in ["a", "b"])`}, []string{"contradictory"}},
+		{"opaque and its negation", []string{`request.path == "/x" && !(request.path == "/x")`}, []string{"contradictory"}},
+		{"dead branch", []string{`mcp.tool.name == "a" || (jwt.sub == "x" && false)`}, []string{"unreachable"}},
+		{"shadowed", []string{"mcp.tool.name in ['a', 'b']", `mcp.tool.name == "a" && jwt.sub == "alice"`}, []string{"", "unreachable"}},
+		{"not shadowed", []string{`mcp.tool.name == "a" && jwt.sub == "alice"`, "mcp.tool.name in ['a', 'b']"}, []string{"", ""}},
+		{"syntax error", []string{`mcp.tool.name ==`}, []string{"invalid"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := AnalyzeAuthorization(allowRules(tt.exprs...))
+			var got []string
+			for _, r := range a.Rules {
+				got = append(got, r.Issue)
+			}
+			if !reflect.DeepEqual(got, tt.wantIssue) {
+				t.Errorf("issues = %q, want %q (%+v)", got, tt.wantIssue, a.Rules)
+			}
+		})
+	}
+}
+
+func TestAnalyzeAuthorization_Grants(t *testing.T) {
+	a := AnalyzeAuthorization(allowRules(
+		`(jwt.sub == "alice" || "admins" in jwt.groups) && mcp.tool.name in ["read", "write"]`,
+	))
+	grants := a.Rules[0].Grants
+	if len(grants) != 2 {
+		t.Fatalf("grants = %+v", grants)
+	}
+	if !reflect.DeepEqual(grants[0].Tools, []string{"read", "write"}) || grants[0].ClaimValues["sub"] != "alice" {
+		t.Errorf("first grant = %+v", grants[0])
+	}
+	if !reflect.DeepEqual(grants[1].RequiredClaims, []string{"groups"}) || len(grants[1].Conditions) != 1 {
+		t.Errorf("second grant = %+v", grants[1])
+	}
+}
+
+func TestResolveTools(t *testing.T) {
+	known := []string{"get_a", "get_b", "delete_a", "exec"}
+	all := []string{"delete_a", "exec", "get_a", "get_b"}
+	tests := []struct {
+		name           string
+		rules          []AuthorizationRule
+		wantTools      []string
+		wantRestricted bool
+	}{
+		{"named tools", allowRules("mcp.tool.name in ['get_a', 'unknown_tool']"), []string{"get_a", "unknown_tool"}, true},
+		{"prefix", allowRules(`mcp.tool.name.startsWith("get_")`), []string{"get_a", "get_b"}, true},
+		{"tautology", allowRules(`true || mcp.tool.name == "exec"`), all, false},
+		{"exclusion", allowRules(`mcp.tool.name != "exec"`), []string{"delete_a", "get_a", "get_b"}, true},
+		{"deny", []AuthorizationRule{{Section: "traffic", Action: "Deny", Expression: `mcp.tool.name in ["exec", "delete_a"]`}}, []string{"get_a", "get_b"}, true},
+		{"conditional deny keeps tool", []AuthorizationRule{{Section: "traffic", Action: "Deny", Expression: `mcp.tool.name == "exec" && jwt.sub != "root"`}}, all, false},
+		{"sections intersect", append(allowRules("mcp.tool.name in ['get_a', 'get_b']"),
+			AuthorizationRule{Section: "traffic", Action: "Allow", Expression: "mcp.tool.name in ['get_b', 'exec']"}), []string{"get_b"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			access, restricted := AnalyzeAuthorization(tt.rules).ResolveTools(known)
+			var got []string
+			for _, a := range access {
+				got = append(got, a.Tool)
+			}
+			if !reflect.DeepEqual(got, tt.wantTools) || restricted != tt.wantRestricted {
+				t.Errorf("tools = %v (restricted %v), want %v (restricted %v)", got, restricted, tt.wantTools, tt.wantRestricted)
+			}
+		})
+	}
+}
+
+func TestResolveTools_RequiredClaims(t *testing.T) {
+	a := AnalyzeAuthorization(allowRules(
+		`jwt.sub == "alice" && jwt.tenant == "t1" && mcp.tool.name == "write"`,
+		`has(jwt.sub) && mcp.tool.name in ["read", "write"]`,
+	))
+	access, _ := a.ResolveTools(nil)
+	got := map[string][]string{}
+	for _, t := range access {
+		got[t.Tool] = t.RequiredClaims
+	}
+	// "write" is reachable with either grant, so only their common claim is required.
+	if !reflect.DeepEqual(got["write"], []string{"sub"}) || !reflect.DeepEqual(got["read"], []string{"sub"}) {
+		t.Errorf("required claims = %v", got)
+	}
+}
+
+func TestCheckAuthorizationRules(t *testing.T) {
+	p := AgentgatewayPolicyResource{Name: "p1", Namespace: "agentgateway-system", HasRBAC: true,
+		Authorization: AnalyzeAuthorization(append(allowRules(`true || mcp.tool.name == "x"`),
+			AuthorizationRule{Section: "traffic", Action: "Deny", Expression: `mcp.tool.name == "a" && mcp.tool.name == "b"`}))}
+	got := map[string]Finding{}
+	for _, f := range checkAuthorizationRules(&ClusterState{AgentgatewayPolicies: []AgentgatewayPolicyResource{p}}, defaultPolicy()) {
+		got[f.ID] = f
+	}
+	if f, ok := got["RBAC-003-p1"]; !ok || f.Severity != SeverityHigh || !strings.Contains(f.Description, "true ||") {
+		t.Errorf("tautological Allow rule: %+v", got)
+	}
+	if f, ok := got["RBAC-004-p1"]; !ok || f.Severity != SeverityHigh {
+		t.Errorf("contradictory Deny rule: %+v", got)
+	}
+	if len(got) != 2 {
+		t.Errorf("findings = %v", got)
+	}
+}
+
+func TestBuildMCPServerViews_AuthorizationTools(t *testing.T) {
+	state := &ClusterState{
+		KagentRemoteMCPServers: []KagentRemoteMCPServerResource{{Name: "tools", Namespace: "mcp", URL: "http://tools.mcp:8080/mcp",
+			ToolNames: []string{"get_a", "get_b", "exec"}, ToolCount: 3}},
+		AgentgatewayBackends: []AgentgatewayBackendResource{{Name: "tools-backend", Namespace: "mcp", BackendType: "mcp",
+			MCPTargets: []MCPTargetInfo{{Name: "tools", Host: "tools.mcp.svc.cluster.local", Port: 8080}}}},
+		HTTPRoutes: []HTTPRouteResource{{Name: "tools-route", Namespace: "mcp", BackendRefs: []string{"tools-backend"}}},
+		AgentgatewayPolicies: []AgentgatewayPolicyResource{{Name: "p1", Namespace: "mcp", HasRBAC: true,
+			TargetRefs:    []PolicyTargetRef{{Kind: "HTTPRoute", Name: "tools-route"}},
+			Authorization: AnalyzeAuthorization(allowRules(`jwt.sub == "alice" && mcp.tool.name.startsWith("get_")`))}},
+	}
+	views := BuildMCPServerViews(state, nil, defaultPolicy())
+	if len(views) != 1 {
+		t.Fatalf("views = %+v", views)
+	}
+	v := views[0]
+	if !v.HasToolRestriction || v.EffectiveToolCount != 2 || !reflect.DeepEqual(v.ToolsByPolicy["tools-route"]["p1"], []string{"get_a", "get_b"}) {
+		t.Errorf("restriction = %v, effective = %v, toolsByPolicy = %v", v.HasToolRestriction, v.EffectiveToolNames, v.ToolsByPolicy)
+	}
+}
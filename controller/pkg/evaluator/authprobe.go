@@ -0,0 +1,357 @@
+package evaluator
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/mcpprobe"
+)
+
+// Paths an auth probe reaches an MCP server on.
+const (
+	AuthProbeViaGateway = "gateway" // through an agentgateway Gateway listener
+	AuthProbeViaDirect  = "direct"  // straight to the backend Service
+)
+
+// Authentication confidence of an MCPServerView.
+const (
+	AuthConfidenceInferred = "inferred" // derived from the configured policies
+	AuthConfidenceVerified = "verified" // observed by an auth probe
+)
+
+// AuthProbe is one endpoint of an MCP server sent unauthenticated and
+// invalid-token requests, and how it answered them.
+type AuthProbe struct {
+	ServerID string                `json:"serverId"`
+	Via      string                `json:"via"`     // AuthProbeViaGateway or AuthProbeViaDirect
+	Through  string                `json:"through"` // Gateway/ns/name, or the backend host:port
+	URL      string                `json:"url"`
+	JWTMode  string                `json:"jwtMode,omitempty"` // JWT mode enforced on the gateway path; empty without JWT
+	Results  []mcpprobe.AuthResult `json:"results,omitempty"`
+
+	Target mcpprobe.AuthTarget `json:"-"`
+}
+
+// expectsRejection reports whether a request of the given kind should have
+// been rejected on this path. Through the gateway this follows the JWT mode:
+// Optional admits requests without a token and Permissive admits invalid
+// ones (AUTH-009 reports both). Direct probes are only sent to servers
+// whose gateway path requires JWT, so anything the backend accepts skips it.
+func (p AuthProbe) expectsRejection(kind string) bool {
+	if p.Via == AuthProbeViaDirect {
+		return true
+	}
+	switch p.JWTMode {
+	case "", "Permissive":
+		return false
+	case "Optional":
+		return kind != mcpprobe.AuthUnauthenticated
+	}
+	return true
+}
+
+// AuthProbeTargets returns the endpoints of the gateway-routed MCP servers
+// the policy allows probing: every agentgateway listener serving one of the
+// server's routes and, for servers behind JWT authentication, the backend
+// Service the gateway forwards to.
+func AuthProbeTargets(state *ClusterState, policy Policy) []AuthProbe {
+	if !policy.AuthBypassProbeEnabled() {
+		return nil
+	}
+	now := time.Now()
+	var probes []AuthProbe
+	for _, view := range BuildMCPServerViews(state, nil, policy) {
+		if !view.RoutedThroughGateway || !policy.authProbesNamespace(view.Namespace) {
+			continue
+		}
+		mode := ""
+		if view.HasJWT {
+			mode = view.JWTMode
+			if mode == "" {
+				mode = "Strict"
+			}
+		}
+		issuer, audience := jwtProbeIdentity(state, &view)
+		reqs, err := mcpprobe.AuthRequests(issuer, audience, view.HasJWT, now)
+		if err != nil {
+			continue
+		}
+
+		seen := map[string]bool{}
+		add := func(via, through string, t mcpprobe.Target) {
+			key := t.URL + "|" + t.Host
+			if seen[key] {
+				return
+			}
+			seen[key] = true
+			t.ID = view.ID
+			probes = append(probes, AuthProbe{ServerID: view.ID, Via: via, Through: through, URL: t.URL, JWTMode: mode,
+				Target: mcpprobe.AuthTarget{Target: t, Requests: reqs}})
+		}
+		for _, e := range gatewayProbeEndpoints(state, &view) {
+			add(AuthProbeViaGateway, e.through, e.target)
+		}
+		if view.HasJWT {
+			for _, e := range backendProbeEndpoints(state, &view) {
+				add(AuthProbeViaDirect, e.through, e.target)
+			}
+		}
+	}
+	return probes
+}
+
+// probeEndpoint is an endpoint of an MCP server and what it is reached through.
+type probeEndpoint struct {
+	through string
+	target  mcpprobe.Target
+}
+
+// gatewayProbeEndpoints returns one endpoint per route and agentgateway
+// Gateway of the view. The Gateway is reached through the Service the
+// agentgateway controller creates with the Gateway's name, on the first
+// HTTP or HTTPS listener the route attaches to.
+func gatewayProbeEndpoints(state *ClusterState, view *MCPServerView) []probeEndpoint {
+	var out []probeEndpoint
+	for _, rr := range view.RelatedRoutes {
+		if uses, _ := rr.Details["usesAGWBackend"].(bool); !uses {
+			continue
+		}
+		var route *HTTPRouteResource
+		for i := range state.HTTPRoutes {
+			if r := &state.HTTPRoutes[i]; r.Name == rr.Name && r.Namespace == rr.Namespace {
+				route = r
+			}
+		}
+		if route == nil {
+			continue
+		}
+		gwNS := route.ParentGatewayNamespace
+		if gwNS == "" {
+			gwNS = route.Namespace
+		}
+		for _, gw := range state.Gateways {
+			if gw.Name != route.ParentGateway || gw.Namespace != gwNS || gw.GatewayClassName != "agentgateway" {
+				continue
+			}
+			for _, l := range gw.Listeners {
+				scheme := strings.ToLower(l.Protocol)
+				if (scheme != "http" && scheme != "https") || (route.ParentSectionName != "" && l.Name != route.ParentSectionName) {
+					continue
+				}
+				path := "/mcp"
+				if len(route.Paths) > 0 && route.Paths[0] != "/" {
+					path = route.Paths[0]
+				}
+				t := mcpprobe.Target{
+					URL:  fmt.Sprintf("%s://%s.%s.svc.cluster.local:%d%s", scheme, gw.Name, gw.Namespace, l.Port, path),
+					Host: probeHostname(route.Hostnames, l.Hostname),
+				}
+				out = append(out, probeEndpoint{through: fmt.Sprintf("Gateway/%s/%s", gw.Namespace, gw.Name), target: t})
+				break
+			}
+		}
+	}
+	return out
+}
+
+// probeHostname picks a concrete hostname the route answers on, preferring
+// the route's own hostnames over the listener's; wildcards are skipped.
+func probeHostname(routeHosts []string, listenerHost string) string {
+	for _, h := range append(append([]string{}, routeHosts...), listenerHost) {
+		if h != "" && !strings.Contains(h, "*") {
+			return h
+		}
+	}
+	return ""
+}
+
+// backendProbeEndpoints returns the MCP targets of the view's
+// AgentgatewayBackends: the Services the gateway forwards to.
+func backendProbeEndpoints(state *ClusterState, view *MCPServerView) []probeEndpoint {
+	var out []probeEndpoint
+	for _, rb := range view.RelatedBackends {
+		for _, b := range state.AgentgatewayBackends {
+			if b.Name != rb.Name || b.Namespace != rb.Namespace {
+				continue
+			}
+			for _, mt := range b.MCPTargets {
+				if mt.Host == "" || mt.Port == 0 || !matchesMCPTarget(view, mt) {
+					continue
+				}
+				host := mt.Host
+				if !strings.Contains(host, ".") {
+					host = fmt.Sprintf("%s.%s.svc.cluster.local", host, b.Namespace)
+				}
+				t := mcpprobe.Target{URL: fmt.Sprintf("http://%s:%d/mcp", host, mt.Port), Transport: mcpprobe.TransportStreamableHTTP}
+				if mt.Protocol == "SSE" {
+					t.URL = fmt.Sprintf("http://%s:%d/sse", host, mt.Port)
+					t.Transport = mcpprobe.TransportSSE
+				}
+				out = append(out, probeEndpoint{through: fmt.Sprintf("%s:%d", host, mt.Port), target: t})
+			}
+		}
+	}
+	return out
+}
+
+// jwtProbeIdentity returns the issuer and an audience of the first JWT
+// provider of the view's policies, so invalid tokens differ from valid
+// ones only in the claim under test.
+func jwtProbeIdentity(state *ClusterState, view *MCPServerView) (issuer, audience string) {
+	for _, rp := range view.RelatedPolicies {
+		for _, p := range state.AgentgatewayPolicies {
+			if p.Name != rp.Name || p.Namespace != rp.Namespace || !p.HasJWT {
+				continue
+			}
+			for _, prov := range p.JWTProviders {
+				if prov.Issuer == "" {
+					continue
+				}
+				if len(prov.Audiences) > 0 {
+					return prov.Issuer, prov.Audiences[0]
+				}
+				if len(p.JWTAudiences) > 0 {
+					return prov.Issuer, p.JWTAudiences[0]
+				}
+				return prov.Issuer, ""
+			}
+		}
+	}
+	return "", ""
+}
+
+// applyAuthProbes attaches the auth probes of a view and sets its
+// authentication confidence: verified once a probe was accepted, or every
+// request through the gateway got a definite answer.
+func applyAuthProbes(view *MCPServerView, state *ClusterState, policy Policy) {
+	view.AuthConfidence = AuthConfidenceInferred
+	probes := state.AuthProbes[view.ID]
+	mp := policy.ForNamespace(view.Namespace).MCPProbe
+	if len(probes) == 0 || !mp.Enabled || !mp.AuthBypass {
+		return
+	}
+	view.AuthProbes = probes
+	gateway, conclusive := 0, 0
+	for _, p := range probes {
+		for _, r := range p.Results {
+			if r.Outcome == mcpprobe.OutcomeAccepted {
+				view.AuthConfidence = AuthConfidenceVerified
+				return
+			}
+			if p.Via == AuthProbeViaGateway {
+				gateway++
+				if r.Outcome == mcpprobe.OutcomeRejected {
+					conclusive++
+				}
+			}
+		}
+	}
+	if gateway > 0 && conclusive == gateway {
+		view.AuthConfidence = AuthConfidenceVerified
+	}
+}
+
+// authProbeSummary describes how the endpoints of a server answered its
+// auth probes.
+func authProbeSummary(probes []AuthProbe) string {
+	total, rejected, accepted := 0, 0, 0
+	for _, p := range probes {
+		for _, r := range p.Results {
+			total++
+			switch r.Outcome {
+			case mcpprobe.OutcomeRejected:
+				rejected++
+			case mcpprobe.OutcomeAccepted:
+				accepted++
+			}
+		}
+	}
+	return fmt.Sprintf("Verified by live probe: %d of %d unauthenticated or invalid-token requests were rejected and %d accepted.", rejected, total, accepted)
+}
+
+// AuthBypassProbeEnabled reports whether the policy or any of its namespace
+// policies enables auth bypass probing.
+func (p Policy) AuthBypassProbeEnabled() bool {
+	for _, ep := range p.EffectivePolicies() {
+		if ep.MCPProbe.Enabled && ep.MCPProbe.AuthBypass {
+			return true
+		}
+	}
+	return false
+}
+
+// authProbesNamespace reports whether the effective policy of ns enables auth
+// bypass probing and allows it for the servers in ns.
+func (p Policy) authProbesNamespace(ns string) bool {
+	mp := p.ForNamespace(ns).MCPProbe
+	return mp.Enabled && mp.AuthBypass && mp.AllowsNamespace(ns)
+}
+
+// checkAuthBypass reports requests an MCP server accepted although its
+// authentication should have rejected them: through the gateway (AUTH-011)
+// or directly at the backend Service, skipping the gateway (AUTH-012).
+func checkAuthBypass(state *ClusterState, policy Policy) []Finding {
+	if !policy.MCPProbe.Enabled || !policy.MCPProbe.AuthBypass || state.AuthProbes == nil {
+		return nil
+	}
+	var findings []Finding
+	ts := time.Now().Format(time.RFC3339)
+	check := func(id, kind, ns, name string) {
+		var gateway, direct []string
+		for _, p := range state.AuthProbes[id] {
+			for _, r := range p.Results {
+				if r.Outcome != mcpprobe.OutcomeAccepted || !p.expectsRejection(r.Kind) {
+					continue
+				}
+				if p.Via == AuthProbeViaDirect {
+					direct = append(direct, r.Evidence)
+				} else {
+					gateway = append(gateway, r.Evidence)
+				}
+			}
+		}
+		ref := fmt.Sprintf("%s/%s/%s", kind, ns, name)
+		if len(gateway) > 0 {
+			findings = append(findings, Finding{
+				ID:          fmt.Sprintf("AUTH-011-%s-%s", ns, name),
+				Severity:    SeverityCritical,
+				Category:    CategoryAuthentication,
+				Title:       fmt.Sprintf("Verified authentication bypass on MCP server '%s'", name),
+				Description: fmt.Sprintf("The gateway served MCP requests that its JWT authentication should reject: %s", strings.Join(gateway, "; ")),
+				Impact:      "Anyone who can reach the gateway can list and call the server's tools without a valid token, whatever the policy claims.",
+				Remediation: "Attach an AgentgatewayPolicy with traffic.jwtAuthentication in Strict mode to every route serving the server, and check that no other route or listener exposes the same backend.",
+				ResourceRef: ref,
+				Namespace:   ns,
+				Timestamp:   ts,
+			})
+		}
+		if len(direct) > 0 {
+			findings = append(findings, Finding{
+				ID:          fmt.Sprintf("AUTH-012-%s-%s", ns, name),
+				Severity:    SeverityCritical,
+				Category:    CategoryAuthentication,
+				Title:       fmt.Sprintf("Verified gateway bypass on MCP server '%s'", name),
+				Description: fmt.Sprintf("The backend Service served MCP requests sent directly, skipping the gateway's JWT authentication: %s", strings.Join(direct, "; ")),
+				Impact:      "Any workload that can reach the Service can call the server's tools without authentication or authorization.",
+				Remediation: "Restrict ingress to the MCP server pods to the agentgateway namespace with a NetworkPolicy, or require authentication on the server itself.",
+				ResourceRef: ref,
+				Namespace:   ns,
+				Timestamp:   ts,
+			})
+		}
+	}
+	for _, s := range state.KagentMCPServers {
+		check(fmt.Sprintf("KagentMCPServer/%s/%s", s.Namespace, s.Name), "MCPServer", s.Namespace, s.Name)
+	}
+	for _, s := range state.KagentRemoteMCPServers {
+		check(fmt.Sprintf("KagentRemoteMCPServer/%s/%s", s.Namespace, s.Name), "RemoteMCPServer", s.Namespace, s.Name)
+	}
+	return findings
+}
+
+// isAuthBypassFinding reports whether a finding ID is a verified bypass
+// (AUTH-011, AUTH-012); these lower the per-server Authentication score.
+func isAuthBypassFinding(id string) bool {
+	return strings.HasPrefix(id, "AUTH-011-") || strings.HasPrefix(id, "AUTH-012-")
+}
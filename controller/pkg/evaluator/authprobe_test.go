@@ -0,0 +1,189 @@
+package evaluator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/mcpprobe"
+)
+
+func authProbeState(mode string) *ClusterState {
+	return &ClusterState{
+		Namespaces:       []string{"mcp"},
+		KagentMCPServers: []KagentMCPServerResource{{Name: "tools", Namespace: "mcp", Port: 3000, HasService: true}},
+		AgentgatewayBackends: []AgentgatewayBackendResource{{Name: "tools-backend", Namespace: "mcp", BackendType: "mcp",
+			MCPTargets: []MCPTargetInfo{{Name: "tools", Host: "tools", Port: 3000}}}},
+		HTTPRoutes: []HTTPRouteResource{{Name: "tools-route", Namespace: "mcp", BackendRefs: []string{"tools-backend"},
+			ParentGateway: "agw", Paths: []string{"/mcp/tools"}, Hostnames: []string{"*.example.com", "tools.example.com"}}},
+		Gateways: []GatewayResource{{Name: "agw", Namespace: "mcp", GatewayClassName: "agentgateway", Programmed: true,
+			Listeners: []ListenerInfo{{Name: "grpc", Port: 9000, Protocol: "GRPC"}, {Name: "http", Port: 8080, Protocol: "HTTP"}}}},
+		AgentgatewayPolicies: []AgentgatewayPolicyResource{{Name: "tools-jwt", Namespace: "mcp", HasJWT: true, JWTMode: mode,
+			TargetRefs:   []PolicyTargetRef{{Kind: "HTTPRoute", Name: "tools-route"}},
+			JWTProviders: []JWTProvider{{Issuer: "https://idp.example.com", Audiences: []string{"tools"}}}}},
+	}
+}
+
+func authProbePolicy() Policy {
+	p := defaultPolicy()
+	p.RequireJWTAuth = true
+	p.MCPProbe = MCPProbePolicy{Enabled: true, AuthBypass: true, AllowedNamespaces: []string{"*"}}
+	return p
+}
+
+// authProbeResults answers every request of the probes with the outcome
+// outcomes[via][kind], or rejected.
+func authProbeResults(probes []AuthProbe, outcomes map[string]map[string]string) map[string][]AuthProbe {
+	out := map[string][]AuthProbe{}
+	for _, p := range probes {
+		for _, req := range p.Target.Requests {
+			outcome := outcomes[p.Via][req.Kind]
+			if outcome == "" {
+				outcome = mcpprobe.OutcomeRejected
+			}
+			p.Results = append(p.Results, mcpprobe.AuthResult{Kind: req.Kind, Outcome: outcome, Evidence: p.URL + " " + req.Kind + ": " + outcome})
+		}
+		out[p.ServerID] = append(out[p.ServerID], p)
+	}
+	return out
+}
+
+func TestAuthProbeTargets(t *testing.T) {
+	state := authProbeState("Strict")
+
+	disabled := authProbePolicy()
+	disabled.MCPProbe.AuthBypass = false
+	if probes := AuthProbeTargets(state, disabled); probes != nil {
+		t.Errorf("auth bypass disabled: probes = %+v", probes)
+	}
+
+	probes := AuthProbeTargets(state, authProbePolicy())
+	if len(probes) != 2 {
+		t.Fatalf("probes = %+v", probes)
+	}
+	gw, direct := probes[0], probes[1]
+	if gw.Via != AuthProbeViaGateway || gw.Through != "Gateway/mcp/agw" || gw.JWTMode != "Strict" ||
+		gw.URL != "http://agw.mcp.svc.cluster.local:8080/mcp/tools" || gw.Target.Host != "tools.example.com" {
+		t.Errorf("gateway probe = %+v", gw)
+	}
+	if direct.Via != AuthProbeViaDirect || direct.URL != "http://tools.mcp.svc.cluster.local:3000/mcp" ||
+		direct.Target.Transport != mcpprobe.TransportStreamableHTTP || direct.ServerID != "KagentMCPServer/mcp/tools" {
+		t.Errorf("direct probe = %+v", direct)
+	}
+	reqs := gw.Target.Requests
+	if len(reqs) != 3 || reqs[0].Kind != mcpprobe.AuthUnauthenticated ||
+		reqs[1].Claims["iss"] != "https://idp.example.com" || reqs[1].Claims["aud"] != "tools" ||
+		reqs[2].Claims["aud"] != mcpprobe.WrongAudience {
+		t.Errorf("requests = %+v", reqs)
+	}
+
+	// Without JWT only the gateway is probed, and only anonymously.
+	state.AgentgatewayPolicies = nil
+	probes = AuthProbeTargets(state, authProbePolicy())
+	if len(probes) != 1 || probes[0].Via != AuthProbeViaGateway || probes[0].JWTMode != "" || len(probes[0].Target.Requests) != 1 {
+		t.Errorf("without JWT: probes = %+v", probes)
+	}
+}
+
+func TestAuthProbeTargets_NamespacePolicy(t *testing.T) {
+	state := authProbeState("Strict")
+	p := defaultPolicy()
+	p.RequireJWTAuth = true
+	p.NamespacePolicies = map[string]Policy{"mcp": TightenPolicy(p, authProbePolicy())}
+
+	if !p.AuthBypassProbeEnabled() {
+		t.Fatal("expected the namespace policy to enable auth bypass probing")
+	}
+	if probes := AuthProbeTargets(state, p); len(probes) != 2 {
+		t.Errorf("probes = %+v", probes)
+	}
+
+	p.NamespacePolicies = map[string]Policy{"other": TightenPolicy(p, authProbePolicy())}
+	if probes := AuthProbeTargets(state, p); probes != nil {
+		t.Errorf("policy for another namespace: probes = %+v", probes)
+	}
+}
+
+func TestCheckAuthBypass(t *testing.T) {
+	accepted := mcpprobe.OutcomeAccepted
+	tests := []struct {
+		name     string
+		mode     string
+		outcomes map[string]map[string]string
+		want     []string
+	}{
+		{"all rejected", "Strict", nil, nil},
+		{"anonymous through gateway", "Strict",
+			map[string]map[string]string{AuthProbeViaGateway: {mcpprobe.AuthUnauthenticated: accepted}}, []string{"AUTH-011-mcp-tools"}},
+		{"wrong audience through gateway", "", // empty mode is Strict
+			map[string]map[string]string{AuthProbeViaGateway: {mcpprobe.AuthWrongAudience: accepted}}, []string{"AUTH-011-mcp-tools"}},
+		{"anonymous in Optional mode", "Optional",
+			map[string]map[string]string{AuthProbeViaGateway: {mcpprobe.AuthUnauthenticated: accepted}}, nil},
+		{"expired token in Optional mode", "Optional",
+			map[string]map[string]string{AuthProbeViaGateway: {mcpprobe.AuthExpiredToken: accepted}}, []string{"AUTH-011-mcp-tools"}},
+		{"invalid token in Permissive mode", "Permissive",
+			map[string]map[string]string{AuthProbeViaGateway: {mcpprobe.AuthExpiredToken: accepted}}, nil},
+		{"backend reachable directly", "Strict",
+			map[string]map[string]string{AuthProbeViaDirect: {mcpprobe.AuthUnauthenticated: accepted}}, []string{"AUTH-012-mcp-tools"}},
+		{"backend unreachable", "Strict",
+			map[string]map[string]string{AuthProbeViaDirect: {mcpprobe.AuthUnauthenticated: mcpprobe.OutcomeInconclusive}}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := authProbeState(tt.mode)
+			policy := authProbePolicy()
+			state.AuthProbes = authProbeResults(AuthProbeTargets(state, policy), tt.outcomes)
+
+			findings := checkAuthBypass(state, policy)
+
+			var ids []string
+			for _, f := range findings {
+				ids = append(ids, f.ID)
+				if f.Severity != SeverityCritical || f.ResourceRef != "MCPServer/mcp/tools" || !strings.Contains(f.Description, ": accepted") {
+					t.Errorf("finding = %+v", f)
+				}
+			}
+			if strings.Join(ids, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("findings = %v, want %v", ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildMCPServerViews_AuthConfidence(t *testing.T) {
+	policy := authProbePolicy()
+	baseline := Evaluate(authProbeState("Strict"), policy).MCPServerViews[0].ScoreBreakdown.Authentication
+	tests := []struct {
+		name     string
+		outcomes map[string]map[string]string
+		probed   bool
+		want     string
+	}{
+		{"not probed", nil, false, AuthConfidenceInferred},
+		{"gateway rejects, backend unreachable", map[string]map[string]string{
+			AuthProbeViaDirect: {mcpprobe.AuthUnauthenticated: mcpprobe.OutcomeInconclusive, mcpprobe.AuthExpiredToken: mcpprobe.OutcomeInconclusive,
+				mcpprobe.AuthWrongAudience: mcpprobe.OutcomeInconclusive}}, true, AuthConfidenceVerified},
+		{"gateway unreachable", map[string]map[string]string{
+			AuthProbeViaGateway: {mcpprobe.AuthUnauthenticated: mcpprobe.OutcomeInconclusive}}, true, AuthConfidenceInferred},
+		{"bypass", map[string]map[string]string{
+			AuthProbeViaGateway: {mcpprobe.AuthUnauthenticated: mcpprobe.OutcomeAccepted}}, true, AuthConfidenceVerified},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := authProbeState("Strict")
+			if tt.probed {
+				state.AuthProbes = authProbeResults(AuthProbeTargets(state, policy), tt.outcomes)
+			}
+			result := Evaluate(state, policy)
+			if len(result.MCPServerViews) != 1 {
+				t.Fatalf("views = %+v", result.MCPServerViews)
+			}
+			v := result.MCPServerViews[0]
+			if v.AuthConfidence != tt.want {
+				t.Errorf("authConfidence = %s, want %s", v.AuthConfidence, tt.want)
+			}
+			if tt.name == "bypass" && v.ScoreBreakdown.Authentication != max(baseline-policy.SeverityPenalties.Critical, 0) {
+				t.Errorf("authentication score = %d, want %d less than %d", v.ScoreBreakdown.Authentication, policy.SeverityPenalties.Critical, baseline)
+			}
+		})
+	}
+}
@@ -0,0 +1,440 @@
+package evaluator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+)
+
+// AuthzPrincipal is the caller of an authorization simulation: a set of JWT
+// claims, an agent identity, or both (explicit claims win).
+type AuthzPrincipal struct {
+	Name   string                 `json:"name,omitempty"`
+	Agent  string                 `json:"agent,omitempty"` // AgentView ID ("Agent/ns/name")
+	Claims map[string]interface{} `json:"claims,omitempty"`
+}
+
+// AuthzSimulationRequest asks whether a principal may call a tool on an MCP server.
+type AuthzSimulationRequest struct {
+	AuthzPrincipal
+	ServerID string `json:"serverId"`
+	Tool     string `json:"tool"`
+}
+
+// AuthzRuleResult is the outcome of one authorization rule for a request.
+type AuthzRuleResult struct {
+	AuthorizationRule
+	Result string `json:"result"`           // "match", "no-match", "unknown" or "error"
+	Detail string `json:"detail,omitempty"` // evaluation error or the attributes left unknown
+}
+
+// AuthzPolicyDecision is the outcome of one AgentgatewayPolicy for a request.
+type AuthzPolicyDecision struct {
+	Policy        string            `json:"policy"`   // "AgentgatewayPolicy/ns/name"
+	Decision      string            `json:"decision"` // "allow", "deny" or "unknown"
+	Rules         []AuthzRuleResult `json:"rules"`
+	MissingClaims []string          `json:"missingClaims,omitempty"`
+}
+
+// AuthzRouteDecision is the outcome for one route to the server: the
+// policies attached to the route, its Gateway or its backends all apply.
+type AuthzRouteDecision struct {
+	Route    string                `json:"route,omitempty"` // "HTTPRoute/ns/name"; empty when the server has no routes
+	Decision string                `json:"decision"`
+	Policies []AuthzPolicyDecision `json:"policies"`
+}
+
+// AuthzDecision answers an AuthzSimulationRequest. A tool is allowed when
+// any route to the server allows it; "unknown" means the rules depend on
+// request attributes other than the JWT claims and the tool name.
+type AuthzDecision struct {
+	ServerID      string               `json:"serverId"`
+	Tool          string               `json:"tool"`
+	Principal     string               `json:"principal"`
+	Decision      string               `json:"decision"` // "allow", "deny" or "unknown"
+	Allowed       bool                 `json:"allowed"`
+	Reason        string               `json:"reason"`
+	Routes        []AuthzRouteDecision `json:"routes"`
+	MissingClaims []string             `json:"missingClaims,omitempty"` // claims that would let the closest route allow the call
+}
+
+// AuthzMatrixRequest simulates every principal against every tool of a server.
+type AuthzMatrixRequest struct {
+	ServerID   string           `json:"serverId"`
+	Principals []AuthzPrincipal `json:"principals"`
+	Tools      []string         `json:"tools,omitempty"` // defaults to the server's tools
+}
+
+// AuthzMatrixRow is one principal of an access matrix.
+type AuthzMatrixRow struct {
+	Principal     string              `json:"principal"`
+	Decisions     map[string]string   `json:"decisions"` // tool -> "allow", "deny" or "unknown"
+	MissingClaims map[string][]string `json:"missingClaims,omitempty"`
+}
+
+// AuthzMatrix is the principal × tool access matrix of a server.
+type AuthzMatrix struct {
+	ServerID string           `json:"serverId"`
+	Tools    []string         `json:"tools"`
+	Rows     []AuthzMatrixRow `json:"rows"`
+}
+
+// SimulateAuthorization evaluates the authorization rules of the
+// AgentgatewayPolicies related to an MCP server (as correlated by
+// BuildMCPServerViews) for a principal calling a tool.
+func SimulateAuthorization(state *ClusterState, views []MCPServerView, req AuthzSimulationRequest) (*AuthzDecision, error) {
+	view := findServerView(views, req.ServerID)
+	if view == nil {
+		return nil, fmt.Errorf("MCP server %q not found", req.ServerID)
+	}
+	if req.Tool == "" {
+		return nil, fmt.Errorf("tool is required")
+	}
+	claims, principal, err := principalClaims(state, req.AuthzPrincipal)
+	if err != nil {
+		return nil, err
+	}
+	return simulateTool(state, view, claims, principal, req.Tool), nil
+}
+
+// SimulateAuthorizationMatrix runs SimulateAuthorization for every
+// principal and tool of req.
+func SimulateAuthorizationMatrix(state *ClusterState, views []MCPServerView, req AuthzMatrixRequest) (*AuthzMatrix, error) {
+	view := findServerView(views, req.ServerID)
+	if view == nil {
+		return nil, fmt.Errorf("MCP server %q not found", req.ServerID)
+	}
+	tools := req.Tools
+	if len(tools) == 0 {
+		tools = view.ToolNames
+	}
+	out := &AuthzMatrix{ServerID: view.ID, Tools: tools, Rows: []AuthzMatrixRow{}}
+	for _, p := range req.Principals {
+		claims, principal, err := principalClaims(state, p)
+		if err != nil {
+			return nil, err
+		}
+		row := AuthzMatrixRow{Principal: principal, Decisions: map[string]string{}}
+		for _, tool := range tools {
+			d := simulateTool(state, view, claims, principal, tool)
+			row.Decisions[tool] = d.Decision
+			if len(d.MissingClaims) > 0 {
+				if row.MissingClaims == nil {
+					row.MissingClaims = map[string][]string{}
+				}
+				row.MissingClaims[tool] = d.MissingClaims
+			}
+		}
+		out.Rows = append(out.Rows, row)
+	}
+	return out, nil
+}
+
+func findServerView(views []MCPServerView, id string) *MCPServerView {
+	for i := range views {
+		if views[i].ID == id {
+			return &views[i]
+		}
+	}
+	return nil
+}
+
+// principalClaims returns the JWT claims of a principal. An agent identity
+// contributes the claims of its ServiceAccount token: the ServiceAccount of
+// the agent's workload, or the one kagent creates with the agent's name.
+func principalClaims(state *ClusterState, p AuthzPrincipal) (map[string]interface{}, string, error) {
+	claims := map[string]interface{}{}
+	name := p.Name
+	if p.Agent != "" {
+		agent := findAgent(state, p.Agent)
+		if agent == nil {
+			return nil, "", fmt.Errorf("agent %q not found", p.Agent)
+		}
+		sa := agent.Name
+		for _, w := range state.Workloads {
+			if w.Namespace == agent.Namespace && w.Name == agent.Name && w.ServiceAccountName != "" {
+				sa = w.ServiceAccountName
+			}
+		}
+		claims["sub"] = "system:serviceaccount:" + agent.Namespace + ":" + sa
+		claims["kubernetes.io"] = map[string]interface{}{
+			"namespace":      agent.Namespace,
+			"serviceaccount": map[string]interface{}{"name": sa},
+		}
+		if name == "" {
+			name = p.Agent
+		}
+	}
+	for k, v := range p.Claims {
+		claims[k] = v
+	}
+	if name == "" {
+		if sub, ok := claims["sub"].(string); ok {
+			name = sub
+		} else if len(claims) == 0 {
+			name = "anonymous"
+		} else {
+			name = "claims"
+		}
+	}
+	return claims, name, nil
+}
+
+func findAgent(state *ClusterState, id string) *KagentAgentResource {
+	for i := range state.KagentAgents {
+		a := &state.KagentAgents[i]
+		if agentViewID(a.Namespace, a.Name) == id {
+			return a
+		}
+	}
+	return nil
+}
+
+// simulateTool decides a call of tool on view for each route to the server.
+func simulateTool(state *ClusterState, view *MCPServerView, claims map[string]interface{}, principal, tool string) *AuthzDecision {
+	d := &AuthzDecision{ServerID: view.ID, Tool: tool, Principal: principal, Routes: []AuthzRouteDecision{}}
+	for _, route := range serverRoutePolicies(state, view) {
+		rd := AuthzRouteDecision{Route: route.ref, Decision: "allow", Policies: []AuthzPolicyDecision{}}
+		var missing []string
+		for _, p := range route.policies {
+			pd := simulatePolicy(p, claims, tool)
+			rd.Policies = append(rd.Policies, pd)
+			switch {
+			case pd.Decision == "deny":
+				rd.Decision = "deny"
+			case pd.Decision == "unknown" && rd.Decision == "allow":
+				rd.Decision = "unknown"
+			}
+			for _, c := range pd.MissingClaims {
+				missing = appendUnique(missing, c)
+			}
+		}
+		d.Routes = append(d.Routes, rd)
+		switch {
+		case rd.Decision == "allow":
+			d.Decision = "allow"
+		case rd.Decision == "unknown" && d.Decision != "allow":
+			d.Decision = "unknown"
+		case d.Decision == "":
+			d.Decision = "deny"
+		}
+		if rd.Decision != "allow" && len(missing) > 0 && (d.MissingClaims == nil || len(missing) < len(d.MissingClaims)) {
+			sort.Strings(missing)
+			d.MissingClaims = missing
+		}
+	}
+
+	d.Allowed = d.Decision == "allow"
+	switch d.Decision {
+	case "allow":
+		d.MissingClaims = nil
+		d.Reason = fmt.Sprintf("%s may call %s", principal, tool)
+	case "unknown":
+		d.Reason = "The decision depends on request attributes other than JWT claims and the tool name"
+	default:
+		d.Reason = fmt.Sprintf("No route to %s lets %s call %s", view.Name, principal, tool)
+		if len(d.MissingClaims) > 0 {
+			d.Reason += "; missing claims: " + strings.Join(d.MissingClaims, ", ")
+		}
+	}
+	return d
+}
+
+type routePolicies struct {
+	ref      string
+	policies []AgentgatewayPolicyResource
+}
+
+// serverRoutePolicies groups the server's related policies that carry
+// authorization rules by the route they apply to: policies targeting the
+// route, its parent Gateway or a backend it references, plus untargeted
+// ones. Without related routes every related policy applies.
+func serverRoutePolicies(state *ClusterState, view *MCPServerView) []routePolicies {
+	var related []AgentgatewayPolicyResource
+	for _, rp := range view.RelatedPolicies {
+		for _, p := range state.AgentgatewayPolicies {
+			if p.Name == rp.Name && p.Namespace == rp.Namespace && p.Authorization != nil {
+				related = append(related, p)
+			}
+		}
+	}
+	if len(view.RelatedRoutes) == 0 {
+		return []routePolicies{{policies: related}}
+	}
+
+	var out []routePolicies
+	for _, rt := range view.RelatedRoutes {
+		gw, _ := rt.Details["parentGateway"].(string)
+		gwNS, _ := rt.Details["parentGatewayNamespace"].(string)
+		if gwNS == "" {
+			gwNS = rt.Namespace
+		}
+		var backendRefs []string
+		for _, r := range state.HTTPRoutes {
+			if r.Name == rt.Name && r.Namespace == rt.Namespace {
+				backendRefs = r.BackendRefs
+			}
+		}
+		entry := routePolicies{ref: "HTTPRoute/" + rt.Namespace + "/" + rt.Name}
+		for _, p := range related {
+			applies := len(p.TargetRefs) == 0
+			for _, tr := range p.TargetRefs {
+				switch tr.Kind {
+				case "HTTPRoute":
+					applies = applies || (tr.Name == rt.Name && p.Namespace == rt.Namespace)
+				case "Gateway":
+					applies = applies || (tr.Name == gw && p.Namespace == gwNS)
+				case "AgentgatewayBackend":
+					applies = applies || containsString(backendRefs, tr.Name)
+				}
+			}
+			if applies {
+				entry.policies = append(entry.policies, p)
+			}
+		}
+		out = append(out, entry)
+	}
+	return out
+}
+
+// simulatePolicy evaluates a policy's rules with the same semantics as
+// ResolveTools: a matching Deny rule denies, and each section with Allow
+// rules needs one of them to match.
+func simulatePolicy(p AgentgatewayPolicyResource, claims map[string]interface{}, tool string) AuthzPolicyDecision {
+	pd := AuthzPolicyDecision{Policy: agentgatewayPolicyRef(p), Decision: "allow", Rules: []AuthzRuleResult{}}
+	type sectionState struct{ hasAllow, matched, unknown bool }
+	sections := map[string]*sectionState{}
+	var order []string
+	denied, denyUnknown := false, false
+	for _, r := range p.Authorization.Rules {
+		res := evaluateRule(r, claims, tool)
+		pd.Rules = append(pd.Rules, res)
+		if r.Action == "Deny" {
+			denied = denied || res.Result == "match"
+			denyUnknown = denyUnknown || res.Result == "unknown"
+			continue
+		}
+		s, ok := sections[r.Section]
+		if !ok {
+			s = &sectionState{}
+			sections[r.Section] = s
+			order = append(order, r.Section)
+		}
+		s.hasAllow = true
+		s.matched = s.matched || res.Result == "match"
+		s.unknown = s.unknown || res.Result == "unknown"
+	}
+
+	switch {
+	case denied:
+		pd.Decision = "deny"
+	default:
+		for _, name := range order {
+			s := sections[name]
+			if s.matched {
+				continue
+			}
+			if s.unknown && pd.Decision != "deny" {
+				pd.Decision = "unknown"
+			} else if !s.unknown {
+				pd.Decision = "deny"
+				for _, c := range missingClaims(p.Authorization, name, claims, tool) {
+					pd.MissingClaims = appendUnique(pd.MissingClaims, c)
+				}
+			}
+		}
+		if denyUnknown && pd.Decision == "allow" {
+			pd.Decision = "unknown"
+		}
+	}
+	sort.Strings(pd.MissingClaims)
+	return pd
+}
+
+// evaluateRule evaluates one rule for the principal's claims and the tool.
+// Root variables other than jwt and mcp are left unknown, and errors (such
+// as a claim the token lacks) count as no match, as in agentgateway.
+func evaluateRule(r RuleAnalysis, claims map[string]interface{}, tool string) AuthzRuleResult {
+	res := AuthzRuleResult{AuthorizationRule: r.AuthorizationRule}
+	if r.Issue == "invalid" {
+		res.Result, res.Detail = "error", r.Detail
+		return res
+	}
+	env, err := authorizationEnv()
+	if err != nil {
+		res.Result, res.Detail = "error", err.Error()
+		return res
+	}
+	ast, iss := env.Parse(r.Expression)
+	if iss != nil && iss.Err() != nil {
+		res.Result, res.Detail = "error", strings.TrimSpace(iss.Err().Error())
+		return res
+	}
+	prg, err := env.Program(ast, cel.EvalOptions(cel.OptPartialEval))
+	if err != nil {
+		res.Result, res.Detail = "error", err.Error()
+		return res
+	}
+	_, idents := exprReferences(ast.NativeRep().Expr())
+	var unknown []*cel.AttributePatternType
+	var unknownNames []string
+	for _, id := range idents {
+		if id != "jwt" && id != "mcp" {
+			unknown = append(unknown, cel.AttributePattern(id))
+			unknownNames = append(unknownNames, id)
+		}
+	}
+	vars, err := cel.PartialVars(map[string]interface{}{
+		"jwt": claims,
+		"mcp": map[string]interface{}{"tool": map[string]interface{}{"name": tool}},
+	}, unknown...)
+	if err != nil {
+		res.Result, res.Detail = "error", err.Error()
+		return res
+	}
+	val, _, err := prg.Eval(vars)
+	switch {
+	case err != nil:
+		res.Result, res.Detail = "no-match", err.Error()
+	case types.IsUnknown(val):
+		res.Result, res.Detail = "unknown", "depends on "+strings.Join(unknownNames, ", ")
+	case val == types.True:
+		res.Result = "match"
+	default:
+		res.Result = "no-match"
+	}
+	return res
+}
+
+// missingClaims returns the claims the principal lacks, or holds with a
+// different value, for the Allow grant of section closest to admitting tool.
+func missingClaims(a *AuthorizationAnalysis, section string, claims map[string]interface{}, tool string) []string {
+	var best []string
+	found := false
+	for _, r := range a.Rules {
+		if r.Section != section || r.Action != "Allow" {
+			continue
+		}
+		for _, g := range r.Grants {
+			if !grantAdmits(g, tool) {
+				continue
+			}
+			var missing []string
+			for _, c := range g.RequiredClaims {
+				v, ok := claims[c]
+				if !ok {
+					missing = append(missing, c)
+				} else if want, ok := g.ClaimValues[c]; ok && fmt.Sprint(v) != want {
+					missing = append(missing, c)
+				}
+			}
+			if !found || len(missing) < len(best) {
+				best, found = missing, true
+			}
+		}
+	}
+	return best
+}
@@ -0,0 +1,107 @@
+package evaluator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func authzSimState() *ClusterState {
+	return &ClusterState{
+		KagentRemoteMCPServers: []KagentRemoteMCPServerResource{{Name: "tools", Namespace: "mcp", URL: "http://tools.mcp:8080/mcp",
+			ToolNames: []string{"read", "write", "exec"}, ToolCount: 3}},
+		AgentgatewayBackends: []AgentgatewayBackendResource{{Name: "tools-backend", Namespace: "mcp", BackendType: "mcp",
+			MCPTargets: []MCPTargetInfo{{Name: "tools", Host: "tools.mcp.svc.cluster.local", Port: 8080}}}},
+		HTTPRoutes: []HTTPRouteResource{{Name: "tools-route", Namespace: "mcp", BackendRefs: []string{"tools-backend"},
+			ParentGateway: "agw", ParentGatewayNamespace: "mcp"}},
+		Gateways: []GatewayResource{{Name: "agw", Namespace: "mcp", GatewayClassName: "agentgateway", Programmed: true}},
+		AgentgatewayPolicies: []AgentgatewayPolicyResource{
+			{Name: "tools-authz", Namespace: "mcp", HasRBAC: true,
+				TargetRefs: []PolicyTargetRef{{Kind: "HTTPRoute", Name: "tools-route"}},
+				Authorization: AnalyzeAuthorization(append(allowRules(
+					`mcp.tool.name == "read"`,
+					`jwt.role == "admin" && mcp.tool.name in ["write", "exec"]`,
+					`jwt.sub == "system:serviceaccount:agents:helper" && mcp.tool.name == "write"`,
+				), AuthorizationRule{Section: "traffic", Action: "Deny", Expression: `mcp.tool.name == "exec" && request.path == "/ro"`}))},
+			{Name: "gw-deny", Namespace: "mcp", HasRBAC: true,
+				TargetRefs: []PolicyTargetRef{{Kind: "Gateway", Name: "agw"}},
+				Authorization: AnalyzeAuthorization([]AuthorizationRule{
+					{Section: "traffic", Action: "Deny", Expression: `jwt.groups.exists(g, g == "contractors")`},
+				})},
+		},
+		KagentAgents: []KagentAgentResource{{Name: "helper", Namespace: "agents"}},
+	}
+}
+
+func TestSimulateAuthorization(t *testing.T) {
+	state := authzSimState()
+	views := BuildMCPServerViews(state, nil, defaultPolicy())
+	if len(views) != 1 {
+		t.Fatalf("views = %+v", views)
+	}
+	id := views[0].ID
+
+	tests := []struct {
+		name         string
+		req          AuthzSimulationRequest
+		wantDecision string
+		wantMissing  []string
+	}{
+		{"public tool", AuthzSimulationRequest{Tool: "read"}, "allow", nil},
+		{"missing role", AuthzSimulationRequest{AuthzPrincipal: AuthzPrincipal{Claims: map[string]interface{}{"sub": "bob"}}, Tool: "write"}, "deny", []string{"role"}},
+		{"wrong role value", AuthzSimulationRequest{AuthzPrincipal: AuthzPrincipal{Claims: map[string]interface{}{"role": "viewer"}}, Tool: "write"}, "deny", []string{"role"}},
+		{"admin", AuthzSimulationRequest{AuthzPrincipal: AuthzPrincipal{Claims: map[string]interface{}{"role": "admin"}}, Tool: "write"}, "allow", nil},
+		{"deny on request attribute", AuthzSimulationRequest{AuthzPrincipal: AuthzPrincipal{Claims: map[string]interface{}{"role": "admin"}}, Tool: "exec"}, "unknown", nil},
+		{"gateway deny", AuthzSimulationRequest{AuthzPrincipal: AuthzPrincipal{Claims: map[string]interface{}{"role": "admin", "groups": []interface{}{"contractors"}}}, Tool: "read"}, "deny", nil},
+		{"agent identity", AuthzSimulationRequest{AuthzPrincipal: AuthzPrincipal{Agent: "Agent/agents/helper"}, Tool: "write"}, "allow", nil},
+		{"unknown tool", AuthzSimulationRequest{Tool: "delete"}, "deny", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.ServerID = id
+			d, err := SimulateAuthorization(state, views, tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d.Decision != tt.wantDecision || d.Allowed != (tt.wantDecision == "allow") || !reflect.DeepEqual(d.MissingClaims, tt.wantMissing) {
+				t.Errorf("decision = %s, missing = %v, want %s, %v (%+v)", d.Decision, d.MissingClaims, tt.wantDecision, tt.wantMissing, d.Routes)
+			}
+			if len(d.Routes) != 1 || len(d.Routes[0].Policies) != 2 {
+				t.Errorf("routes = %+v", d.Routes)
+			}
+		})
+	}
+
+	if _, err := SimulateAuthorization(state, views, AuthzSimulationRequest{ServerID: "nope", Tool: "read"}); err == nil {
+		t.Error("expected an error for an unknown server")
+	}
+	if _, err := SimulateAuthorization(state, views, AuthzSimulationRequest{ServerID: id, Tool: "read",
+		AuthzPrincipal: AuthzPrincipal{Agent: "Agent/agents/missing"}}); err == nil {
+		t.Error("expected an error for an unknown agent")
+	}
+}
+
+func TestSimulateAuthorizationMatrix(t *testing.T) {
+	state := authzSimState()
+	views := BuildMCPServerViews(state, nil, defaultPolicy())
+	m, err := SimulateAuthorizationMatrix(state, views, AuthzMatrixRequest{
+		ServerID: views[0].ID,
+		Principals: []AuthzPrincipal{
+			{Name: "viewer"},
+			{Name: "admin", Claims: map[string]interface{}{"role": "admin"}},
+			{Agent: "Agent/agents/helper"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []AuthzMatrixRow{
+		{Principal: "viewer", Decisions: map[string]string{"read": "allow", "write": "deny", "exec": "deny"},
+			MissingClaims: map[string][]string{"write": {"role"}, "exec": {"role"}}},
+		{Principal: "admin", Decisions: map[string]string{"read": "allow", "write": "allow", "exec": "unknown"}},
+		{Principal: "Agent/agents/helper", Decisions: map[string]string{"read": "allow", "write": "allow", "exec": "deny"},
+			MissingClaims: map[string][]string{"exec": {"role"}}},
+	}
+	if !reflect.DeepEqual(m.Rows, want) {
+		t.Errorf("matrix = %+v", m.Rows)
+	}
+}
@@ -0,0 +1,143 @@
+package evaluator
+
+import (
+	"sync"
+)
+
+// Check is a single pluggable governance check. Built-in checks register
+// themselves in init(); Evaluate runs every registered check in registration
+// order, honouring per-check overrides from Policy.Checks.
+type Check interface {
+	// ID is the stable identifier used to reference the check from
+	// MCPGovernancePolicy spec.checks (e.g. "authentication").
+	ID() string
+	// Category is the governance category the check's findings belong to.
+	Category() string
+	// Severity is the default (highest) severity the check reports.
+	Severity() string
+	// Run evaluates the cluster state and returns any findings.
+	Run(state *ClusterState, policy Policy) []Finding
+}
+
+// CheckConfig holds per-check overrides from MCPGovernancePolicy spec.checks.
+type CheckConfig struct {
+	Disabled bool   // If true, the check is not run
+	Severity string // If set, replaces the severity of every finding the check reports
+}
+
+var (
+	checkRegistryMu sync.RWMutex
+	checkRegistry   []Check
+)
+
+// RegisterCheck adds a check to the registry. Registering a check with an ID
+// that already exists replaces the earlier registration in place.
+func RegisterCheck(c Check) {
+	checkRegistryMu.Lock()
+	defer checkRegistryMu.Unlock()
+	for i, existing := range checkRegistry {
+		if existing.ID() == c.ID() {
+			checkRegistry[i] = c
+			return
+		}
+	}
+	checkRegistry = append(checkRegistry, c)
+}
+
+// RegisteredChecks returns a copy of the registered checks in registration order.
+func RegisteredChecks() []Check {
+	checkRegistryMu.RLock()
+	defer checkRegistryMu.RUnlock()
+	out := make([]Check, len(checkRegistry))
+	copy(out, checkRegistry)
+	return out
+}
+
+// LookupCheck returns the registered check with the given ID.
+func LookupCheck(id string) (Check, bool) {
+	checkRegistryMu.RLock()
+	defer checkRegistryMu.RUnlock()
+	for _, c := range checkRegistry {
+		if c.ID() == id {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+// funcCheck adapts a plain check function to the Check interface.
+type funcCheck struct {
+	id       string
+	category string
+	severity string
+	run      func(state *ClusterState, policy Policy) []Finding
+}
+
+func (c funcCheck) ID() string       { return c.id }
+func (c funcCheck) Category() string { return c.category }
+func (c funcCheck) Severity() string { return c.severity }
+func (c funcCheck) Run(state *ClusterState, policy Policy) []Finding {
+	return c.run(state, policy)
+}
+
+// NewCheck builds a Check from a check function.
+func NewCheck(id, category, severity string, run func(state *ClusterState, policy Policy) []Finding) Check {
+	return funcCheck{id: id, category: category, severity: severity, run: run}
+}
+
+func init() {
+	RegisterCheck(NewCheck("agentgateway", CategoryAgentGateway, SeverityCritical, checkAgentGatewayCompliance))
+	RegisterCheck(NewCheck("authentication", CategoryAuthentication, SeverityCritical, checkAuthentication))
+	RegisterCheck(NewCheck("jwt-audience", CategoryAuthentication, SeverityHigh, checkJWTAudienceScope)) // Tier 2 #18
+	RegisterCheck(NewCheck("mtls", CategoryTLS, SeverityMedium, checkMTLS))                              // Tier 2 #19
+	RegisterCheck(NewCheck("authorization", CategoryAuthorization, SeverityCritical, checkAuthorization))
+	RegisterCheck(NewCheck("cors", CategoryCORS, SeverityHigh, checkCORS))
+	RegisterCheck(NewCheck("tls", CategoryTLS, SeverityHigh, checkTLS))
+	RegisterCheck(NewCheck("prompt-guard", CategoryPromptGuard, SeverityHigh, checkPromptGuard))
+	RegisterCheck(NewCheck("rate-limit", CategoryRateLimit, SeverityHigh, checkRateLimit))
+	RegisterCheck(NewCheck("exposure", CategoryExposure, SeverityCritical, checkExposure))
+	RegisterCheck(NewCheck("tool-count", CategoryToolScope, SeverityCritical, checkToolCount))
+	RegisterCheck(NewCheck("hardened-deployment", CategoryHardening, SeverityCritical, checkHardenedDeployment))
+	RegisterCheck(NewCheck("tool-sensitivity", CategoryToolScope, SeverityCritical, checkToolSensitivity))
+	RegisterCheck(NewCheck("workload-rbac", CategoryWorkloadRBAC, SeverityCritical, checkWorkloadRBAC))
+	RegisterCheck(NewCheck("image-verification", CategoryHardening, SeverityCritical, checkImageVerification))
+	RegisterCheck(NewCheck("vulnerabilities", CategoryVulnerabilities, SeverityCritical, checkVulnerabilities))
+	RegisterCheck(NewCheck("gateway-tls", CategoryTLS, SeverityCritical, checkGatewayTLS))
+	RegisterCheck(NewCheck("jwt-jwks-source", CategoryAuthentication, SeverityHigh, checkJWTKeySource))
+	RegisterCheck(NewCheck("jwt-shared-issuer", CategoryAuthentication, SeverityMedium, checkJWTSharedIssuer))
+	RegisterCheck(NewCheck("jwt-algorithms", CategoryAuthentication, SeverityHigh, checkJWTAlgorithms))
+	RegisterCheck(NewCheck("jwt-permissive", CategoryAuthentication, SeverityCritical, checkJWTPermissive))
+	RegisterCheck(NewCheck("jwt-required-claims", CategoryAuthentication, SeverityMedium, checkJWTRequiredClaims))
+	RegisterCheck(NewCheck("authorization-rules", CategoryAuthorization, SeverityHigh, checkAuthorizationRules))
+	RegisterCheck(NewCheck("mcp-live-probe", CategoryToolScope, SeverityHigh, checkMCPProbes))
+	RegisterCheck(NewCheck("auth-bypass-probe", CategoryAuthentication, SeverityCritical, checkAuthBypass))
+	RegisterCheck(NewCheck("agent-references", CategoryGovernance, SeverityMedium, checkAgentReferences))
+}
+
+// runRegisteredChecks runs every enabled check and applies severity overrides.
+func runRegisteredChecks(state *ClusterState, policy Policy) []Finding {
+	var findings []Finding
+	for _, c := range RegisteredChecks() {
+		cfg := policy.Checks[c.ID()]
+		if cfg.Disabled {
+			continue
+		}
+		out := c.Run(state, policy)
+		if isValidSeverity(cfg.Severity) {
+			for i := range out {
+				out[i].Severity = cfg.Severity
+			}
+		}
+		findings = append(findings, out...)
+	}
+	return findings
+}
+
+// isValidSeverity reports whether s is one of the known severity levels.
+func isValidSeverity(s string) bool {
+	switch s {
+	case SeverityCritical, SeverityHigh, SeverityMedium, SeverityLow:
+		return true
+	}
+	return false
+}
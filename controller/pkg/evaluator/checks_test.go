@@ -0,0 +1,139 @@
+package evaluator
+
+import (
+	"testing"
+)
+
+// withTestCheck registers c for the duration of the test.
+func withTestCheck(t *testing.T, c Check) {
+	t.Helper()
+	RegisterCheck(c)
+	t.Cleanup(func() {
+		checkRegistryMu.Lock()
+		defer checkRegistryMu.Unlock()
+		for i, existing := range checkRegistry {
+			if existing.ID() == c.ID() {
+				checkRegistry = append(checkRegistry[:i], checkRegistry[i+1:]...)
+				return
+			}
+		}
+	})
+}
+
+func TestRegisteredChecks_BuiltinsInOrder(t *testing.T) {
+	want := []string{
+		"agentgateway", "authentication", "jwt-audience", "mtls", "authorization", "cors",
+		"tls", "prompt-guard", "rate-limit", "exposure", "tool-count", "hardened-deployment",
+		"tool-sensitivity",
+		"workload-rbac",
+		"image-verification", "vulnerabilities", "gateway-tls",
+		"jwt-jwks-source", "jwt-shared-issuer", "jwt-algorithms", "jwt-permissive", "jwt-required-claims",
+		"authorization-rules",
+		"mcp-live-probe", "auth-bypass-probe", "agent-references",
+	}
+	checks := RegisteredChecks()
+	if len(checks) < len(want) {
+		t.Fatalf("Expected at least %d registered checks, got %d", len(want), len(checks))
+	}
+	for i, id := range want {
+		if checks[i].ID() != id {
+			t.Errorf("check[%d] = %s, want %s", i, checks[i].ID(), id)
+		}
+		if !isValidSeverity(checks[i].Severity()) {
+			t.Errorf("check %s has invalid default severity %q", id, checks[i].Severity())
+		}
+	}
+}
+
+func TestLookupCheck(t *testing.T) {
+	c, ok := LookupCheck("cors")
+	if !ok {
+		t.Fatal("Expected cors check to be registered")
+	}
+	if c.Category() != CategoryCORS {
+		t.Errorf("cors category = %s, want %s", c.Category(), CategoryCORS)
+	}
+	if _, ok := LookupCheck("does-not-exist"); ok {
+		t.Error("Expected lookup of unknown check to fail")
+	}
+}
+
+func TestEvaluate_CheckDisabledByPolicy(t *testing.T) {
+	state := emptyState()
+	policy := defaultPolicy()
+	policy.Checks = map[string]CheckConfig{
+		"agentgateway": {Disabled: true},
+	}
+
+	result := Evaluate(state, policy)
+
+	for _, f := range result.Findings {
+		if f.Category == CategoryAgentGateway {
+			t.Errorf("agentgateway check disabled → unexpected finding %s", f.ID)
+		}
+	}
+}
+
+func TestEvaluate_CheckSeverityOverride(t *testing.T) {
+	state := emptyState()
+	policy := defaultPolicy()
+	policy.Checks = map[string]CheckConfig{
+		"agentgateway": {Severity: SeverityLow},
+	}
+
+	findings := runRegisteredChecks(state, policy)
+
+	found := false
+	for _, f := range findings {
+		if f.ID == "AGW-001" {
+			found = true
+			if f.Severity != SeverityLow {
+				t.Errorf("AGW-001 severity = %s, want overridden Low", f.Severity)
+			}
+		}
+	}
+	if !found {
+		t.Error("Expected AGW-001 finding")
+	}
+}
+
+func TestEvaluate_InvalidSeverityOverrideIgnored(t *testing.T) {
+	state := emptyState()
+	policy := defaultPolicy()
+	policy.Checks = map[string]CheckConfig{
+		"agentgateway": {Severity: "Urgent"},
+	}
+
+	for _, f := range runRegisteredChecks(state, policy) {
+		if f.ID == "AGW-001" && f.Severity != SeverityCritical {
+			t.Errorf("AGW-001 severity = %s, want default Critical", f.Severity)
+		}
+	}
+}
+
+func TestEvaluate_CustomRegisteredCheck(t *testing.T) {
+	withTestCheck(t, NewCheck("test-custom", CategoryExposure, SeverityMedium,
+		func(state *ClusterState, policy Policy) []Finding {
+			return []Finding{{ID: "TEST-001", Severity: SeverityMedium, Category: CategoryExposure, Title: "custom"}}
+		}))
+
+	result := Evaluate(emptyState(), defaultPolicy())
+
+	found := false
+	for _, f := range result.Findings {
+		if f.ID == "TEST-001" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("Expected custom registered check to run during Evaluate")
+	}
+
+	policy := defaultPolicy()
+	policy.Checks = map[string]CheckConfig{"test-custom": {Disabled: true}}
+	for _, f := range Evaluate(emptyState(), policy).Findings {
+		if f.ID == "TEST-001" {
+			t.Error("Disabled custom check should not run")
+		}
+	}
+}
@@ -0,0 +1,324 @@
+package evaluator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+)
+
+// CustomRule is a CEL-based house rule declared in MCPGovernancePolicy spec.customRules.
+// The expression is evaluated once per resource of TargetKind; every resource for
+// which it evaluates to true produces a finding.
+type CustomRule struct {
+	ID          string // Rule identifier; findings are reported as CUSTOM-<ID>-<resource name>
+	Severity    string // Critical, High, Medium or Low
+	Category    string // Governance category the finding counts toward (e.g. "ToolScope")
+	TargetKind  string // Resource kind the rule applies to (see CustomRuleTargetKinds)
+	Expression  string // CEL expression; true means the resource violates the rule
+	Title       string // Optional finding title
+	Description string // Optional finding description
+	Remediation string // Optional remediation guidance
+}
+
+// CustomRuleTargetKinds lists the resource kinds a custom rule can target.
+var CustomRuleTargetKinds = []string{
+	"Gateway", "AgentgatewayBackend", "AgentgatewayPolicy", "HTTPRoute",
+	"Agent", "MCPServer", "RemoteMCPServer", "Service",
+	"Workload", "Deployment", "StatefulSet", "DaemonSet", "Job", "CronJob", "Pod",
+	"NetworkPolicy", "SkillCatalog",
+}
+
+// customRuleTarget is a single resource a custom rule is evaluated against.
+type customRuleTarget struct {
+	Name      string
+	Namespace string
+	Ref       string
+	Object    interface{}
+}
+
+var (
+	celEnvOnce sync.Once
+	celEnv     *cel.Env
+	celEnvErr  error
+
+	celProgramCache sync.Map // expression → cel.Program
+)
+
+// customRuleEnv returns the shared CEL environment for custom rules.
+// Rules see two variables: `resource` (the evaluator struct as a map with
+// lowerCamelCase keys) and `kind` (the resource kind).
+func customRuleEnv() (*cel.Env, error) {
+	celEnvOnce.Do(func() {
+		celEnv, celEnvErr = cel.NewEnv(
+			cel.Variable("resource", cel.MapType(cel.StringType, cel.DynType)),
+			cel.Variable("kind", cel.StringType),
+			ext.Strings(),
+			ext.Lists(),
+			ext.Sets(),
+		)
+	})
+	return celEnv, celEnvErr
+}
+
+// CompileCustomRule parses and type-checks a custom rule expression.
+func CompileCustomRule(expression string) (cel.Program, error) {
+	if prg, ok := celProgramCache.Load(expression); ok {
+		return prg.(cel.Program), nil
+	}
+	env, err := customRuleEnv()
+	if err != nil {
+		return nil, err
+	}
+	ast, iss := env.Compile(expression)
+	if iss != nil && iss.Err() != nil {
+		return nil, iss.Err()
+	}
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("expression must evaluate to bool, got %s", ast.OutputType())
+	}
+	prg, err := env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+	celProgramCache.Store(expression, prg)
+	return prg, nil
+}
+
+// checkCustomRules evaluates every CEL custom rule in the policy against the
+// matching resources in the cluster state.
+func checkCustomRules(state *ClusterState, policy Policy) []Finding {
+	var findings []Finding
+	ts := time.Now().Format(time.RFC3339)
+
+	for _, rule := range policy.CustomRules {
+		if rule.ID == "" || rule.Expression == "" {
+			continue
+		}
+		severity := rule.Severity
+		if !isValidSeverity(severity) {
+			severity = SeverityMedium
+		}
+		category := rule.Category
+		if category == "" {
+			category = CategoryToolScope
+		}
+
+		prg, err := CompileCustomRule(rule.Expression)
+		if err != nil {
+			findings = append(findings, Finding{
+				ID:          fmt.Sprintf("CUSTOM-000-%s", rule.ID),
+				Severity:    SeverityLow,
+				Category:    category,
+				Title:       fmt.Sprintf("Custom rule '%s' failed to compile", rule.ID),
+				Description: fmt.Sprintf("The CEL expression for custom rule '%s' could not be compiled: %v", rule.ID, err),
+				Impact:      "The rule is not enforced until the expression is fixed.",
+				Remediation: "Fix the expression in MCPGovernancePolicy spec.customRules. Expressions must evaluate to a bool and may reference `resource` and `kind`.",
+				Timestamp:   ts,
+			})
+			continue
+		}
+
+		var evalErr error
+		for _, t := range customRuleTargets(state, rule.TargetKind) {
+			out, _, err := prg.Eval(map[string]interface{}{
+				"resource": toCELValue(reflect.ValueOf(t.Object)),
+				"kind":     rule.TargetKind,
+			})
+			if err != nil {
+				if evalErr == nil {
+					evalErr = fmt.Errorf("%s: %v", t.Ref, err)
+				}
+				continue
+			}
+			if hit, ok := out.Value().(bool); !ok || !hit {
+				continue
+			}
+
+			title := rule.Title
+			if title == "" {
+				title = fmt.Sprintf("Custom rule '%s' violated by %s", rule.ID, t.Ref)
+			}
+			description := rule.Description
+			if description == "" {
+				description = fmt.Sprintf("%s matched custom rule '%s' (%s).", t.Ref, rule.ID, rule.Expression)
+			}
+			remediation := rule.Remediation
+			if remediation == "" {
+				remediation = "Bring the resource in line with the house rule, or adjust the rule in MCPGovernancePolicy spec.customRules."
+			}
+			findings = append(findings, Finding{
+				ID:          fmt.Sprintf("CUSTOM-%s-%s", rule.ID, t.Name),
+				Severity:    severity,
+				Category:    category,
+				Title:       title,
+				Description: description,
+				Impact:      "The resource does not satisfy an organisation-defined governance rule.",
+				Remediation: remediation,
+				ResourceRef: t.Ref,
+				Namespace:   t.Namespace,
+				Timestamp:   ts,
+			})
+		}
+
+		if evalErr != nil {
+			findings = append(findings, Finding{
+				ID:          fmt.Sprintf("CUSTOM-000-%s", rule.ID),
+				Severity:    SeverityLow,
+				Category:    category,
+				Title:       fmt.Sprintf("Custom rule '%s' failed to evaluate", rule.ID),
+				Description: fmt.Sprintf("The CEL expression for custom rule '%s' returned an error for at least one resource: %v", rule.ID, evalErr),
+				Impact:      "Resources that fail evaluation are not checked against the rule.",
+				Remediation: "Guard optional fields with has() or adjust the expression in MCPGovernancePolicy spec.customRules.",
+				Timestamp:   ts,
+			})
+		}
+	}
+
+	return findings
+}
+
+// customRuleTargets returns the resources of the given kind.
+func customRuleTargets(state *ClusterState, kind string) []customRuleTarget {
+	var out []customRuleTarget
+	add := func(k, name, ns string, obj interface{}) {
+		out = append(out, customRuleTarget{
+			Name:      name,
+			Namespace: ns,
+			Ref:       fmt.Sprintf("%s/%s/%s", k, ns, name),
+			Object:    obj,
+		})
+	}
+
+	switch kind {
+	case "Gateway":
+		for _, r := range state.Gateways {
+			add(kind, r.Name, r.Namespace, r)
+		}
+	case "AgentgatewayBackend":
+		for _, r := range state.AgentgatewayBackends {
+			add(kind, r.Name, r.Namespace, r)
+		}
+	case "AgentgatewayPolicy":
+		for _, r := range state.AgentgatewayPolicies {
+			add(kind, r.Name, r.Namespace, r)
+		}
+	case "HTTPRoute":
+		for _, r := range state.HTTPRoutes {
+			add(kind, r.Name, r.Namespace, r)
+		}
+	case "Agent":
+		for _, r := range state.KagentAgents {
+			add(kind, r.Name, r.Namespace, r)
+		}
+	case "MCPServer":
+		for _, r := range state.KagentMCPServers {
+			add(kind, r.Name, r.Namespace, r)
+		}
+	case "RemoteMCPServer":
+		for _, r := range state.KagentRemoteMCPServers {
+			add(kind, r.Name, r.Namespace, r)
+		}
+	case "Service":
+		for _, r := range state.Services {
+			add(kind, r.Name, r.Namespace, r)
+		}
+	case "Workload", "Deployment", "StatefulSet", "DaemonSet", "Job", "CronJob", "Pod":
+		for _, r := range state.Workloads {
+			if kind == "Workload" || r.Kind == kind {
+				add(r.Kind, r.Name, r.Namespace, r)
+			}
+		}
+	case "NetworkPolicy":
+		for _, r := range state.NetworkPolicies {
+			add(kind, r.Name, r.Namespace, r)
+		}
+	case "SkillCatalog":
+		for _, r := range state.SkillCatalogs {
+			add(kind, r.Name, r.Namespace, r)
+		}
+	}
+	return out
+}
+
+// ResourceRefsOfKind returns the "Kind/ns/name" refs of every resource of the
+// given kind (any of CustomRuleTargetKinds) in the cluster state.
+func ResourceRefsOfKind(state *ClusterState, kind string) []string {
+	targets := customRuleTargets(state, kind)
+	refs := make([]string, 0, len(targets))
+	for _, t := range targets {
+		refs = append(refs, t.Ref)
+	}
+	return refs
+}
+
+// toCELValue converts an evaluator resource struct into plain maps and lists
+// with lowerCamelCase keys so CEL expressions can reference fields like
+// resource.toolCount or resource.mcpTargets[0].hasAuth.
+func toCELValue(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return toCELValue(v.Elem())
+	case reflect.Struct:
+		m := make(map[string]interface{}, v.NumField())
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			if !t.Field(i).IsExported() {
+				continue
+			}
+			m[celFieldName(t.Field(i).Name)] = toCELValue(v.Field(i))
+		}
+		return m
+	case reflect.Slice, reflect.Array:
+		l := make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			l[i] = toCELValue(v.Index(i))
+		}
+		return l
+	case reflect.Map:
+		m := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			m[fmt.Sprint(iter.Key().Interface())] = toCELValue(iter.Value())
+		}
+		return m
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.String:
+		return v.String()
+	}
+	return nil
+}
+
+// celFieldName converts a Go field name to lowerCamelCase, keeping acronyms
+// together: ToolCount → toolCount, URL → url, MCPTargets → mcpTargets.
+func celFieldName(name string) string {
+	runes := []rune(name)
+	upper := 0
+	for upper < len(runes) && unicode.IsUpper(runes[upper]) {
+		upper++
+	}
+	switch {
+	case upper == 0:
+		return name
+	case upper == len(runes):
+		return strings.ToLower(name)
+	case upper > 1:
+		upper-- // last upper rune starts the next word
+	}
+	return strings.ToLower(string(runes[:upper])) + string(runes[upper:])
+}
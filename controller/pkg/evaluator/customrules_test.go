@@ -0,0 +1,198 @@
+package evaluator
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func prodToolsRule() CustomRule {
+	return CustomRule{
+		ID:         "prod-max-tools",
+		Severity:   SeverityHigh,
+		Category:   CategoryToolScope,
+		TargetKind: "RemoteMCPServer",
+		Expression: `resource.namespace.startsWith("prod") && resource.toolCount > 5`,
+	}
+}
+
+func TestCelFieldName(t *testing.T) {
+	cases := map[string]string{
+		"ToolCount":  "toolCount",
+		"URL":        "url",
+		"MCPTargets": "mcpTargets",
+		"HasTLS":     "hasTLS",
+		"JWTMode":    "jwtMode",
+		"Name":       "name",
+		"ID":         "id",
+	}
+	for in, want := range cases {
+		if got := celFieldName(in); got != want {
+			t.Errorf("celFieldName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestCheckCustomRules_Hit(t *testing.T) {
+	state := &ClusterState{
+		KagentRemoteMCPServers: []KagentRemoteMCPServerResource{
+			{Name: "big", Namespace: "prod-a", ToolCount: 9},
+			{Name: "small", Namespace: "prod-a", ToolCount: 3},
+			{Name: "dev-big", Namespace: "dev", ToolCount: 20},
+		},
+	}
+	policy := defaultPolicy()
+	policy.CustomRules = []CustomRule{prodToolsRule()}
+
+	findings := checkCustomRules(state, policy)
+
+	if len(findings) != 1 {
+		t.Fatalf("Expected 1 custom finding, got %d: %+v", len(findings), findings)
+	}
+	f := findings[0]
+	if f.ID != "CUSTOM-prod-max-tools-big" {
+		t.Errorf("ID = %s, want CUSTOM-prod-max-tools-big", f.ID)
+	}
+	if f.Severity != SeverityHigh || f.Category != CategoryToolScope {
+		t.Errorf("severity/category = %s/%s, want High/ToolScope", f.Severity, f.Category)
+	}
+	if f.ResourceRef != "RemoteMCPServer/prod-a/big" {
+		t.Errorf("ResourceRef = %s", f.ResourceRef)
+	}
+}
+
+func TestCheckCustomRules_NestedFields(t *testing.T) {
+	state := &ClusterState{
+		AgentgatewayBackends: []AgentgatewayBackendResource{
+			{Name: "b1", Namespace: "ns", BackendType: "mcp", MCPTargets: []MCPTargetInfo{{Name: "t", HasAuth: false}}},
+			{Name: "b2", Namespace: "ns", BackendType: "mcp", MCPTargets: []MCPTargetInfo{{Name: "t", HasAuth: true}}},
+		},
+	}
+	policy := defaultPolicy()
+	policy.CustomRules = []CustomRule{{
+		ID: "target-auth", Severity: SeverityMedium, Category: CategoryAuthentication,
+		TargetKind: "AgentgatewayBackend",
+		Expression: `resource.mcpTargets.exists(t, !t.hasAuth)`,
+	}}
+
+	findings := checkCustomRules(state, policy)
+
+	if len(findings) != 1 || findings[0].ID != "CUSTOM-target-auth-b1" {
+		t.Errorf("Expected single finding for b1, got %+v", findings)
+	}
+}
+
+func TestCheckCustomRules_WorkloadKindFilter(t *testing.T) {
+	state := &ClusterState{
+		Workloads: []WorkloadResource{
+			{Name: "d", Namespace: "ns", Kind: "Deployment", HasLatestTag: true},
+			{Name: "s", Namespace: "ns", Kind: "StatefulSet", HasLatestTag: true},
+		},
+	}
+	policy := defaultPolicy()
+	policy.CustomRules = []CustomRule{{
+		ID: "no-latest", Severity: SeverityLow, Category: CategoryHardening,
+		TargetKind: "StatefulSet", Expression: `resource.hasLatestTag`,
+	}}
+
+	findings := checkCustomRules(state, policy)
+
+	if len(findings) != 1 || findings[0].ResourceRef != "StatefulSet/ns/s" {
+		t.Errorf("Expected single StatefulSet finding, got %+v", findings)
+	}
+}
+
+func TestCheckCustomRules_AllWorkloadKinds(t *testing.T) {
+	kinds := []string{"Deployment", "StatefulSet", "DaemonSet", "Job", "CronJob", "Pod"}
+	state := &ClusterState{}
+	for _, k := range kinds {
+		state.Workloads = append(state.Workloads, WorkloadResource{Name: strings.ToLower(k), Namespace: "ns", Kind: k, HasLatestTag: true})
+	}
+	for _, k := range kinds {
+		policy := defaultPolicy()
+		policy.CustomRules = []CustomRule{{
+			ID: "no-latest", Severity: SeverityLow, Category: CategoryHardening,
+			TargetKind: k, Expression: `resource.hasLatestTag`,
+		}}
+
+		findings := checkCustomRules(state, policy)
+
+		want := fmt.Sprintf("%s/ns/%s", k, strings.ToLower(k))
+		if len(findings) != 1 || findings[0].ResourceRef != want {
+			t.Errorf("%s: expected single finding for %s, got %+v", k, want, findings)
+		}
+	}
+}
+
+func TestCheckCustomRules_CompileError(t *testing.T) {
+	policy := defaultPolicy()
+	policy.CustomRules = []CustomRule{{
+		ID: "broken", Severity: SeverityHigh, Category: CategoryToolScope,
+		TargetKind: "RemoteMCPServer", Expression: `resource.toolCount >`,
+	}}
+
+	findings := checkCustomRules(emptyState(), policy)
+
+	if len(findings) != 1 || findings[0].ID != "CUSTOM-000-broken" {
+		t.Fatalf("Expected CUSTOM-000-broken, got %+v", findings)
+	}
+	if findings[0].Severity != SeverityLow {
+		t.Errorf("Compile error severity = %s, want Low", findings[0].Severity)
+	}
+}
+
+func TestCheckCustomRules_NonBoolExpression(t *testing.T) {
+	policy := defaultPolicy()
+	policy.CustomRules = []CustomRule{{
+		ID: "str", TargetKind: "RemoteMCPServer", Expression: `resource.name + "x"`,
+	}}
+
+	findings := checkCustomRules(emptyState(), policy)
+
+	if len(findings) != 1 || !strings.HasPrefix(findings[0].ID, "CUSTOM-000-") {
+		t.Errorf("Expected compile error finding for non-bool expression, got %+v", findings)
+	}
+}
+
+func TestEvaluate_CustomRuleLowersServerScore(t *testing.T) {
+	state := fullCompliantState()
+	state.KagentRemoteMCPServers = []KagentRemoteMCPServerResource{
+		{Name: "remote", Namespace: "prod", URL: "http://remote.prod:8080/mcp", ToolCount: 8,
+			ToolNames: []string{"a", "b", "c", "d", "e", "f", "g", "h"}},
+	}
+	policy := defaultPolicy()
+
+	before := Evaluate(state, policy)
+
+	policy.CustomRules = []CustomRule{prodToolsRule()}
+	after := Evaluate(state, policy)
+
+	var beforeView, afterView *MCPServerView
+	for i := range before.MCPServerViews {
+		if before.MCPServerViews[i].Name == "remote" {
+			beforeView = &before.MCPServerViews[i]
+		}
+	}
+	for i := range after.MCPServerViews {
+		if after.MCPServerViews[i].Name == "remote" {
+			afterView = &after.MCPServerViews[i]
+		}
+	}
+	if beforeView == nil || afterView == nil {
+		t.Fatal("Expected MCPServerView for remote server")
+	}
+
+	found := false
+	for _, f := range afterView.Findings {
+		if f.ID == "CUSTOM-prod-max-tools-remote" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("Expected custom finding on MCPServerView")
+	}
+	if afterView.ScoreBreakdown.ToolScope >= beforeView.ScoreBreakdown.ToolScope {
+		t.Errorf("ToolScope should drop with custom finding: before=%d after=%d",
+			beforeView.ScoreBreakdown.ToolScope, afterView.ScoreBreakdown.ToolScope)
+	}
+}
@@ -0,0 +1,90 @@
+package evaluator
+
+import (
+	"sort"
+)
+
+// MCPServerScoreDelta compares one MCP server's score between two evaluations.
+// Servers present in only one evaluation have the other score reported as -1.
+type MCPServerScoreDelta struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Namespace     string `json:"namespace"`
+	PreviousScore int    `json:"previousScore"`
+	Score         int    `json:"score"`
+	Delta         int    `json:"delta"`
+	PreviousGrade string `json:"previousGrade,omitempty"`
+	Grade         string `json:"grade,omitempty"`
+}
+
+// ResultDiff describes how an evaluation differs from a previous one.
+type ResultDiff struct {
+	PreviousScore   int                   `json:"previousScore"`
+	Score           int                   `json:"score"`
+	ScoreDelta      int                   `json:"scoreDelta"`
+	ServerDeltas    []MCPServerScoreDelta `json:"serverDeltas"`
+	FindingsAdded   []Finding             `json:"findingsAdded"`
+	FindingsRemoved []Finding             `json:"findingsRemoved"`
+}
+
+// DiffResults compares after against before. A nil before is treated as an
+// empty evaluation.
+func DiffResults(before, after *EvaluationResult) ResultDiff {
+	if before == nil {
+		before = &EvaluationResult{}
+	}
+	if after == nil {
+		after = &EvaluationResult{}
+	}
+	d := ResultDiff{
+		PreviousScore:   before.Score,
+		Score:           after.Score,
+		ScoreDelta:      after.Score - before.Score,
+		ServerDeltas:    []MCPServerScoreDelta{},
+		FindingsAdded:   []Finding{},
+		FindingsRemoved: []Finding{},
+	}
+
+	prevFindings := make(map[string]bool, len(before.Findings))
+	for _, f := range before.Findings {
+		prevFindings[FindingFingerprint(f)] = true
+	}
+	nextFindings := make(map[string]bool, len(after.Findings))
+	for _, f := range after.Findings {
+		nextFindings[FindingFingerprint(f)] = true
+		if !prevFindings[FindingFingerprint(f)] {
+			d.FindingsAdded = append(d.FindingsAdded, f)
+		}
+	}
+	for _, f := range before.Findings {
+		if !nextFindings[FindingFingerprint(f)] {
+			d.FindingsRemoved = append(d.FindingsRemoved, f)
+		}
+	}
+
+	deltas := map[string]*MCPServerScoreDelta{}
+	for _, v := range before.MCPServerViews {
+		deltas[v.ID] = &MCPServerScoreDelta{
+			ID: v.ID, Name: v.Name, Namespace: v.Namespace,
+			PreviousScore: v.Score, PreviousGrade: v.Grade, Score: -1,
+		}
+	}
+	for _, v := range after.MCPServerViews {
+		sd, ok := deltas[v.ID]
+		if !ok {
+			sd = &MCPServerScoreDelta{ID: v.ID, Name: v.Name, Namespace: v.Namespace, PreviousScore: -1}
+			deltas[v.ID] = sd
+		}
+		sd.Score = v.Score
+		sd.Grade = v.Grade
+	}
+	for _, sd := range deltas {
+		if sd.PreviousScore >= 0 && sd.Score >= 0 {
+			sd.Delta = sd.Score - sd.PreviousScore
+		}
+		d.ServerDeltas = append(d.ServerDeltas, *sd)
+	}
+	sort.Slice(d.ServerDeltas, func(i, j int) bool { return d.ServerDeltas[i].ID < d.ServerDeltas[j].ID })
+
+	return d
+}
@@ -0,0 +1,67 @@
+package evaluator
+
+import (
+	"testing"
+)
+
+func TestDiffResults(t *testing.T) {
+	before := &EvaluationResult{
+		Score: 60,
+		Findings: []Finding{
+			{ID: "AGW-001", ResourceRef: ""},
+			{ID: "AUTH-001", ResourceRef: "AgentgatewayBackend/ns/a"},
+			{ID: "AUTH-001", ResourceRef: "AgentgatewayBackend/ns/b"},
+		},
+		MCPServerViews: []MCPServerView{
+			{ID: "ns/a", Name: "a", Namespace: "ns", Score: 50, Grade: "F"},
+			{ID: "ns/gone", Name: "gone", Namespace: "ns", Score: 70, Grade: "C"},
+		},
+	}
+	after := &EvaluationResult{
+		Score: 75,
+		Findings: []Finding{
+			{ID: "AUTH-001", ResourceRef: "AgentgatewayBackend/ns/a"},
+			{ID: "TLS-001", ResourceRef: "AgentgatewayBackend/ns/a"},
+		},
+		MCPServerViews: []MCPServerView{
+			{ID: "ns/a", Name: "a", Namespace: "ns", Score: 80, Grade: "B"},
+			{ID: "ns/new", Name: "new", Namespace: "ns", Score: 90, Grade: "A"},
+		},
+	}
+
+	d := DiffResults(before, after)
+
+	if d.ScoreDelta != 15 {
+		t.Errorf("ScoreDelta = %d, want 15", d.ScoreDelta)
+	}
+	if len(d.FindingsAdded) != 1 || d.FindingsAdded[0].ID != "TLS-001" {
+		t.Errorf("FindingsAdded = %+v", d.FindingsAdded)
+	}
+	if len(d.FindingsRemoved) != 2 {
+		t.Errorf("FindingsRemoved = %+v, want AGW-001 and AUTH-001 for b", d.FindingsRemoved)
+	}
+
+	if len(d.ServerDeltas) != 3 {
+		t.Fatalf("Expected 3 server deltas, got %+v", d.ServerDeltas)
+	}
+	byID := map[string]MCPServerScoreDelta{}
+	for _, sd := range d.ServerDeltas {
+		byID[sd.ID] = sd
+	}
+	if sd := byID["ns/a"]; sd.Delta != 30 || sd.PreviousGrade != "F" || sd.Grade != "B" {
+		t.Errorf("ns/a delta = %+v", sd)
+	}
+	if sd := byID["ns/gone"]; sd.Score != -1 || sd.Delta != 0 {
+		t.Errorf("removed server should have Score -1, got %+v", sd)
+	}
+	if sd := byID["ns/new"]; sd.PreviousScore != -1 || sd.Score != 90 {
+		t.Errorf("new server should have PreviousScore -1, got %+v", sd)
+	}
+}
+
+func TestDiffResults_NilBefore(t *testing.T) {
+	d := DiffResults(nil, &EvaluationResult{Score: 40, Findings: []Finding{{ID: "X"}}})
+	if d.PreviousScore != 0 || d.ScoreDelta != 40 || len(d.FindingsAdded) != 1 {
+		t.Errorf("unexpected diff from nil: %+v", d)
+	}
+}
@@ -2,10 +2,14 @@ package evaluator
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	v1alpha1 "github.com/techwithhuz/mcp-security-governance/controller/pkg/apis/governance/v1alpha1"
 	"github.com/techwithhuz/mcp-security-governance/controller/pkg/auditor"
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/imageverify"
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/mcpprobe"
 	"github.com/techwithhuz/mcp-security-governance/controller/pkg/skillscanner"
 )
 
@@ -29,6 +33,9 @@ const (
 	CategoryExposure        = "Exposure"
 	CategoryToolScope       = "ToolScope"
 	CategoryHardening       = "Hardening"
+	CategoryWorkloadRBAC    = "WorkloadRBAC"
+	CategoryVulnerabilities = "Vulnerabilities"
+	CategoryGovernance      = "Governance"
 )
 
 // ClusterState holds discovered Kubernetes resource state
@@ -47,11 +54,45 @@ type ClusterState struct {
 	// agentregistry resources (agentregistry.dev/v1alpha1)
 	SkillCatalogs []SkillCatalogResource
 
+	// governance resources (governance.mcp.io/v1alpha1)
+	GovernanceExceptions []GovernanceExceptionResource
+
 	// Standard K8s
 	Services        []ServiceResource
+	Ingresses       []IngressResource
+	Routes          []RouteResource // OpenShift route.openshift.io/v1
 	Namespaces      []string
+	NamespaceMeta   map[string]ObjectMeta // Namespace name -> labels/annotations (ownership resolution)
 	Workloads       []WorkloadResource
 	NetworkPolicies []NetworkPolicyResource
+
+	// Kubernetes RBAC (rbac.authorization.k8s.io/v1)
+	ServiceAccounts []ServiceAccountResource
+	Roles           []RoleResource        // Roles and ClusterRoles
+	RoleBindings    []RoleBindingResource // RoleBindings and ClusterRoleBindings
+
+	// Trivy Operator scan reports (aquasecurity.github.io/v1alpha1)
+	VulnerabilityReports []VulnerabilityReportResource
+	ConfigAuditReports   []ConfigAuditReportResource
+
+	// Cosign verification results keyed by namespace and image reference (see
+	// ImageSignatureKey); nil when image verification is disabled
+	ImageSignatures map[string]imageverify.Result
+
+	// Live MCP probe results keyed by MCPServerView ID (see mcpprobe); nil
+	// when probing is disabled
+	MCPProbes map[string]mcpprobe.Result
+
+	// Auth bypass probes keyed by MCPServerView ID (see AuthProbeTargets);
+	// nil when not enabled
+	AuthProbes map[string][]AuthProbe
+}
+
+// ObjectMeta holds the labels and annotations of a resource that has no other
+// representation in the cluster state (e.g. a Namespace).
+type ObjectMeta struct {
+	Labels      map[string]string
+	Annotations map[string]string
 }
 
 // SkillCatalogResource holds the governance-relevant fields from a SkillCatalog CR.
@@ -68,6 +109,7 @@ type SkillCatalogResource struct {
 	Environment string // label agentregistry.dev/resource-environment
 	ResourceUID string // label agentregistry.dev/resource-uid
 	Labels      map[string]string
+	Annotations map[string]string
 }
 
 // FilterByNamespaces returns a new ClusterState containing only resources whose
@@ -99,7 +141,11 @@ func (s *ClusterState) FilterByNamespaces(targetNamespaces, excludeNamespaces []
 
 	filtered := &ClusterState{
 		// Keep cluster-scoped resources
-		Gateways: s.Gateways,
+		Gateways:        s.Gateways,
+		NamespaceMeta:   s.NamespaceMeta,
+		ImageSignatures: s.ImageSignatures,
+		MCPProbes:       s.MCPProbes,
+		AuthProbes:      s.AuthProbes,
 	}
 
 	// Filter namespaces list
@@ -145,6 +191,16 @@ func (s *ClusterState) FilterByNamespaces(targetNamespaces, excludeNamespaces []
 			filtered.Services = append(filtered.Services, r)
 		}
 	}
+	for _, r := range s.Ingresses {
+		if allowed[r.Namespace] {
+			filtered.Ingresses = append(filtered.Ingresses, r)
+		}
+	}
+	for _, r := range s.Routes {
+		if allowed[r.Namespace] {
+			filtered.Routes = append(filtered.Routes, r)
+		}
+	}
 	for _, r := range s.Workloads {
 		if allowed[r.Namespace] {
 			filtered.Workloads = append(filtered.Workloads, r)
@@ -160,6 +216,37 @@ func (s *ClusterState) FilterByNamespaces(targetNamespaces, excludeNamespaces []
 			filtered.SkillCatalogs = append(filtered.SkillCatalogs, r)
 		}
 	}
+	for _, r := range s.ServiceAccounts {
+		if allowed[r.Namespace] {
+			filtered.ServiceAccounts = append(filtered.ServiceAccounts, r)
+		}
+	}
+	// Cluster-scoped roles and bindings apply to every namespace
+	for _, r := range s.Roles {
+		if r.Namespace == "" || allowed[r.Namespace] {
+			filtered.Roles = append(filtered.Roles, r)
+		}
+	}
+	for _, r := range s.RoleBindings {
+		if r.Namespace == "" || allowed[r.Namespace] {
+			filtered.RoleBindings = append(filtered.RoleBindings, r)
+		}
+	}
+	for _, r := range s.VulnerabilityReports {
+		if allowed[r.Namespace] {
+			filtered.VulnerabilityReports = append(filtered.VulnerabilityReports, r)
+		}
+	}
+	for _, r := range s.ConfigAuditReports {
+		if allowed[r.Namespace] {
+			filtered.ConfigAuditReports = append(filtered.ConfigAuditReports, r)
+		}
+	}
+	for _, r := range s.GovernanceExceptions {
+		if allowed[r.Namespace] {
+			filtered.GovernanceExceptions = append(filtered.GovernanceExceptions, r)
+		}
+	}
 
 	return filtered
 }
@@ -175,9 +262,13 @@ type GatewayResource struct {
 }
 
 type ListenerInfo struct {
-	Name     string
-	Port     int
-	Protocol string
+	Name            string            `json:"name"`
+	Port            int               `json:"port"`
+	Protocol        string            `json:"protocol"`
+	Hostname        string            `json:"hostname,omitempty"`
+	TLSMode         string            `json:"tlsMode,omitempty"`         // "Terminate" or "Passthrough"; empty without tls
+	CertificateRefs []string          `json:"certificateRefs,omitempty"` // namespace/name of the referenced Secrets
+	Certificates    []CertificateInfo `json:"certificates,omitempty"`
 }
 
 type AgentgatewayBackendResource struct {
@@ -188,6 +279,8 @@ type AgentgatewayBackendResource struct {
 	HasAuth     bool
 	HasTLS      bool
 	HasClientCert bool // Tier 2 #19: true when TLS is configured with a client certificate (mTLS)
+	Labels      map[string]string
+	Annotations map[string]string
 }
 
 type MCPTargetInfo struct {
@@ -212,7 +305,12 @@ type AgentgatewayPolicyResource struct {
 	HasPromptGuard bool
 	JWTMode      string   // "Strict", "Optional", "Permissive"
 	JWTAudiences []string // Tier 2 #18: audiences from jwtAuthentication config
-	AllowedTools []string // Tool names extracted from authorization CEL matchExpressions
+	JWTProviders []JWTProvider // issuers, JWKS sources, algorithms and claims from jwtAuthentication
+	CORS         *CORSConfig       // traffic.cors settings; nil when absent
+	RateLimits   []RateLimitRule   // traffic.rateLimit local and global rules
+	PromptGuardRules []PromptGuardRule // request/response guards from promptGuard
+	AllowedTools []string // Tool names admitted by the authorization rules (nil when tools are not restricted)
+	Authorization *AuthorizationAnalysis // parsed traffic and backend.mcp authorization rules; nil when absent
 }
 
 type PolicyTargetRef struct {
@@ -229,6 +327,9 @@ type HTTPRouteResource struct {
 	BackendRefs            []string
 	HasCORSFilter          bool
 	Paths                  []string // Extracted path values from rules (e.g., ["/ro", "/rw"])
+	Hostnames              []string // spec.hostnames
+	ParentSectionName      string   // parentRefs sectionName: the listener the route attaches to (empty = all)
+	ServiceBackendRefs     []string // backendRefs of kind Service (core group)
 }
 
 // ---- kagent resource representations ----
@@ -239,6 +340,8 @@ type KagentAgentResource struct {
 	Type      string // "Declarative", "BYO"
 	Tools     []KagentToolRef
 	Ready     bool
+	Labels      map[string]string
+	Annotations map[string]string
 }
 
 type KagentToolRef struct {
@@ -254,14 +357,19 @@ type KagentMCPServerResource struct {
 	Transport string // "stdio", "sse", "streamablehttp"
 	Port      int
 	HasService bool
+	Labels      map[string]string
+	Annotations map[string]string
 }
 
 type KagentRemoteMCPServerResource struct {
 	Name      string
 	Namespace string
 	URL       string
+	Protocol  string // spec.protocol: "SSE" or "STREAMABLE_HTTP"
 	ToolCount int
 	ToolNames []string
+	Labels      map[string]string
+	Annotations map[string]string
 }
 
 type ServiceResource struct {
@@ -270,13 +378,31 @@ type ServiceResource struct {
 	AppProtocol string
 	Ports       []int
 	IsMCP       bool
+	Labels      map[string]string
+	Annotations map[string]string
+
+	Type                  string            // "ClusterIP", "NodePort", "LoadBalancer" or "ExternalName"
+	Selector              map[string]string // spec.selector; empty for selector-less Services
+	ExternalIPs           []string
+	LoadBalancerAddresses []string // status.loadBalancer.ingress IPs and hostnames
+	NodePorts             []int
 }
 
-// WorkloadResource holds security-relevant fields extracted from a Deployment or StatefulSet pod spec.
+// WorkloadResource holds security-relevant fields extracted from the pod spec of a Deployment,
+// StatefulSet, DaemonSet, Job, CronJob or bare Pod.
 type WorkloadResource struct {
 	Name      string
 	Namespace string
-	Kind      string // "Deployment" or "StatefulSet"
+	Kind      string // "Deployment", "StatefulSet", "DaemonSet", "Job", "CronJob" or "Pod"
+
+	// Workload metadata merged over the pod template's (ownership resolution)
+	Labels      map[string]string
+	Annotations map[string]string
+	PodLabels   map[string]string // pod template labels only (NetworkPolicy selector matching)
+
+	// Pod identity
+	ServiceAccountName           string // "" = "default"
+	AutomountServiceAccountToken *bool  // pod-level setting; nil defers to the ServiceAccount
 
 	// Pod-level security context
 	RunAsNonRoot      bool
@@ -291,6 +417,17 @@ type WorkloadResource struct {
 	AllContainersNoPrivEscalation bool // all containers set allowPrivilegeEscalation:false
 	AllContainersCapDropAll       bool // all containers drop ALL capabilities
 
+	// Privilege and host exposure (zero values are the safe defaults)
+	PrivilegedContainers []string // containers with securityContext.privileged: true
+	HostNetwork          bool
+	HostPID              bool
+	HostIPC              bool
+	HostPathVolumes      []string // "volume-name=/host/path"
+	AddedCapabilities    []string // capabilities.add across all containers, e.g. "SYS_ADMIN"
+
+	// Resource limits
+	ContainersWithoutLimits []string // containers missing a CPU or memory limit
+
 	// Image hygiene
 	HasLatestTag bool     // any container image uses :latest or has no tag
 	ImageNames   []string // all container image names
@@ -307,11 +444,15 @@ type WorkloadResource struct {
 
 // NetworkPolicyResource holds a discovered NetworkPolicy resource.
 type NetworkPolicyResource struct {
-	Name                string
-	Namespace           string
-	PodSelectorLabels   map[string]string
-	HasIngressRules     bool
-	HasEgressRules      bool
+	Name                   string
+	Namespace              string
+	PodSelectorLabels      map[string]string
+	PodSelectorExpressions []LabelSelectorRequirement
+	PolicyTypes            []string // "Ingress", "Egress"; empty = Kubernetes defaults
+	HasIngressRules        bool
+	HasEgressRules         bool
+	Ingress                []NetworkPolicyRule
+	Egress                 []NetworkPolicyRule
 }
 
 // Finding represents a governance finding
@@ -326,6 +467,8 @@ type Finding struct {
 	ResourceRef string `json:"resourceRef,omitempty"`
 	Namespace   string `json:"namespace,omitempty"`
 	Timestamp   string `json:"timestamp,omitempty"`
+	Fingerprint string `json:"fingerprint,omitempty"` // Stable hash of check ID + resourceRef (see findingstore.go)
+	Owner       string `json:"owner,omitempty"`       // Owning team resolved from labels/annotations (see ownership.go)
 }
 
 // EvaluationResult holds the complete evaluation output
@@ -335,12 +478,17 @@ type EvaluationResult struct {
 	Findings        []Finding
 	ResourceSummary ResourceSummary
 	NamespaceScores []NamespaceScore
+	TeamScores      []TeamScore `json:"teamScores,omitempty"`
 	Timestamp       time.Time
 	// MCP-server-centric views
 	MCPServerViews        []MCPServerView                `json:"mcpServerViews"`
 	MCPServerSummary      MCPServerSummary               `json:"mcpServerSummary"`
+	// Agent-centric views (see agents.go)
+	AgentViews            []AgentView                    `json:"agentViews"`
 	VerifiedCatalogScores []v1alpha1.VerifiedCatalogScore `json:"verifiedCatalogScores,omitempty"`
 	SkillCatalogScores    []SkillCatalogScore            `json:"skillCatalogScores,omitempty"`
+	// Findings waived by an active GovernanceException (excluded from scoring)
+	WaivedFindings []WaivedFinding `json:"waivedFindings,omitempty"`
 }
 
 // SkillCatalogScore is the per-catalog governance score written to the status CR.
@@ -424,6 +572,7 @@ type Policy struct {
 	RequireTLS                 bool
 	RequireRateLimit           bool
 	RequireHardenedDeployment  bool     // If true, check container securityContext and NetworkPolicy
+	RequireWorkloadRBAC        bool     // If true, check the Kubernetes RBAC of MCP workload ServiceAccounts
 	EnableAIAgent       bool     // If true, use AI agent for governance scoring alongside algorithmic scoring
 	AIProvider          string   // LLM provider: "gemini" or "ollama" (default: "gemini")
 	AIModel             string   // Model name (e.g. "gemini-2.5-flash", "llama3.1")
@@ -433,12 +582,29 @@ type Policy struct {
 	ScanInterval        string   // Interval between governance scans (e.g. "5m", "10m", "1h"); default: "5m"
 	MaxToolsWarning     int // If MCP server has more than this many tools, generate Warning
 	MaxToolsCritical    int // If MCP server has more than this many tools, generate Critical
+	CertExpiryWarningDays int // TLS-005: flag Gateway certificates expiring within this many days (default 30)
+	RequiredJWTClaims   []string // AUTH-010: claims every JWT provider must require (empty = not checked)
+	AllowedCORSOrigins  []string // CORS-005: origins a CORS policy may allow (nil = any HTTPS origin without wildcards)
+	MaxRateLimitRPS     int      // RL-003: flag local rate limits allowing more requests per second (default 100)
+	RequiredPromptGuardBuiltins []string // PG-005: builtin detectors every prompt guard must configure (empty = not checked)
 	TargetNamespaces    []string // If non-empty, only evaluate resources in these namespaces
 	ExcludeNamespaces   []string // Namespaces to exclude from evaluation (e.g. kube-system)
 	Weights             ScoringWeights
 	SeverityPenalties   SeverityPenalties
 	VerifiedCatalogScoring interface{} // *v1alpha1.VerifiedCatalogScoringConfig (stored as interface to avoid circular imports)
 	SkillGovernance        SkillGovernancePolicy
+	Checks                 map[string]CheckConfig // Per-check overrides keyed by Check.ID()
+	CheckOverrides         map[string]CheckOverride // Per-finding severity/penalty/disable overrides keyed by finding ID prefix
+	CustomRules            []CustomRule           // CEL-based house rules from spec.customRules
+	ScopeNamespaces        []string               // Namespace-scoped policy: namespaces it tightens (empty = cluster-wide baseline)
+	NamespacePolicies      map[string]Policy      // Effective per-namespace policies (see ResolvePolicies)
+	SourcePolicies         []string               // Names of the MCPGovernancePolicy CRs merged into this policy
+	ScopedPolicies         []Policy               // Raw namespace-scoped policies, kept so the baseline can be re-resolved
+	Ownership              OwnershipPolicy        // Label/annotation keys used to attribute findings and servers to teams
+	ToolSensitivity        ToolSensitivityPolicy  // Tool classification patterns and per-class ToolScope penalties
+	ImageVerification      ImageVerificationPolicy // Cosign trusted keys and registry allowlist for workload images
+	Vulnerabilities        VulnerabilityPolicy     // CVE thresholds for Trivy VulnerabilityReports of MCP workloads
+	MCPProbe               MCPProbePolicy          // Live initialize/tools/list probing of MCP endpoints
 }
 
 // SkillGovernancePolicy configures governance behaviour for SkillCatalog CRs.
@@ -495,6 +661,8 @@ type ScoringWeights struct {
 	RateLimit               int
 	ToolScope               int
 	HardenedDeployment      int
+	WorkloadRBAC            int
+	Vulnerabilities         int
 }
 
 // DefaultExcludeNamespaces returns the list of system namespaces that should
@@ -518,8 +686,11 @@ func DefaultPolicy() Policy {
 		RequireTLS:                true,
 		RequireRateLimit:          false,
 		RequireHardenedDeployment: true,
+		RequireWorkloadRBAC:       true,
 		MaxToolsWarning:           10,
 		MaxToolsCritical:          15,
+		CertExpiryWarningDays:     DefaultCertExpiryWarningDays,
+		MaxRateLimitRPS:           DefaultMaxRateLimitRPS,
 		ExcludeNamespaces:         DefaultExcludeNamespaces(),
 		Weights: ScoringWeights{
 			AgentGatewayIntegration: 20,
@@ -531,8 +702,14 @@ func DefaultPolicy() Policy {
 			RateLimit:               5,
 			ToolScope:               5,
 			HardenedDeployment:      15,
+			WorkloadRBAC:            10,
+			Vulnerabilities:         10,
 		},
 		SeverityPenalties: DefaultSeverityPenalties(),
+		Ownership:         DefaultOwnershipPolicy(),
+		ToolSensitivity:   DefaultToolSensitivityPolicy(),
+		Vulnerabilities:   DefaultVulnerabilityPolicy(),
+		MCPProbe:          DefaultMCPProbePolicy(),
 		SkillGovernance: SkillGovernancePolicy{
 			Enabled:                   true,
 			ScanRepoContent:           false,
@@ -569,19 +746,9 @@ func Evaluate(state *ClusterState, policy Policy) *EvaluationResult {
 	// 1. Discover and summarize resources
 	result.ResourceSummary = summarizeResources(state)
 
-	// 2. Run all governance checks
-	result.Findings = append(result.Findings, checkAgentGatewayCompliance(state, policy)...)
-	result.Findings = append(result.Findings, checkAuthentication(state, policy)...)
-	result.Findings = append(result.Findings, checkJWTAudienceScope(state, policy)...) // Tier 2 #18
-	result.Findings = append(result.Findings, checkMTLS(state, policy)...)              // Tier 2 #19
-	result.Findings = append(result.Findings, checkAuthorization(state, policy)...)
-	result.Findings = append(result.Findings, checkCORS(state, policy)...)
-	result.Findings = append(result.Findings, checkTLS(state, policy)...)
-	result.Findings = append(result.Findings, checkPromptGuard(state, policy)...)
-	result.Findings = append(result.Findings, checkRateLimit(state, policy)...)
-	result.Findings = append(result.Findings, checkExposure(state, policy)...)
-	result.Findings = append(result.Findings, checkToolCount(state, policy)...)
-	result.Findings = append(result.Findings, checkHardenedDeployment(state, policy)...)
+	// 2. Run all registered governance checks (see checks.go) and CEL custom
+	// rules (see customrules.go), honouring namespace-scoped policies.
+	result.Findings = append(result.Findings, runPolicyChecks(state, policy)...)
 
 	// Skill Catalogue governance — metadata + optional repo scanning
 	mountPath := policy.SkillGovernance.PatternMountPath
@@ -593,18 +760,46 @@ func Evaluate(state *ClusterState, policy Policy) *EvaluationResult {
 	result.Findings = append(result.Findings, skillFindings...)
 	result.SkillCatalogScores = skillScores
 
+	// Per-finding severity / disable overrides from spec.checkOverrides
+	var overrides []appliedOverride
+	result.Findings, overrides = applyCheckOverrides(result.Findings, policy)
+
+	// Move findings covered by an active GovernanceException into the waived list
+	result.Findings, result.WaivedFindings = applyGovernanceExceptions(state, result.Findings, result.Timestamp)
+
+	// Stable per-issue fingerprints so findings can be tracked across scans
+	assignFingerprints(result.Findings)
+	for i := range result.WaivedFindings {
+		result.WaivedFindings[i].Fingerprint = FindingFingerprint(result.WaivedFindings[i].Finding)
+	}
+
+	// Attribute findings to owning teams (resource → workload → namespace labels)
+	owners := newOwnerResolver(state, policy.Ownership)
+	assignOwners(owners, result.Findings)
+	for i := range result.WaivedFindings {
+		result.WaivedFindings[i].Owner = owners.resolveFinding(result.WaivedFindings[i].Finding)
+	}
+
 	// Tier 2 #16: Audit every finding
 	for _, f := range result.Findings {
 		auditLog.LogFinding(evalID, f.ID, f.Severity, f.Category, "", f.Namespace,
 			fmt.Sprintf("[%s] %s", f.Severity, f.Title))
 	}
+	for _, o := range overrides {
+		auditLog.LogOverride(evalID, o.Finding.ID, o.OriginalSeverity, o.Finding.Severity, o.Finding.Category, o.Key, o.Disabled,
+			fmt.Sprintf("[%s] %s — check override '%s' applied", o.Finding.Severity, o.Finding.Title, o.Key))
+	}
+	for _, wf := range result.WaivedFindings {
+		auditLog.LogWaiver(evalID, wf.ID, wf.Severity, wf.Category, wf.Waiver.Exception,
+			fmt.Sprintf("[%s] %s — waived by %s (approver=%s, expires=%s)", wf.Severity, wf.Title, wf.Waiver.Exception, wf.Waiver.Approver, wf.Waiver.ExpiresAt))
+	}
 
 	// 3. Calculate scores
 	result.ScoreBreakdown = calculateScores(state, result.Findings, policy)
 	result.Score = calculateOverallScore(result.ScoreBreakdown, policy.Weights, policy)
 
 	// 4. Namespace-level scores
-	result.NamespaceScores = calculateNamespaceScores(state, result.Findings, policy.SeverityPenalties)
+	result.NamespaceScores = calculateNamespaceScores(state, result.Findings, policy)
 
 	// 5. Count compliant vs non-compliant
 	for _, f := range result.Findings {
@@ -653,6 +848,12 @@ func Evaluate(state *ClusterState, policy Policy) *EvaluationResult {
 	result.ScoreBreakdown = aggregateBreakdownFromMCPViews(result.MCPServerViews, policy)
 	result.Score = calculateOverallScore(result.ScoreBreakdown, policy.Weights, policy)
 
+	// 9. Team scorecards from the owners resolved above
+	result.TeamScores = calculateTeamScores(result.Findings, result.MCPServerViews, policy)
+
+	// 10. Agent-centric views, scored from the MCP server views they use
+	result.AgentViews = BuildAgentViews(state, result.MCPServerViews, result.Findings, policy)
+
 	// Tier 2 #16: Audit per-server scores and overall result
 	for _, v := range result.MCPServerViews {
 		auditLog.LogScoreChange(evalID, v.Name, v.Namespace, 0, v.Score,
@@ -670,6 +871,63 @@ func Evaluate(state *ClusterState, policy Policy) *EvaluationResult {
 	return result
 }
 
+// runPolicyChecks runs the registered checks and custom rules with the baseline
+// policy, then re-runs them with each namespace's effective policy on that
+// namespace's share of the cluster state (see namespaceCheckState) and replaces
+// that namespace's findings.
+func runPolicyChecks(state *ClusterState, policy Policy) []Finding {
+	findings := append(runRegisteredChecks(state, policy), checkCustomRules(state, policy)...)
+	if len(policy.NamespacePolicies) == 0 {
+		return findings
+	}
+
+	namespaces := make([]string, 0, len(policy.NamespacePolicies))
+	for ns := range policy.NamespacePolicies {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+
+	overridden := make(map[string]bool, len(namespaces))
+	var nsFindings []Finding
+	for _, ns := range namespaces {
+		overridden[ns] = true
+		nsPolicy := policy.NamespacePolicies[ns]
+		nsState := namespaceCheckState(state, ns)
+		for _, f := range append(runRegisteredChecks(nsState, nsPolicy), checkCustomRules(nsState, nsPolicy)...) {
+			if findingNamespace(f) == ns {
+				nsFindings = append(nsFindings, f)
+			}
+		}
+	}
+
+	var out []Finding
+	for _, f := range findings {
+		if !overridden[findingNamespace(f)] {
+			out = append(out, f)
+		}
+	}
+	return append(out, nsFindings...)
+}
+
+// namespaceCheckState returns the cluster state the checks of a namespace
+// policy run on: the namespace's own resources plus the agentgateway backends,
+// policies and routes of every namespace, which MCP servers are correlated
+// with by name across namespaces, every MCP server, which Agents reference by
+// name across namespaces, and every Service, so URLs pointing at the
+// agentgateway Service still resolve to their Gateway. All namespaces stay known so NetworkPolicy
+// namespace selectors still resolve.
+func namespaceCheckState(state *ClusterState, ns string) *ClusterState {
+	scoped := state.FilterByNamespaces([]string{ns}, nil)
+	scoped.Namespaces = state.Namespaces
+	scoped.AgentgatewayBackends = state.AgentgatewayBackends
+	scoped.AgentgatewayPolicies = state.AgentgatewayPolicies
+	scoped.HTTPRoutes = state.HTTPRoutes
+	scoped.Services = state.Services
+	scoped.KagentMCPServers = state.KagentMCPServers
+	scoped.KagentRemoteMCPServers = state.KagentRemoteMCPServers
+	return scoped
+}
+
 func summarizeResources(state *ClusterState) ResourceSummary {
 	totalMCP := len(state.KagentMCPServers) + len(state.KagentRemoteMCPServers)
 	for _, b := range state.AgentgatewayBackends {
@@ -822,7 +1080,8 @@ func checkAuthentication(state *ClusterState, policy Policy) []Finding {
 	for _, p := range state.AgentgatewayPolicies {
 		if p.HasJWT {
 			hasJWTPolicy = true
-			if p.JWTMode == "Optional" || p.JWTMode == "Permissive" {
+			// Permissive mode is reported separately as AUTH-009
+			if p.JWTMode == "Optional" {
 				findings = append(findings, Finding{
 					ID:          fmt.Sprintf("AUTH-001-%s", p.Name),
 					Severity:    SeverityHigh,
@@ -1013,6 +1272,7 @@ func checkCORS(state *ClusterState, policy Policy) []Finding {
 		})
 	}
 
+	findings = append(findings, checkCORSConfig(state, policy)...)
 	return findings
 }
 
@@ -1102,6 +1362,7 @@ func checkPromptGuard(state *ClusterState, policy Policy) []Finding {
 		})
 	}
 
+	findings = append(findings, checkPromptGuardConfig(state, policy)...)
 	return findings
 }
 
@@ -1148,6 +1409,7 @@ func checkRateLimit(state *ClusterState, policy Policy) []Finding {
 		})
 	}
 
+	findings = append(findings, checkRateLimitConfig(state, policy)...)
 	return findings
 }
 
@@ -1219,22 +1481,7 @@ func checkExposure(state *ClusterState, policy Policy) []Finding {
 
 		for _, rms := range state.KagentRemoteMCPServers {
 			// Check if the RemoteMCPServer URL points through agentgateway
-			routedThroughGateway := false
-			for _, gw := range state.Gateways {
-				if gw.GatewayClassName == "agentgateway" {
-					// Check if the URL references the agentgateway service
-					for _, svc := range state.Services {
-						if svc.Name == "agentgateway" || svc.Name == gw.Name {
-							// URL should contain the agentgateway service name
-							if containsHost(rms.URL, svc.Name, svc.Namespace) {
-								routedThroughGateway = true
-							}
-						}
-					}
-				}
-			}
-
-			if !routedThroughGateway {
+			if _, routedThroughGateway := GatewayForURL(state, rms.URL); !routedThroughGateway {
 				findings = append(findings, Finding{
 					ID:          fmt.Sprintf("EXP-001-%s", rms.Name),
 					Severity:    exposureSeverity,
@@ -1251,13 +1498,32 @@ func checkExposure(state *ClusterState, policy Policy) []Finding {
 		}
 	}
 
+	// Services, Ingresses, Routes and HTTPRoutes reaching MCP workloads directly
+	findings = append(findings, checkGatewayBypass(state, policy)...)
+
 	return findings
 }
 
+// dangerousCapabilities are Linux capabilities that allow a container to escape
+// isolation, sniff or spoof traffic, or tamper with the host kernel.
+var dangerousCapabilities = map[string]bool{
+	"ALL":             true,
+	"SYS_ADMIN":       true,
+	"NET_ADMIN":       true,
+	"NET_RAW":         true,
+	"SYS_PTRACE":      true,
+	"SYS_MODULE":      true,
+	"SYS_RAWIO":       true,
+	"DAC_READ_SEARCH": true,
+	"BPF":             true,
+}
+
 // checkHardenedDeployment inspects workload security contexts and network policies.
 // It covers OWASP Pillar 5: non-root containers, read-only root FS, no privilege
 // escalation, capability drops, seccomp, no :latest tag, NetworkPolicy presence,
-// plaintext secret env vars, vault/ESO annotations, and image signature annotations.
+// plaintext secret env vars, vault/ESO annotations, image signature annotations,
+// privileged containers, host namespaces, hostPath volumes, dangerous added
+// capabilities and CPU/memory limits.
 func checkHardenedDeployment(state *ClusterState, policy Policy) []Finding {
 	var findings []Finding
 	ts := fmt.Sprintf("%s", "")
@@ -1274,9 +1540,9 @@ func checkHardenedDeployment(state *ClusterState, policy Policy) []Finding {
 			Severity:    SeverityHigh,
 			Category:    CategoryHardening,
 			Title:       "No workloads discovered for hardening evaluation",
-			Description: "No Deployments or StatefulSets were found in the evaluated namespaces. Hardening checks require workload discovery to be enabled.",
+			Description: "No Deployments, StatefulSets, DaemonSets, Jobs, CronJobs or bare Pods were found in the evaluated namespaces. Hardening checks require workload discovery to be enabled.",
 			Impact:      "Container security posture cannot be assessed.",
-			Remediation: "Ensure MCP server workloads are deployed as Deployments or StatefulSets in evaluated namespaces.",
+			Remediation: "Ensure MCP server workloads are deployed in evaluated namespaces.",
 		})
 		return findings
 	}
@@ -1398,6 +1664,19 @@ func checkHardenedDeployment(state *ClusterState, policy Policy) []Finding {
 				Remediation: "Create a NetworkPolicy in namespace '%s' with an ingress rule allowing only agentgateway traffic and a restrictive egress rule.",
 				Namespace:   w.Namespace,
 			})
+		} else if nsPolicyCovered[w.Namespace] && len(workloadNetworkExposure(state, w).Policies) == 0 {
+			// The namespace has policies, but none of their podSelectors match this workload
+			findings = append(findings, Finding{
+				ID:          fmt.Sprintf("HDN-007-%s", w.Name),
+				Severity:    SeverityHigh,
+				Category:    CategoryHardening,
+				Title:       fmt.Sprintf("No NetworkPolicy selects %s '%s'", w.Kind, w.Name),
+				Description: fmt.Sprintf("Namespace '%s' has NetworkPolicies, but none of their podSelectors match the pod labels of %s '%s'. Its pods are not isolated.", w.Namespace, w.Kind, w.Name),
+				Impact:      "A compromised MCP server container can reach any internal service, database, or cloud metadata endpoint without restriction.",
+				Remediation: fmt.Sprintf("Add a NetworkPolicy whose podSelector matches the pod template labels of %s '%s', allowing ingress only from the agentgateway namespace and restricting egress.", w.Kind, w.Name),
+				ResourceRef: ref,
+				Namespace:   w.Namespace,
+			})
 		}
 
 		// HDN-008: Plaintext secret env vars
@@ -1430,8 +1709,9 @@ func checkHardenedDeployment(state *ClusterState, policy Policy) []Finding {
 			})
 		}
 
-		// HDN-010: No image signature annotation
-		if !w.HasImageSignature {
+		// HDN-010: No image signature annotation. Superseded by cryptographic
+		// verification (HDN-016/017) when imageVerification is enabled.
+		if !w.HasImageSignature && !policy.ImageVerification.Enabled {
 			findings = append(findings, Finding{
 				ID:          fmt.Sprintf("HDN-010-%s", w.Name),
 				Severity:    SeverityMedium,
@@ -1444,6 +1724,97 @@ func checkHardenedDeployment(state *ClusterState, policy Policy) []Finding {
 				Namespace:   w.Namespace,
 			})
 		}
+
+		// HDN-011: Privileged containers
+		if len(w.PrivilegedContainers) > 0 {
+			findings = append(findings, Finding{
+				ID:          fmt.Sprintf("HDN-011-%s", w.Name),
+				Severity:    SeverityCritical,
+				Category:    CategoryHardening,
+				Title:       fmt.Sprintf("%s '%s' runs privileged containers", w.Kind, w.Name),
+				Description: fmt.Sprintf("Containers %v in %s '%s/%s' set securityContext.privileged: true.", w.PrivilegedContainers, w.Kind, w.Namespace, w.Name),
+				Impact:      "A privileged container has every capability and full access to host devices. Compromising the MCP server is equivalent to root on the node.",
+				Remediation: "Remove securityContext.privileged: true. Grant only the specific capabilities the container needs via capabilities.add.",
+				ResourceRef: ref,
+				Namespace:   w.Namespace,
+			})
+		}
+
+		// HDN-012: Host namespaces shared
+		var hostNamespaces []string
+		if w.HostNetwork {
+			hostNamespaces = append(hostNamespaces, "hostNetwork")
+		}
+		if w.HostPID {
+			hostNamespaces = append(hostNamespaces, "hostPID")
+		}
+		if w.HostIPC {
+			hostNamespaces = append(hostNamespaces, "hostIPC")
+		}
+		if len(hostNamespaces) > 0 {
+			findings = append(findings, Finding{
+				ID:          fmt.Sprintf("HDN-012-%s", w.Name),
+				Severity:    SeverityHigh,
+				Category:    CategoryHardening,
+				Title:       fmt.Sprintf("%s '%s' shares host namespaces: %s", w.Kind, w.Name, strings.Join(hostNamespaces, ", ")),
+				Description: fmt.Sprintf("The pod spec of %s '%s/%s' sets %s: true.", w.Kind, w.Namespace, w.Name, strings.Join(hostNamespaces, ", ")),
+				Impact:      "hostNetwork bypasses NetworkPolicy and exposes node-local services; hostPID and hostIPC let the container see and signal host processes and read their shared memory.",
+				Remediation: "Remove hostNetwork, hostPID and hostIPC from the pod spec. MCP servers should only be reachable through a Service behind agentgateway.",
+				ResourceRef: ref,
+				Namespace:   w.Namespace,
+			})
+		}
+
+		// HDN-013: hostPath volumes
+		if len(w.HostPathVolumes) > 0 {
+			findings = append(findings, Finding{
+				ID:          fmt.Sprintf("HDN-013-%s", w.Name),
+				Severity:    SeverityHigh,
+				Category:    CategoryHardening,
+				Title:       fmt.Sprintf("%s '%s' mounts hostPath volumes", w.Kind, w.Name),
+				Description: fmt.Sprintf("%s '%s/%s' mounts host directories: %v", w.Kind, w.Namespace, w.Name, w.HostPathVolumes),
+				Impact:      "Tools that read or write files can reach the node filesystem, including kubelet credentials, container runtime sockets and other pods' data.",
+				Remediation: "Replace hostPath volumes with emptyDir, ConfigMap, Secret or PersistentVolumeClaim volumes.",
+				ResourceRef: ref,
+				Namespace:   w.Namespace,
+			})
+		}
+
+		// HDN-014: Dangerous capabilities added
+		var dangerous []string
+		for _, c := range w.AddedCapabilities {
+			if dangerousCapabilities[strings.TrimPrefix(strings.ToUpper(c), "CAP_")] {
+				dangerous = append(dangerous, c)
+			}
+		}
+		if len(dangerous) > 0 {
+			findings = append(findings, Finding{
+				ID:          fmt.Sprintf("HDN-014-%s", w.Name),
+				Severity:    SeverityHigh,
+				Category:    CategoryHardening,
+				Title:       fmt.Sprintf("%s '%s' adds dangerous capabilities: %s", w.Kind, w.Name, strings.Join(dangerous, ", ")),
+				Description: fmt.Sprintf("Containers in %s '%s/%s' add Linux capabilities via securityContext.capabilities.add: %v", w.Kind, w.Namespace, w.Name, dangerous),
+				Impact:      "SYS_ADMIN and similar capabilities enable container escape and kernel tampering; NET_RAW and NET_ADMIN allow traffic sniffing and spoofing inside the cluster network.",
+				Remediation: "Remove these capabilities from capabilities.add. Drop ALL and add back only narrowly scoped capabilities such as NET_BIND_SERVICE.",
+				ResourceRef: ref,
+				Namespace:   w.Namespace,
+			})
+		}
+
+		// HDN-015: Missing CPU/memory limits
+		if len(w.ContainersWithoutLimits) > 0 {
+			findings = append(findings, Finding{
+				ID:          fmt.Sprintf("HDN-015-%s", w.Name),
+				Severity:    SeverityMedium,
+				Category:    CategoryHardening,
+				Title:       fmt.Sprintf("%s '%s' has containers without CPU/memory limits", w.Kind, w.Name),
+				Description: fmt.Sprintf("Containers %v in %s '%s/%s' do not set both resources.limits.cpu and resources.limits.memory.", w.ContainersWithoutLimits, w.Kind, w.Namespace, w.Name),
+				Impact:      "A runaway or abusive tool call can exhaust node CPU or memory, starving other MCP servers and workloads on the node (denial of service).",
+				Remediation: "Set resources.limits.cpu and resources.limits.memory on every container, sized for the MCP server's expected tool load.",
+				ResourceRef: ref,
+				Namespace:   w.Namespace,
+			})
+		}
 	}
 
 	// Check for ResourceQuota in MCP namespaces
@@ -1462,6 +1833,23 @@ func checkHardenedDeployment(state *ClusterState, policy Policy) []Finding {
 }
 
 // containsHost checks if a URL references a K8s service by name pattern
+// GatewayForURL returns the agentgateway Gateway whose Service the URL points
+// at, i.e. whether MCP traffic sent to the URL passes through agentgateway.
+func GatewayForURL(state *ClusterState, url string) (GatewayResource, bool) {
+	for _, gw := range state.Gateways {
+		if gw.GatewayClassName != "agentgateway" {
+			continue
+		}
+		// The URL should reference the agentgateway service
+		for _, svc := range state.Services {
+			if (svc.Name == "agentgateway" || svc.Name == gw.Name) && containsHost(url, svc.Name, svc.Namespace) {
+				return gw, true
+			}
+		}
+	}
+	return GatewayResource{}, false
+}
+
 func containsHost(url, svcName, svcNamespace string) bool {
 	patterns := []string{
 		fmt.Sprintf("%s.%s", svcName, svcNamespace),
@@ -1562,7 +1950,7 @@ func calculateCategoryScore(primaryCategory, secondaryCategory string, findings
 	// Partial compliance: infrastructure exists but has issues
 	penalty := 0
 	for _, f := range categoryFindings {
-		penalty += severityPenalty(f.Severity, policy.SeverityPenalties)
+		penalty += policy.ForNamespace(findingNamespace(f)).findingPenalty(f)
 	}
 
 	score := 100 - penalty
@@ -1765,7 +2153,7 @@ func aggregateBreakdownFromMCPViews(views []MCPServerView, policy Policy) ScoreB
 	return bd
 }
 
-func calculateNamespaceScores(state *ClusterState, findings []Finding, penalties SeverityPenalties) []NamespaceScore {
+func calculateNamespaceScores(state *ClusterState, findings []Finding, policy Policy) []NamespaceScore {
 	nsFindings := make(map[string][]Finding)
 	for _, f := range findings {
 		if f.Namespace != "" {
@@ -1777,8 +2165,9 @@ func calculateNamespaceScores(state *ClusterState, findings []Finding, penalties
 	for _, ns := range state.Namespaces {
 		score := 100
 		nf := nsFindings[ns]
+		nsPolicy := policy.ForNamespace(ns)
 		for _, f := range nf {
-			score -= severityPenalty(f.Severity, penalties)
+			score -= nsPolicy.findingPenalty(f)
 		}
 		if score < 0 {
 			score = 0
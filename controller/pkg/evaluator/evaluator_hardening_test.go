@@ -515,6 +515,64 @@ func TestCheckHardenedDeployment_NoImageSignature(t *testing.T) {
 	}
 }
 
+// TestCheckHardenedDeployment_PodSecurity tests HDN-011..015 findings
+func TestCheckHardenedDeployment_PodSecurity(t *testing.T) {
+	tests := []struct {
+		name         string
+		mutate       func(w *WorkloadResource)
+		wantID       string
+		wantSeverity string
+	}{
+		{"privileged", func(w *WorkloadResource) { w.PrivilegedContainers = []string{"app"} }, "HDN-011-pod-sec", SeverityCritical},
+		{"host namespaces", func(w *WorkloadResource) { w.HostNetwork, w.HostPID = true, true }, "HDN-012-pod-sec", SeverityHigh},
+		{"hostPath", func(w *WorkloadResource) { w.HostPathVolumes = []string{"docker=/var/run/docker.sock"} }, "HDN-013-pod-sec", SeverityHigh},
+		{"dangerous capability", func(w *WorkloadResource) { w.AddedCapabilities = []string{"NET_BIND_SERVICE", "CAP_SYS_ADMIN"} }, "HDN-014-pod-sec", SeverityHigh},
+		{"missing limits", func(w *WorkloadResource) { w.ContainersWithoutLimits = []string{"app"} }, "HDN-015-pod-sec", SeverityMedium},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := WorkloadResource{
+				Name:                          "pod-sec",
+				Namespace:                     "default",
+				Kind:                          "DaemonSet",
+				AllContainersNonRoot:          true,
+				AllContainersReadOnlyRootFS:   true,
+				AllContainersNoPrivEscalation: true,
+				AllContainersCapDropAll:       true,
+				SeccompProfileSet:             true,
+				HasVaultInjection:             true,
+				HasImageSignature:             true,
+			}
+			tt.mutate(&w)
+			state := &ClusterState{
+				Workloads:       []WorkloadResource{w},
+				NetworkPolicies: []NetworkPolicyResource{{Name: "all", Namespace: "default", PodSelectorLabels: map[string]string{}, HasIngressRules: true}},
+			}
+
+			findings := checkHardenedDeployment(state, Policy{RequireHardenedDeployment: true})
+
+			if len(findings) != 1 || findings[0].ID != tt.wantID {
+				t.Fatalf("findings = %v, want only %s", findings, tt.wantID)
+			}
+			if findings[0].Severity != tt.wantSeverity {
+				t.Errorf("%s should be %s, got %s", tt.wantID, tt.wantSeverity, findings[0].Severity)
+			}
+		})
+	}
+}
+
+// NET_BIND_SERVICE alone is not a dangerous capability
+func TestCheckHardenedDeployment_SafeCapability(t *testing.T) {
+	state := &ClusterState{
+		Workloads: []WorkloadResource{{Name: "web", Namespace: "default", Kind: "Deployment", AddedCapabilities: []string{"NET_BIND_SERVICE"}}},
+	}
+	for _, f := range checkHardenedDeployment(state, Policy{RequireHardenedDeployment: true}) {
+		if f.ID == "HDN-014-web" {
+			t.Errorf("unexpected %s: %s", f.ID, f.Title)
+		}
+	}
+}
+
 // TestCheckHardenedDeployment_FullyHardened tests no findings for a fully hardened deployment
 func TestCheckHardenedDeployment_FullyHardened(t *testing.T) {
 	state := &ClusterState{
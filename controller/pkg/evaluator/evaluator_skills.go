@@ -245,19 +245,16 @@ func scoreSkillCatalog(skill SkillCatalogResource, metaFindings, contentFindings
 	score := 100
 	penalty := 0
 
-	allFindings := append(metaFindings, contentFindings...)
+	// Honour spec.checkOverrides: drop disabled findings, apply severity and penalty overrides
+	var allFindings []Finding
+	for _, f := range append(append([]Finding{}, metaFindings...), contentFindings...) {
+		if f, ok := policy.overrideFinding(f); ok {
+			allFindings = append(allFindings, f)
+		}
+	}
 
 	for _, f := range allFindings {
-		switch f.Severity {
-		case SeverityCritical:
-			penalty += policy.SeverityPenalties.Critical
-		case SeverityHigh:
-			penalty += policy.SeverityPenalties.High
-		case SeverityMedium:
-			penalty += policy.SeverityPenalties.Medium
-		case SeverityLow:
-			penalty += policy.SeverityPenalties.Low
-		}
+		penalty += policy.findingPenalty(f)
 	}
 	score -= penalty
 	if score < 0 {
@@ -949,7 +949,10 @@ func TestCheckPromptGuard_NoPromptGuardPolicy(t *testing.T) {
 func TestCheckPromptGuard_Configured(t *testing.T) {
 	state := &ClusterState{
 		AgentgatewayPolicies: []AgentgatewayPolicyResource{
-			{Name: "p1", Namespace: "system", HasPromptGuard: true},
+			{Name: "p1", Namespace: "system", HasPromptGuard: true, PromptGuardRules: []PromptGuardRule{
+				{Phase: "request", Kind: "regex", Action: "Reject", Matches: []string{"ignore previous instructions"}},
+				{Phase: "response", Kind: "regex", Action: "Mask", Builtins: []string{"CreditCard"}},
+			}},
 		},
 	}
 	policy := defaultPolicy()
@@ -1019,7 +1022,9 @@ func TestCheckRateLimit_NotConfigured(t *testing.T) {
 func TestCheckRateLimit_Configured(t *testing.T) {
 	state := &ClusterState{
 		AgentgatewayPolicies: []AgentgatewayPolicyResource{
-			{Name: "p1", Namespace: "system", HasRateLimit: true},
+			{Name: "p1", Namespace: "system", HasRateLimit: true, RateLimits: []RateLimitRule{
+				{Type: "local", Requests: 100, Unit: "Minutes", Burst: 20},
+			}},
 		},
 	}
 	policy := defaultPolicy()
@@ -1387,7 +1392,7 @@ func TestCalculateNamespaceScores_ClusterWideFindingsExcluded(t *testing.T) {
 		{ID: "AGW-001", Severity: SeverityCritical, Namespace: ""}, // Cluster-wide, no namespace
 	}
 
-	scores := calculateNamespaceScores(state, findings, DefaultSeverityPenalties())
+	scores := calculateNamespaceScores(state, findings, Policy{SeverityPenalties: DefaultSeverityPenalties()})
 
 	if len(scores) != 1 {
 		t.Fatalf("len = %d, want 1", len(scores))
@@ -1407,7 +1412,7 @@ func TestCalculateNamespaceScores_NamespacedFindings(t *testing.T) {
 		{ID: "f2", Severity: SeverityCritical, Namespace: "ns1"},
 	}
 
-	scores := calculateNamespaceScores(state, findings, DefaultSeverityPenalties())
+	scores := calculateNamespaceScores(state, findings, Policy{SeverityPenalties: DefaultSeverityPenalties()})
 
 	var ns1Score, ns2Score NamespaceScore
 	for _, s := range scores {
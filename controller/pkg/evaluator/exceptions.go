@@ -0,0 +1,141 @@
+package evaluator
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// GovernanceExceptionResource holds a discovered GovernanceException CR — a
+// time-boxed waiver for findings in the exception's own namespace or, with
+// spec.clusterScoped, for findings on cluster-scoped resources.
+type GovernanceExceptionResource struct {
+	Name            string
+	Namespace       string
+	FindingIDPrefix string    // spec.findingIDPrefix — e.g. "HDN-003" or "CUSTOM-prod-max-tools"
+	ResourceRef     string    // spec.resourceRef — optional exact "Kind/ns/name" match
+	Justification   string    // spec.justification (required)
+	Approver        string    // spec.approver (required)
+	ExpiresAt       time.Time // spec.expiresAt (required)
+	ClusterScoped   bool      // spec.clusterScoped — waive findings without a namespace instead
+}
+
+// FindingWaiver is the waiver metadata attached to a waived finding.
+type FindingWaiver struct {
+	Exception     string `json:"exception"` // "namespace/name" of the GovernanceException
+	Justification string `json:"justification"`
+	Approver      string `json:"approver"`
+	ExpiresAt     string `json:"expiresAt"`
+}
+
+// WaivedFinding is a finding that matched an active GovernanceException.
+// Waived findings are excluded from scoring and MCP server views.
+type WaivedFinding struct {
+	Finding
+	Waiver FindingWaiver `json:"waiver"`
+}
+
+// isValid reports whether the exception carries the mandatory fields.
+func (e GovernanceExceptionResource) isValid() bool {
+	return e.FindingIDPrefix != "" && e.Justification != "" && e.Approver != "" && !e.ExpiresAt.IsZero()
+}
+
+// matches reports whether the exception waives the given finding. Findings
+// on cluster-scoped resources carry no namespace; only cluster-scoped
+// exceptions waive those, and they waive nothing else.
+func (e GovernanceExceptionResource) matches(f Finding) bool {
+	if !strings.HasPrefix(f.ID, e.FindingIDPrefix) {
+		return false
+	}
+	if e.ResourceRef != "" && f.ResourceRef != e.ResourceRef {
+		return false
+	}
+	if e.ClusterScoped {
+		return findingNamespace(f) == ""
+	}
+	return findingNamespace(f) == e.Namespace
+}
+
+// findingNamespace returns the namespace a finding belongs to, falling back to
+// the namespace segment of its "Kind/ns/name" resource ref.
+func findingNamespace(f Finding) string {
+	if f.Namespace != "" {
+		return f.Namespace
+	}
+	if parts := strings.Split(f.ResourceRef, "/"); len(parts) == 3 {
+		return parts[1]
+	}
+	return ""
+}
+
+// applyGovernanceExceptions splits findings into kept and waived using the
+// active exceptions in the cluster state. Expired or incomplete exceptions are
+// not applied; each one raises an EXC finding of its own.
+func applyGovernanceExceptions(state *ClusterState, findings []Finding, now time.Time) (kept []Finding, waived []WaivedFinding) {
+	if len(state.GovernanceExceptions) == 0 {
+		return findings, nil
+	}
+	ts := now.Format(time.RFC3339)
+
+	var active []GovernanceExceptionResource
+	var excFindings []Finding
+	for _, e := range state.GovernanceExceptions {
+		ref := fmt.Sprintf("GovernanceException/%s/%s", e.Namespace, e.Name)
+		switch {
+		case !e.isValid():
+			excFindings = append(excFindings, Finding{
+				ID:          fmt.Sprintf("EXC-002-%s-%s", e.Namespace, e.Name),
+				Severity:    SeverityLow,
+				Category:    CategoryGovernance,
+				Title:       fmt.Sprintf("GovernanceException '%s' is incomplete", e.Name),
+				Description: fmt.Sprintf("GovernanceException '%s/%s' is missing a finding ID prefix, justification, approver or expiry and is not applied.", e.Namespace, e.Name),
+				Impact:      "Findings the exception was meant to waive continue to count toward the governance score.",
+				Remediation: "Set spec.findingIDPrefix, spec.justification, spec.approver and spec.expiresAt on the exception.",
+				ResourceRef: ref,
+				Namespace:   e.Namespace,
+				Timestamp:   ts,
+			})
+		case !now.Before(e.ExpiresAt):
+			excFindings = append(excFindings, Finding{
+				ID:          fmt.Sprintf("EXC-001-%s-%s", e.Namespace, e.Name),
+				Severity:    SeverityMedium,
+				Category:    CategoryGovernance,
+				Title:       fmt.Sprintf("GovernanceException '%s' has expired", e.Name),
+				Description: fmt.Sprintf("GovernanceException '%s/%s' (approved by %s) expired at %s. Findings matching '%s' are no longer waived.", e.Namespace, e.Name, e.Approver, e.ExpiresAt.Format(time.RFC3339), e.FindingIDPrefix),
+				Impact:      "The previously accepted risk is back in scope and counts toward the governance score again.",
+				Remediation: "Remediate the waived findings, or renew the exception with a fresh justification, approver and expiry.",
+				ResourceRef: ref,
+				Namespace:   e.Namespace,
+				Timestamp:   ts,
+			})
+		default:
+			active = append(active, e)
+		}
+	}
+
+	for _, f := range findings {
+		waivedBy := -1
+		for i, e := range active {
+			if e.matches(f) {
+				waivedBy = i
+				break
+			}
+		}
+		if waivedBy < 0 {
+			kept = append(kept, f)
+			continue
+		}
+		e := active[waivedBy]
+		waived = append(waived, WaivedFinding{
+			Finding: f,
+			Waiver: FindingWaiver{
+				Exception:     fmt.Sprintf("%s/%s", e.Namespace, e.Name),
+				Justification: e.Justification,
+				Approver:      e.Approver,
+				ExpiresAt:     e.ExpiresAt.Format(time.RFC3339),
+			},
+		})
+	}
+
+	return append(kept, excFindings...), waived
+}
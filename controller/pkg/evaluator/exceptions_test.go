@@ -0,0 +1,173 @@
+package evaluator
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func activeException() GovernanceExceptionResource {
+	return GovernanceExceptionResource{
+		Name:            "legacy-image",
+		Namespace:       "mcp",
+		FindingIDPrefix: "HDN-003",
+		Justification:   "vendor image, fix tracked upstream",
+		Approver:        "secops",
+		ExpiresAt:       time.Now().Add(24 * time.Hour),
+	}
+}
+
+func TestApplyGovernanceExceptions_NoExceptions(t *testing.T) {
+	findings := []Finding{{ID: "HDN-003-a", Namespace: "mcp"}}
+	kept, waived := applyGovernanceExceptions(emptyState(), findings, time.Now())
+	if len(kept) != 1 || len(waived) != 0 {
+		t.Errorf("kept=%d waived=%d, want 1/0", len(kept), len(waived))
+	}
+}
+
+func TestApplyGovernanceExceptions_WaivesByPrefixAndNamespace(t *testing.T) {
+	state := emptyState()
+	state.GovernanceExceptions = []GovernanceExceptionResource{activeException()}
+	findings := []Finding{
+		{ID: "HDN-003-a", Namespace: "mcp"},
+		{ID: "HDN-003-b", Namespace: "other"},              // wrong namespace
+		{ID: "HDN-001-a", Namespace: "mcp"},                // wrong prefix
+		{ID: "HDN-003-c", ResourceRef: "Deployment/mcp/c"}, // namespace from resourceRef
+	}
+
+	kept, waived := applyGovernanceExceptions(state, findings, time.Now())
+
+	if len(waived) != 2 {
+		t.Fatalf("waived = %d, want 2", len(waived))
+	}
+	if waived[0].ID != "HDN-003-a" || waived[1].ID != "HDN-003-c" {
+		t.Errorf("waived IDs = %s, %s", waived[0].ID, waived[1].ID)
+	}
+	if waived[0].Waiver.Exception != "mcp/legacy-image" || waived[0].Waiver.Approver != "secops" {
+		t.Errorf("waiver metadata = %+v", waived[0].Waiver)
+	}
+	if len(kept) != 2 {
+		t.Errorf("kept = %d, want 2", len(kept))
+	}
+}
+
+func TestApplyGovernanceExceptions_ResourceRefRestricts(t *testing.T) {
+	exc := activeException()
+	exc.ResourceRef = "Deployment/mcp/a"
+	state := emptyState()
+	state.GovernanceExceptions = []GovernanceExceptionResource{exc}
+	findings := []Finding{
+		{ID: "HDN-003-a", Namespace: "mcp", ResourceRef: "Deployment/mcp/a"},
+		{ID: "HDN-003-b", Namespace: "mcp", ResourceRef: "Deployment/mcp/b"},
+	}
+
+	_, waived := applyGovernanceExceptions(state, findings, time.Now())
+
+	if len(waived) != 1 || waived[0].ID != "HDN-003-a" {
+		t.Errorf("Expected only HDN-003-a to be waived, got %+v", waived)
+	}
+}
+
+func TestApplyGovernanceExceptions_ClusterScoped(t *testing.T) {
+	findings := []Finding{
+		{ID: "HDN-003-node", ResourceRef: "ClusterRole/node-reader"}, // no namespace
+		{ID: "HDN-003-a", Namespace: "mcp"},
+	}
+	for _, tt := range []struct {
+		clusterScoped bool
+		wantWaived    []string
+	}{
+		{false, []string{"HDN-003-a"}},
+		{true, []string{"HDN-003-node"}},
+	} {
+		// Discovery always fills the namespace of the namespaced CR.
+		exc := activeException()
+		exc.Namespace = "mcp"
+		exc.ClusterScoped = tt.clusterScoped
+		state := emptyState()
+		state.GovernanceExceptions = []GovernanceExceptionResource{exc}
+
+		_, waived := applyGovernanceExceptions(state, findings, time.Now())
+
+		var ids []string
+		for _, w := range waived {
+			ids = append(ids, w.ID)
+		}
+		if strings.Join(ids, ",") != strings.Join(tt.wantWaived, ",") {
+			t.Errorf("clusterScoped %v: waived = %v, want %v", tt.clusterScoped, ids, tt.wantWaived)
+		}
+	}
+}
+
+func TestApplyGovernanceExceptions_Expired(t *testing.T) {
+	exc := activeException()
+	exc.ExpiresAt = time.Now().Add(-time.Hour)
+	state := emptyState()
+	state.GovernanceExceptions = []GovernanceExceptionResource{exc}
+	findings := []Finding{{ID: "HDN-003-a", Namespace: "mcp"}}
+
+	kept, waived := applyGovernanceExceptions(state, findings, time.Now())
+
+	if len(waived) != 0 {
+		t.Errorf("Expired exception should not waive, got %d waived", len(waived))
+	}
+	hasExpired := false
+	for _, f := range kept {
+		if f.ID == "EXC-001-mcp-legacy-image" {
+			hasExpired = true
+			if f.Severity != SeverityMedium {
+				t.Errorf("EXC-001 severity = %s, want Medium", f.Severity)
+			}
+		}
+	}
+	if !hasExpired {
+		t.Error("Expected EXC-001 finding for expired exception")
+	}
+}
+
+func TestApplyGovernanceExceptions_Incomplete(t *testing.T) {
+	exc := activeException()
+	exc.Approver = ""
+	state := emptyState()
+	state.GovernanceExceptions = []GovernanceExceptionResource{exc}
+
+	kept, waived := applyGovernanceExceptions(state, []Finding{{ID: "HDN-003-a", Namespace: "mcp"}}, time.Now())
+
+	if len(waived) != 0 {
+		t.Error("Incomplete exception should not waive findings")
+	}
+	if len(kept) != 2 || kept[1].ID != "EXC-002-mcp-legacy-image" {
+		t.Errorf("Expected EXC-002 finding, got %+v", kept)
+	}
+}
+
+func TestEvaluate_WaivedFindingsExcludedFromScoring(t *testing.T) {
+	state := fullCompliantState()
+	state.Workloads = append(state.Workloads, WorkloadResource{
+		Name: "my-mcp", Namespace: "mcp-system", Kind: "Deployment",
+		RunAsNonRoot: true, AllContainersNonRoot: true, AllContainersNoPrivEscalation: true,
+		AllContainersCapDropAll: true, SeccompProfileSet: true,
+		AllContainersReadOnlyRootFS: false,
+	})
+	policy := defaultPolicy()
+
+	before := Evaluate(state, policy)
+
+	state.GovernanceExceptions = []GovernanceExceptionResource{{
+		Name: "ro-fs", Namespace: "mcp-system", FindingIDPrefix: "HDN-",
+		Justification: "legacy", Approver: "secops", ExpiresAt: time.Now().Add(time.Hour),
+	}}
+	after := Evaluate(state, policy)
+
+	if len(after.WaivedFindings) == 0 {
+		t.Fatal("Expected HDN findings in mcp-system to be waived")
+	}
+	for _, f := range after.Findings {
+		if f.Namespace == "mcp-system" && f.Category == CategoryHardening {
+			t.Errorf("Waived finding %s still in findings list", f.ID)
+		}
+	}
+	if after.Score < before.Score {
+		t.Errorf("Score should not drop when findings are waived: before=%d after=%d", before.Score, after.Score)
+	}
+}
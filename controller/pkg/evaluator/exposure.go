@@ -0,0 +1,232 @@
+package evaluator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// IngressResource holds the routing fields of a networking.k8s.io/v1 Ingress.
+type IngressResource struct {
+	Name             string
+	Namespace        string
+	IngressClassName string
+	Rules            []IngressRule // one entry per host/path/backend, plus the default backend (empty Host and Path)
+	Addresses        []string      // status.loadBalancer.ingress IPs and hostnames
+	HasTLS           bool
+}
+
+// IngressRule is one host/path of an Ingress and the Service it forwards to.
+type IngressRule struct {
+	Host        string
+	Path        string
+	ServiceName string
+	ServicePort int
+}
+
+// RouteResource holds the routing fields of an OpenShift route.openshift.io/v1 Route.
+type RouteResource struct {
+	Name      string
+	Namespace string
+	Host      string
+	Path      string
+	Services  []string // spec.to plus spec.alternateBackends of kind Service
+	HasTLS    bool
+}
+
+// GatewayBypass is one path into an MCP server workload that does not
+// traverse an agentgateway Gateway.
+type GatewayBypass struct {
+	Kind      string   `json:"kind"` // "Service", "Ingress", "Route" or "HTTPRoute"
+	Name      string   `json:"name"`
+	Namespace string   `json:"namespace"`
+	Via       string   `json:"via"`               // "LoadBalancer", "NodePort", "externalIPs", "Ingress", "Route" or "HTTPRoute"
+	Service   string   `json:"service"`           // the Service selecting the MCP workload
+	Hosts     []string `json:"hosts"`             // external hostnames, addresses or node ports
+	Gateway   string   `json:"gateway,omitempty"` // ns/name of the non-agentgateway Gateway (HTTPRoute only)
+}
+
+// workloadServices returns the Services in the workload's namespace whose
+// selector matches its pod labels. Selector-less Services (manual Endpoints)
+// are not attributed to any workload.
+func workloadServices(state *ClusterState, w WorkloadResource) []ServiceResource {
+	var out []ServiceResource
+	for _, svc := range state.Services {
+		if svc.Namespace != w.Namespace || len(svc.Selector) == 0 {
+			continue
+		}
+		sel := &LabelSelector{MatchLabels: svc.Selector}
+		if sel.Matches(w.PodLabels) {
+			out = append(out, svc)
+		}
+	}
+	return out
+}
+
+// workloadGatewayBypasses lists every path into the workload that reaches
+// one of its Services without passing through an agentgateway Gateway:
+// LoadBalancer, NodePort and externalIPs Services, Ingresses, OpenShift
+// Routes and HTTPRoutes attached to Gateways of another class.
+func workloadGatewayBypasses(state *ClusterState, w WorkloadResource) []GatewayBypass {
+	var out []GatewayBypass
+	for _, svc := range workloadServices(state, w) {
+		switch svc.Type {
+		case "LoadBalancer":
+			hosts := append(append([]string{}, svc.LoadBalancerAddresses...), svc.ExternalIPs...)
+			if len(hosts) == 0 {
+				hosts = []string{"<pending>"}
+			}
+			out = append(out, GatewayBypass{Kind: "Service", Name: svc.Name, Namespace: svc.Namespace, Via: "LoadBalancer", Service: svc.Name, Hosts: hosts})
+		case "NodePort":
+			var hosts []string
+			for _, p := range svc.NodePorts {
+				hosts = append(hosts, fmt.Sprintf("<node>:%d", p))
+			}
+			out = append(out, GatewayBypass{Kind: "Service", Name: svc.Name, Namespace: svc.Namespace, Via: "NodePort", Service: svc.Name, Hosts: hosts})
+		}
+		if len(svc.ExternalIPs) > 0 && svc.Type != "LoadBalancer" {
+			out = append(out, GatewayBypass{Kind: "Service", Name: svc.Name, Namespace: svc.Namespace, Via: "externalIPs", Service: svc.Name, Hosts: svc.ExternalIPs})
+		}
+
+		for _, ing := range state.Ingresses {
+			if ing.Namespace != svc.Namespace {
+				continue
+			}
+			var hosts []string
+			matched := false
+			for _, r := range ing.Rules {
+				if r.ServiceName != svc.Name {
+					continue
+				}
+				matched = true
+				if r.Host != "" {
+					hosts = appendUnique(hosts, r.Host)
+				}
+			}
+			if !matched {
+				continue
+			}
+			if len(hosts) == 0 {
+				hosts = append([]string{"*"}, ing.Addresses...)
+			}
+			out = append(out, GatewayBypass{Kind: "Ingress", Name: ing.Name, Namespace: ing.Namespace, Via: "Ingress", Service: svc.Name, Hosts: hosts})
+		}
+
+		for _, rt := range state.Routes {
+			if rt.Namespace != svc.Namespace || !containsString(rt.Services, svc.Name) {
+				continue
+			}
+			host := rt.Host
+			if host == "" {
+				host = "<generated>"
+			}
+			out = append(out, GatewayBypass{Kind: "Route", Name: rt.Name, Namespace: rt.Namespace, Via: "Route", Service: svc.Name, Hosts: []string{host + rt.Path}})
+		}
+
+		for _, hr := range state.HTTPRoutes {
+			if hr.Namespace != svc.Namespace || !containsString(hr.ServiceBackendRefs, svc.Name) {
+				continue
+			}
+			gw, ok := routeParentGateway(state, hr)
+			if !ok || gw.GatewayClassName == "agentgateway" {
+				continue
+			}
+			hosts := hr.Hostnames
+			if len(hosts) == 0 {
+				hosts = []string{"*"}
+			}
+			out = append(out, GatewayBypass{Kind: "HTTPRoute", Name: hr.Name, Namespace: hr.Namespace, Via: "HTTPRoute", Service: svc.Name, Hosts: hosts,
+				Gateway: gw.Namespace + "/" + gw.Name})
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Kind != out[j].Kind {
+			return out[i].Kind < out[j].Kind
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
+
+// routeParentGateway resolves the Gateway an HTTPRoute attaches to.
+func routeParentGateway(state *ClusterState, hr HTTPRouteResource) (GatewayResource, bool) {
+	if hr.ParentGateway == "" {
+		return GatewayResource{}, false
+	}
+	ns := hr.ParentGatewayNamespace
+	if ns == "" {
+		ns = hr.Namespace
+	}
+	for _, gw := range state.Gateways {
+		if gw.Name == hr.ParentGateway && gw.Namespace == ns {
+			return gw, true
+		}
+	}
+	return GatewayResource{}, false
+}
+
+// isGatewayBypassFinding reports whether a finding ID is one of the
+// EXP-002..006 gateway bypass checks.
+func isGatewayBypassFinding(id string) bool {
+	for _, code := range []string{"EXP-002-", "EXP-003-", "EXP-004-", "EXP-005-", "EXP-006-"} {
+		if strings.HasPrefix(id, code) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkGatewayBypass flags Services, Ingresses, Routes and HTTPRoutes that
+// expose MCP server workloads without traversing agentgateway (EXP-002..006).
+func checkGatewayBypass(state *ClusterState, policy Policy) []Finding {
+	var findings []Finding
+	if !policy.RequireAgentGateway {
+		return findings
+	}
+	ts := time.Now().Format(time.RFC3339)
+
+	for _, w := range mcpWorkloads(state) {
+		for _, b := range workloadGatewayBypasses(state, w) {
+			hosts := strings.Join(b.Hosts, ", ")
+			f := Finding{
+				Category:  CategoryExposure,
+				Severity:  SeverityCritical,
+				Namespace: b.Namespace,
+				Timestamp: ts,
+				Impact:    "Clients reaching this endpoint talk to the MCP server directly — agentgateway authentication, authorization, rate limiting and prompt guards are not applied.",
+			}
+			switch {
+			case b.Kind == "Service" && b.Via == "NodePort":
+				f.ID = fmt.Sprintf("EXP-003-%s-%s", w.Name, b.Name)
+				f.Severity = SeverityHigh
+				f.Title = fmt.Sprintf("MCP server '%s' exposed by NodePort Service '%s'", w.Name, b.Name)
+				f.Description = fmt.Sprintf("Service '%s/%s' selects the pods of %s '%s' and is of type NodePort (%s), so every node forwards traffic to the MCP server without passing through agentgateway.", b.Namespace, b.Name, w.Kind, w.Name, hosts)
+				f.Remediation = "Change the Service to type ClusterIP and expose the MCP server only through an agentgateway Gateway, HTTPRoute and AgentgatewayBackend."
+			case b.Kind == "Service":
+				f.ID = fmt.Sprintf("EXP-002-%s-%s", w.Name, b.Name)
+				f.Title = fmt.Sprintf("MCP server '%s' exposed by %s Service '%s'", w.Name, b.Via, b.Name)
+				f.Description = fmt.Sprintf("Service '%s/%s' selects the pods of %s '%s' and is reachable at %s (%s) without passing through agentgateway.", b.Namespace, b.Name, w.Kind, w.Name, hosts, b.Via)
+				f.Remediation = "Change the Service to type ClusterIP without externalIPs and expose the MCP server only through an agentgateway Gateway, HTTPRoute and AgentgatewayBackend."
+			case b.Kind == "Ingress":
+				f.ID = fmt.Sprintf("EXP-004-%s-%s", w.Name, b.Name)
+				f.Title = fmt.Sprintf("MCP server '%s' exposed by Ingress '%s'", w.Name, b.Name)
+				f.Description = fmt.Sprintf("Ingress '%s/%s' routes %s to Service '%s', which selects the pods of %s '%s', bypassing agentgateway.", b.Namespace, b.Name, hosts, b.Service, w.Kind, w.Name)
+				f.Remediation = "Remove the Ingress rule for the MCP server Service and publish it through an agentgateway Gateway and HTTPRoute instead."
+			case b.Kind == "Route":
+				f.ID = fmt.Sprintf("EXP-005-%s-%s", w.Name, b.Name)
+				f.Title = fmt.Sprintf("MCP server '%s' exposed by OpenShift Route '%s'", w.Name, b.Name)
+				f.Description = fmt.Sprintf("Route '%s/%s' publishes %s to Service '%s', which selects the pods of %s '%s', bypassing agentgateway.", b.Namespace, b.Name, hosts, b.Service, w.Kind, w.Name)
+				f.Remediation = "Delete the Route for the MCP server Service, or point it at the agentgateway Service instead."
+			default:
+				f.ID = fmt.Sprintf("EXP-006-%s-%s", w.Name, b.Name)
+				f.Title = fmt.Sprintf("MCP server '%s' exposed by HTTPRoute '%s' on a non-agentgateway Gateway", w.Name, b.Name)
+				f.Description = fmt.Sprintf("HTTPRoute '%s/%s' attaches to Gateway '%s', which is not of the agentgateway class, and routes %s to Service '%s' backing %s '%s'.", b.Namespace, b.Name, b.Gateway, hosts, b.Service, w.Kind, w.Name)
+				f.Remediation = "Attach the HTTPRoute to an agentgateway-class Gateway and route to an AgentgatewayBackend instead of the Service."
+			}
+			f.ResourceRef = fmt.Sprintf("%s/%s/%s", b.Kind, b.Namespace, b.Name)
+			findings = append(findings, f)
+		}
+	}
+	return findings
+}
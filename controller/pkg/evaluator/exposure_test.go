@@ -0,0 +1,149 @@
+package evaluator
+
+import (
+	"strings"
+	"testing"
+)
+
+func bypassState() *ClusterState {
+	return &ClusterState{
+		Namespaces: []string{"agentgateway-system", "mcp"},
+		Gateways: []GatewayResource{
+			{Name: "agentgateway", Namespace: "agentgateway-system", GatewayClassName: "agentgateway"},
+			{Name: "public", Namespace: "mcp", GatewayClassName: "istio"},
+		},
+		KagentMCPServers: []KagentMCPServerResource{{Name: "tools", Namespace: "mcp", Port: 8080}},
+		Workloads: []WorkloadResource{
+			{Name: "tools", Namespace: "mcp", Kind: "Deployment", PodLabels: map[string]string{"app": "tools"}},
+		},
+		Services: []ServiceResource{
+			{Name: "tools", Namespace: "mcp", Type: "ClusterIP", Selector: map[string]string{"app": "tools"}},
+		},
+	}
+}
+
+func bypassFindings(state *ClusterState) map[string]Finding {
+	out := map[string]Finding{}
+	for _, f := range checkGatewayBypass(state, defaultPolicy()) {
+		out[f.ID] = f
+	}
+	return out
+}
+
+func TestCheckGatewayBypass(t *testing.T) {
+	tests := []struct {
+		name         string
+		mutate       func(s *ClusterState)
+		wantID       string
+		wantSeverity string
+		wantHost     string
+	}{
+		{
+			name: "LoadBalancer service",
+			mutate: func(s *ClusterState) {
+				s.Services[0].Type = "LoadBalancer"
+				s.Services[0].LoadBalancerAddresses = []string{"tools.elb.example.com"}
+			},
+			wantID: "EXP-002-tools-tools", wantSeverity: SeverityCritical, wantHost: "tools.elb.example.com",
+		},
+		{
+			name:   "externalIPs",
+			mutate: func(s *ClusterState) { s.Services[0].ExternalIPs = []string{"203.0.113.7"} },
+			wantID: "EXP-002-tools-tools", wantSeverity: SeverityCritical, wantHost: "203.0.113.7",
+		},
+		{
+			name: "NodePort service",
+			mutate: func(s *ClusterState) {
+				s.Services = append(s.Services, ServiceResource{Name: "tools-np", Namespace: "mcp", Type: "NodePort",
+					Selector: map[string]string{"app": "tools"}, NodePorts: []int{30080}})
+			},
+			wantID: "EXP-003-tools-tools-np", wantSeverity: SeverityHigh, wantHost: "30080",
+		},
+		{
+			name: "Ingress",
+			mutate: func(s *ClusterState) {
+				s.Ingresses = []IngressResource{{Name: "tools", Namespace: "mcp",
+					Rules: []IngressRule{{Host: "mcp.example.com", Path: "/", ServiceName: "tools", ServicePort: 8080}}}}
+			},
+			wantID: "EXP-004-tools-tools", wantSeverity: SeverityCritical, wantHost: "mcp.example.com",
+		},
+		{
+			name: "OpenShift Route",
+			mutate: func(s *ClusterState) {
+				s.Routes = []RouteResource{{Name: "tools", Namespace: "mcp", Host: "tools-mcp.apps.example.com", Services: []string{"tools"}}}
+			},
+			wantID: "EXP-005-tools-tools", wantSeverity: SeverityCritical, wantHost: "tools-mcp.apps.example.com",
+		},
+		{
+			name: "HTTPRoute on another gateway class",
+			mutate: func(s *ClusterState) {
+				s.HTTPRoutes = []HTTPRouteResource{{Name: "tools", Namespace: "mcp", ParentGateway: "public",
+					Hostnames: []string{"tools.example.com"}, BackendRefs: []string{"tools"}, ServiceBackendRefs: []string{"tools"}}}
+			},
+			wantID: "EXP-006-tools-tools", wantSeverity: SeverityCritical, wantHost: "tools.example.com",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := bypassState()
+			tt.mutate(state)
+			findings := bypassFindings(state)
+			f, ok := findings[tt.wantID]
+			if !ok || len(findings) != 1 {
+				t.Fatalf("findings = %v, want only %s", findings, tt.wantID)
+			}
+			if f.Severity != tt.wantSeverity || f.Category != CategoryExposure {
+				t.Errorf("finding = %+v", f)
+			}
+			if !strings.Contains(f.Description, tt.wantHost) {
+				t.Errorf("description %q does not name %q", f.Description, tt.wantHost)
+			}
+		})
+	}
+}
+
+func TestCheckGatewayBypass_NotFlagged(t *testing.T) {
+	state := bypassState()
+	// Routes through agentgateway and exposures of unrelated pods are fine.
+	state.HTTPRoutes = []HTTPRouteResource{{Name: "tools", Namespace: "mcp", ParentGateway: "agentgateway",
+		ParentGatewayNamespace: "agentgateway-system", BackendRefs: []string{"tools"}, ServiceBackendRefs: []string{"tools"}}}
+	state.Services = append(state.Services, ServiceResource{Name: "web", Namespace: "mcp", Type: "LoadBalancer",
+		Selector: map[string]string{"app": "web"}})
+	state.Ingresses = []IngressResource{{Name: "web", Namespace: "mcp", Rules: []IngressRule{{Host: "web.example.com", ServiceName: "web"}}}}
+	if f := bypassFindings(state); len(f) != 0 {
+		t.Errorf("findings = %v, want none", f)
+	}
+
+	state.Services[0].Type = "LoadBalancer"
+	policy := defaultPolicy()
+	policy.RequireAgentGateway = false
+	if f := checkGatewayBypass(state, policy); len(f) != 0 {
+		t.Errorf("gateway not required: findings = %v", f)
+	}
+}
+
+func TestBuildMCPServerViews_GatewayBypasses(t *testing.T) {
+	state := bypassState()
+	state.Ingresses = []IngressResource{{Name: "tools", Namespace: "mcp",
+		Rules: []IngressRule{{Host: "mcp.example.com", ServiceName: "tools"}}}}
+	policy := defaultPolicy()
+
+	views := BuildMCPServerViews(state, checkExposure(state, policy), policy)
+	if len(views) != 1 || len(views[0].GatewayBypasses) != 1 {
+		t.Fatalf("views = %+v", views)
+	}
+	b := views[0].GatewayBypasses[0]
+	if b.Kind != "Ingress" || b.Service != "tools" || len(b.Hosts) != 1 || b.Hosts[0] != "mcp.example.com" {
+		t.Errorf("bypass = %+v", b)
+	}
+	if views[0].ScoreBreakdown.GatewayRouting != 0 {
+		t.Errorf("unrouted server with an Ingress: GatewayRouting = %d, want 0", views[0].ScoreBreakdown.GatewayRouting)
+	}
+	found := false
+	for _, f := range views[0].Findings {
+		found = found || f.ID == "EXP-004-tools-tools"
+	}
+	if !found {
+		t.Errorf("EXP-004 not attributed to the server: %v", views[0].Findings)
+	}
+}
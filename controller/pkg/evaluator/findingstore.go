@@ -0,0 +1,229 @@
+package evaluator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Finding lifecycle states reported by the FindingStore.
+const (
+	FindingStatusOpen     = "open"
+	FindingStatusWaived   = "waived"
+	FindingStatusResolved = "resolved"
+)
+
+// DefaultFindingRetention is how long resolved findings are kept in the store.
+const DefaultFindingRetention = 7 * 24 * time.Hour
+
+// FindingCheckID returns the check part of a finding ID: the ID with the
+// trailing resource name removed, e.g. "TLS-003-backend" → "TLS-003" and
+// "CUSTOM-prod-max-tools-big" → "CUSTOM-prod-max-tools".
+func FindingCheckID(f Finding) string {
+	if f.ResourceRef == "" {
+		return f.ID
+	}
+	name := f.ResourceRef[strings.LastIndex(f.ResourceRef, "/")+1:]
+	if name != "" && strings.HasSuffix(f.ID, "-"+name) {
+		return strings.TrimSuffix(f.ID, "-"+name)
+	}
+	return f.ID
+}
+
+// FindingFingerprint returns a stable identifier for a finding derived from its
+// check ID and resource ref. It is unaffected by timestamps, titles or
+// descriptions, so the same issue keeps its fingerprint across scans.
+func FindingFingerprint(f Finding) string {
+	sum := sha256.Sum256([]byte(FindingCheckID(f) + "\x00" + f.ResourceRef))
+	return hex.EncodeToString(sum[:8])
+}
+
+// assignFingerprints sets the Fingerprint of every finding in place.
+func assignFingerprints(findings []Finding) {
+	for i := range findings {
+		findings[i].Fingerprint = FindingFingerprint(findings[i])
+	}
+}
+
+// TrackedFinding is a finding together with its lifecycle across scans.
+type TrackedFinding struct {
+	Finding
+	Status      string     `json:"status"`
+	FirstSeen   time.Time  `json:"firstSeen"`
+	LastSeen    time.Time  `json:"lastSeen"`
+	ResolvedAt  *time.Time `json:"resolvedAt,omitempty"`
+	Occurrences int        `json:"occurrences"`
+}
+
+// trackedRecord is the store's internal bookkeeping for one fingerprint.
+type trackedRecord struct {
+	TrackedFinding
+	openedScan   int // scan in which the finding was first seen or last reopened
+	resolvedScan int // scan in which the finding was last resolved
+}
+
+// FindingStore records the lifecycle of findings across evaluations, keyed by
+// fingerprint. It is safe for concurrent use.
+type FindingStore struct {
+	mu        sync.RWMutex
+	records   map[string]*trackedRecord
+	scan      int
+	lastScan  time.Time
+	retention time.Duration
+}
+
+// NewFindingStore creates an empty store. Resolved findings older than
+// retention are pruned; a retention of 0 uses DefaultFindingRetention.
+func NewFindingStore(retention time.Duration) *FindingStore {
+	if retention <= 0 {
+		retention = DefaultFindingRetention
+	}
+	return &FindingStore{records: map[string]*trackedRecord{}, retention: retention}
+}
+
+// Record folds an evaluation result into the store. Findings and waived
+// findings are marked as seen; previously open findings that are absent from
+// the result are marked resolved.
+func (s *FindingStore) Record(result *EvaluationResult) {
+	if result == nil {
+		return
+	}
+	now := result.Timestamp
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.scan++
+	s.lastScan = now
+
+	seen := make(map[string]bool, len(result.Findings)+len(result.WaivedFindings))
+	observe := func(f Finding, status string) {
+		fp := f.Fingerprint
+		if fp == "" {
+			fp = FindingFingerprint(f)
+			f.Fingerprint = fp
+		}
+		if seen[fp] {
+			return
+		}
+		seen[fp] = true
+
+		rec, ok := s.records[fp]
+		if !ok {
+			rec = &trackedRecord{TrackedFinding: TrackedFinding{FirstSeen: now}, openedScan: s.scan}
+			s.records[fp] = rec
+		} else if rec.Status == FindingStatusResolved {
+			rec.ResolvedAt = nil
+			rec.openedScan = s.scan
+		}
+		rec.Finding = f
+		rec.Status = status
+		rec.LastSeen = now
+		rec.Occurrences++
+	}
+	for _, f := range result.Findings {
+		observe(f, FindingStatusOpen)
+	}
+	for _, wf := range result.WaivedFindings {
+		observe(wf.Finding, FindingStatusWaived)
+	}
+
+	for fp, rec := range s.records {
+		if seen[fp] {
+			continue
+		}
+		if rec.Status != FindingStatusResolved {
+			resolvedAt := now
+			rec.Status = FindingStatusResolved
+			rec.ResolvedAt = &resolvedAt
+			rec.resolvedScan = s.scan
+			continue
+		}
+		if rec.ResolvedAt != nil && now.Sub(*rec.ResolvedAt) > s.retention {
+			delete(s.records, fp)
+		}
+	}
+}
+
+// LastScan returns the time of the most recently recorded evaluation.
+func (s *FindingStore) LastScan() time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.lastScan
+}
+
+// Track attaches lifecycle data to the given findings. Findings the store has
+// not seen yet are returned as open with no history.
+func (s *FindingStore) Track(findings []Finding) []TrackedFinding {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]TrackedFinding, 0, len(findings))
+	for _, f := range findings {
+		fp := f.Fingerprint
+		if fp == "" {
+			fp = FindingFingerprint(f)
+		}
+		if rec, ok := s.records[fp]; ok {
+			tf := rec.TrackedFinding
+			tf.Finding = f
+			tf.Fingerprint = fp
+			out = append(out, tf)
+			continue
+		}
+		f.Fingerprint = fp
+		out = append(out, TrackedFinding{Finding: f, Status: FindingStatusOpen})
+	}
+	return out
+}
+
+// NewSinceLastScan returns findings that first appeared, or reappeared after
+// being resolved, in the most recent scan.
+func (s *FindingStore) NewSinceLastScan() []TrackedFinding {
+	return s.filter(func(r *trackedRecord) bool {
+		return r.Status != FindingStatusResolved && r.openedScan == s.scan
+	})
+}
+
+// ResolvedSinceLastScan returns findings that disappeared in the most recent scan.
+func (s *FindingStore) ResolvedSinceLastScan() []TrackedFinding {
+	return s.filter(func(r *trackedRecord) bool {
+		return r.Status == FindingStatusResolved && r.resolvedScan == s.scan
+	})
+}
+
+// Lookup returns the lifecycle of a single fingerprint.
+func (s *FindingStore) Lookup(fingerprint string) (TrackedFinding, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rec, ok := s.records[fingerprint]
+	if !ok {
+		return TrackedFinding{}, false
+	}
+	return rec.TrackedFinding, true
+}
+
+func (s *FindingStore) filter(keep func(*trackedRecord) bool) []TrackedFinding {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := []TrackedFinding{}
+	if s.scan == 0 {
+		return out
+	}
+	for _, rec := range s.records {
+		if keep(rec) {
+			out = append(out, rec.TrackedFinding)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].ID != out[j].ID {
+			return out[i].ID < out[j].ID
+		}
+		return out[i].ResourceRef < out[j].ResourceRef
+	})
+	return out
+}
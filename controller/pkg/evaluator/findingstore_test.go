@@ -0,0 +1,111 @@
+package evaluator
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFindingCheckID(t *testing.T) {
+	cases := []struct {
+		f    Finding
+		want string
+	}{
+		{Finding{ID: "TLS-001-backend", ResourceRef: "AgentgatewayBackend/ns/backend"}, "TLS-001"},
+		{Finding{ID: "CUSTOM-prod-max-tools-big", ResourceRef: "RemoteMCPServer/prod/big"}, "CUSTOM-prod-max-tools"},
+		{Finding{ID: "AGW-200-agent-tool", ResourceRef: "Agent/ns/agent"}, "AGW-200-agent-tool"},
+		{Finding{ID: "AGW-001"}, "AGW-001"},
+	}
+	for _, c := range cases {
+		if got := FindingCheckID(c.f); got != c.want {
+			t.Errorf("FindingCheckID(%s) = %s, want %s", c.f.ID, got, c.want)
+		}
+	}
+}
+
+func TestFindingFingerprint_Stable(t *testing.T) {
+	a := Finding{ID: "TLS-001-b", ResourceRef: "AgentgatewayBackend/ns/b", Title: "x", Timestamp: "2026-01-01T00:00:00Z"}
+	b := Finding{ID: "TLS-001-b", ResourceRef: "AgentgatewayBackend/ns/b", Title: "y", Timestamp: "2026-02-01T00:00:00Z"}
+	if FindingFingerprint(a) != FindingFingerprint(b) {
+		t.Error("Fingerprint should ignore title and timestamp")
+	}
+	c := Finding{ID: "TLS-001-b", ResourceRef: "AgentgatewayBackend/other/b"}
+	if FindingFingerprint(a) == FindingFingerprint(c) {
+		t.Error("Fingerprint should differ across resources")
+	}
+}
+
+func TestEvaluate_AssignsFingerprints(t *testing.T) {
+	result := Evaluate(emptyState(), defaultPolicy())
+	if len(result.Findings) == 0 {
+		t.Fatal("Expected findings on empty state")
+	}
+	for _, f := range result.Findings {
+		if f.Fingerprint != FindingFingerprint(f) {
+			t.Errorf("finding %s has fingerprint %q", f.ID, f.Fingerprint)
+		}
+	}
+}
+
+func TestFindingStore_Lifecycle(t *testing.T) {
+	s := NewFindingStore(0)
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	fa := Finding{ID: "TLS-001-a", ResourceRef: "AgentgatewayBackend/ns/a", Severity: SeverityHigh}
+	fb := Finding{ID: "TLS-001-b", ResourceRef: "AgentgatewayBackend/ns/b", Severity: SeverityHigh}
+
+	s.Record(&EvaluationResult{Timestamp: t0, Findings: []Finding{fa, fb}})
+	if n := len(s.NewSinceLastScan()); n != 2 {
+		t.Errorf("first scan: %d new, want 2", n)
+	}
+
+	t1 := t0.Add(time.Hour)
+	s.Record(&EvaluationResult{Timestamp: t1, Findings: []Finding{fa}})
+	if n := len(s.NewSinceLastScan()); n != 0 {
+		t.Errorf("second scan: %d new, want 0", n)
+	}
+	resolved := s.ResolvedSinceLastScan()
+	if len(resolved) != 1 || resolved[0].ID != "TLS-001-b" || resolved[0].ResolvedAt == nil || !resolved[0].ResolvedAt.Equal(t1) {
+		t.Fatalf("second scan resolved = %+v", resolved)
+	}
+	a, ok := s.Lookup(FindingFingerprint(fa))
+	if !ok || a.Occurrences != 2 || !a.FirstSeen.Equal(t0) || !a.LastSeen.Equal(t1) || a.Status != FindingStatusOpen {
+		t.Errorf("a lifecycle = %+v", a)
+	}
+
+	t2 := t1.Add(time.Hour)
+	s.Record(&EvaluationResult{Timestamp: t2, Findings: []Finding{fa, fb}})
+	if len(s.ResolvedSinceLastScan()) != 0 {
+		t.Error("Resolved list should only cover the last scan")
+	}
+	reopened := s.NewSinceLastScan()
+	if len(reopened) != 1 || reopened[0].ID != "TLS-001-b" || reopened[0].ResolvedAt != nil {
+		t.Errorf("reopened = %+v", reopened)
+	}
+	if b, _ := s.Lookup(FindingFingerprint(fb)); !b.FirstSeen.Equal(t0) || b.Occurrences != 2 {
+		t.Errorf("reopened finding should keep firstSeen and count, got %+v", b)
+	}
+}
+
+func TestFindingStore_WaivedAndRetention(t *testing.T) {
+	s := NewFindingStore(24 * time.Hour)
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	f := Finding{ID: "HDN-003-w", ResourceRef: "Deployment/ns/w"}
+
+	s.Record(&EvaluationResult{Timestamp: t0, WaivedFindings: []WaivedFinding{{Finding: f}}})
+	if rec, _ := s.Lookup(FindingFingerprint(f)); rec.Status != FindingStatusWaived {
+		t.Errorf("status = %s, want waived", rec.Status)
+	}
+
+	s.Record(&EvaluationResult{Timestamp: t0.Add(time.Hour)})
+	s.Record(&EvaluationResult{Timestamp: t0.Add(48 * time.Hour)})
+	if _, ok := s.Lookup(FindingFingerprint(f)); ok {
+		t.Error("Resolved finding past retention should be pruned")
+	}
+}
+
+func TestFindingStore_TrackUnknown(t *testing.T) {
+	s := NewFindingStore(0)
+	tracked := s.Track([]Finding{{ID: "AGW-001"}})
+	if len(tracked) != 1 || tracked[0].Status != FindingStatusOpen || tracked[0].Fingerprint == "" {
+		t.Errorf("Track of unseen finding = %+v", tracked)
+	}
+}
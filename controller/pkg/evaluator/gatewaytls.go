@@ -0,0 +1,247 @@
+package evaluator
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultCertExpiryWarningDays is the certificate expiry window used when the
+// policy does not set certExpiryWarningDays.
+const DefaultCertExpiryWarningDays = 30
+
+// CertificateInfo describes a serving certificate read from a TLS Secret
+// referenced by a Gateway listener.
+type CertificateInfo struct {
+	Secret       string    `json:"secret"` // namespace/name
+	Subject      string    `json:"subject,omitempty"`
+	Issuer       string    `json:"issuer,omitempty"`
+	NotBefore    time.Time `json:"notBefore"`
+	NotAfter     time.Time `json:"notAfter"`
+	DNSNames     []string  `json:"dnsNames,omitempty"`
+	KeyAlgorithm string    `json:"keyAlgorithm,omitempty"` // "RSA", "ECDSA" or "Ed25519"
+	KeySize      int       `json:"keySize,omitempty"`      // bits
+	Error        string    `json:"error,omitempty"`        // why the certificate could not be read
+}
+
+// certExpiryWindow returns the policy's certificate expiry window.
+func (p Policy) certExpiryWindow() time.Duration {
+	days := p.CertExpiryWarningDays
+	if days <= 0 {
+		days = DefaultCertExpiryWarningDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// isMCPRoute reports whether an HTTPRoute forwards to an MCP backend: an MCP
+// AgentgatewayBackend, a kagent MCPServer or a Service marked as MCP.
+func isMCPRoute(state *ClusterState, hr HTTPRouteResource) bool {
+	for _, ref := range hr.BackendRefs {
+		for _, b := range state.AgentgatewayBackends {
+			if b.Name == ref && b.Namespace == hr.Namespace && (b.BackendType == "mcp" || len(b.MCPTargets) > 0) {
+				return true
+			}
+		}
+		for _, m := range state.KagentMCPServers {
+			if m.Name == ref && m.Namespace == hr.Namespace {
+				return true
+			}
+		}
+		for _, s := range state.Services {
+			if s.Name == ref && s.Namespace == hr.Namespace && s.IsMCP {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// listenerMCPRoutes returns the MCP HTTPRoutes attached to a Gateway listener.
+// Routes without a sectionName attach to every listener of the Gateway.
+func listenerMCPRoutes(state *ClusterState, gw GatewayResource, l ListenerInfo) []HTTPRouteResource {
+	var out []HTTPRouteResource
+	for _, hr := range state.HTTPRoutes {
+		parent, ok := routeParentGateway(state, hr)
+		if !ok || parent.Name != gw.Name || parent.Namespace != gw.Namespace {
+			continue
+		}
+		if hr.ParentSectionName != "" && hr.ParentSectionName != l.Name {
+			continue
+		}
+		if isMCPRoute(state, hr) {
+			out = append(out, hr)
+		}
+	}
+	return out
+}
+
+// hostnameMatches reports whether host matches a Gateway API listener
+// hostname, where a leading "*." matches one or more labels.
+func hostnameMatches(pattern, host string) bool {
+	if pattern == "" || pattern == host {
+		return true
+	}
+	if strings.HasPrefix(pattern, "*.") {
+		return strings.HasSuffix(host, pattern[1:]) && len(host) > len(pattern)-1
+	}
+	return false
+}
+
+// certCovers reports whether a certificate SAN list covers host. A wildcard
+// SAN covers exactly one label (RFC 6125); a wildcard host is only covered by
+// the identical wildcard SAN.
+func certCovers(dnsNames []string, host string) bool {
+	host = strings.ToLower(host)
+	for _, san := range dnsNames {
+		san = strings.ToLower(san)
+		if san == host {
+			return true
+		}
+		if strings.HasPrefix(san, "*.") && !strings.HasPrefix(host, "*") {
+			if i := strings.Index(host, "."); i > 0 && host[i:] == san[1:] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// listenerHostnames returns the hostnames a listener must present a
+// certificate for: its own hostname and those of the MCP routes it serves.
+func listenerHostnames(l ListenerInfo, routes []HTTPRouteResource) []string {
+	var hosts []string
+	if l.Hostname != "" {
+		hosts = append(hosts, l.Hostname)
+	}
+	for _, r := range routes {
+		for _, h := range r.Hostnames {
+			if hostnameMatches(l.Hostname, h) {
+				hosts = appendUnique(hosts, h)
+			}
+		}
+	}
+	return hosts
+}
+
+// checkGatewayTLS inspects the listeners of Gateways serving MCP routes:
+// plain HTTP listeners (TLS-004), certificates expired or expiring within the
+// policy window (TLS-005) and hostnames the certificates do not cover (TLS-006).
+func checkGatewayTLS(state *ClusterState, policy Policy) []Finding {
+	var findings []Finding
+	if !policy.RequireTLS {
+		return findings
+	}
+	now := time.Now()
+	ts := now.Format(time.RFC3339)
+	window := policy.certExpiryWindow()
+
+	for _, gw := range state.Gateways {
+		ref := fmt.Sprintf("Gateway/%s/%s", gw.Namespace, gw.Name)
+		hasTLSListener := false
+		for _, l := range gw.Listeners {
+			if l.Protocol == "HTTPS" || l.Protocol == "TLS" {
+				hasTLSListener = true
+			}
+		}
+
+		for _, l := range gw.Listeners {
+			routes := listenerMCPRoutes(state, gw, l)
+			if len(routes) == 0 {
+				continue
+			}
+
+			if l.Protocol == "HTTP" {
+				severity := SeverityHigh
+				if hasTLSListener {
+					severity = SeverityMedium
+				}
+				findings = append(findings, Finding{
+					ID:          fmt.Sprintf("TLS-004-%s-%s", gw.Name, l.Name),
+					Severity:    severity,
+					Category:    CategoryTLS,
+					Title:       fmt.Sprintf("Gateway '%s' serves MCP routes over plain HTTP", gw.Name),
+					Description: fmt.Sprintf("Listener '%s' (port %d) of Gateway '%s/%s' uses protocol HTTP and serves %d MCP route(s).", l.Name, l.Port, gw.Namespace, gw.Name, len(routes)),
+					Impact:      "MCP requests, bearer tokens and tool results cross the network unencrypted between clients and the gateway.",
+					Remediation: "Add an HTTPS listener with tls.certificateRefs and attach the MCP HTTPRoutes to it (sectionName), or redirect the HTTP listener to HTTPS.",
+					ResourceRef: ref,
+					Namespace:   gw.Namespace,
+					Timestamp:   ts,
+				})
+				continue
+			}
+			if l.TLSMode != "Terminate" {
+				continue
+			}
+
+			// Report the certificate that expires first; SANs of all
+			// certificates (e.g. RSA and ECDSA pairs) count for coverage.
+			var sans []string
+			var soonest *CertificateInfo
+			for i, c := range l.Certificates {
+				if c.Error != "" {
+					continue
+				}
+				sans = append(sans, c.DNSNames...)
+				if soonest == nil || c.NotAfter.Before(soonest.NotAfter) {
+					soonest = &l.Certificates[i]
+				}
+			}
+			if soonest != nil && soonest.NotAfter.Sub(now) <= window {
+				c := soonest
+				remaining := c.NotAfter.Sub(now)
+				severity, title := SeverityHigh, fmt.Sprintf("Certificate of Gateway '%s' expires in %d days", gw.Name, int(remaining.Hours()/24))
+				if remaining <= 0 {
+					severity, title = SeverityCritical, fmt.Sprintf("Certificate of Gateway '%s' has expired", gw.Name)
+				}
+				findings = append(findings, Finding{
+					ID:          fmt.Sprintf("TLS-005-%s-%s", gw.Name, l.Name),
+					Severity:    severity,
+					Category:    CategoryTLS,
+					Title:       title,
+					Description: fmt.Sprintf("Secret '%s' served by listener '%s' of Gateway '%s/%s' is valid until %s (warning window: %d days).", c.Secret, l.Name, gw.Namespace, gw.Name, c.NotAfter.UTC().Format(time.RFC3339), int(window.Hours()/24)),
+					Impact:      "Once the certificate expires MCP clients fail the TLS handshake, or are pushed to disable certificate verification.",
+					Remediation: "Renew the certificate (or fix the cert-manager Certificate issuing it) and update the TLS Secret.",
+					ResourceRef: ref,
+					Namespace:   gw.Namespace,
+					Timestamp:   ts,
+				})
+			}
+
+			if len(sans) == 0 {
+				continue
+			}
+			var uncovered []string
+			for _, h := range listenerHostnames(l, routes) {
+				if !certCovers(sans, h) {
+					uncovered = append(uncovered, h)
+				}
+			}
+			if len(uncovered) > 0 {
+				findings = append(findings, Finding{
+					ID:          fmt.Sprintf("TLS-006-%s-%s", gw.Name, l.Name),
+					Severity:    SeverityHigh,
+					Category:    CategoryTLS,
+					Title:       fmt.Sprintf("Certificate of Gateway '%s' does not cover %s", gw.Name, strings.Join(uncovered, ", ")),
+					Description: fmt.Sprintf("Listener '%s' of Gateway '%s/%s' serves MCP hostnames %s, but its certificate only lists %s.", l.Name, gw.Namespace, gw.Name, strings.Join(uncovered, ", "), strings.Join(sans, ", ")),
+					Impact:      "Clients connecting to these hostnames get a certificate name mismatch and must either fail or skip verification.",
+					Remediation: "Reissue the certificate with the missing hostnames as SANs, or narrow the listener and HTTPRoute hostnames.",
+					ResourceRef: ref,
+					Namespace:   gw.Namespace,
+					Timestamp:   ts,
+				})
+			}
+		}
+	}
+	return findings
+}
+
+// isTLSQualityFinding reports whether a finding ID is a TLS issue that lowers
+// the per-server TLS score even when TLS is configured (TLS-003..006).
+func isTLSQualityFinding(id string) bool {
+	for _, code := range []string{"TLS-003-", "TLS-004-", "TLS-005-", "TLS-006-"} {
+		if strings.HasPrefix(id, code) {
+			return true
+		}
+	}
+	return false
+}
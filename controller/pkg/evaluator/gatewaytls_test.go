@@ -0,0 +1,155 @@
+package evaluator
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func gatewayTLSState(listeners ...ListenerInfo) *ClusterState {
+	return &ClusterState{
+		Namespaces: []string{"agentgateway-system"},
+		Gateways: []GatewayResource{
+			{Name: "agentgateway", Namespace: "agentgateway-system", GatewayClassName: "agentgateway", Listeners: listeners},
+		},
+		AgentgatewayBackends: []AgentgatewayBackendResource{
+			{Name: "tools-backend", Namespace: "agentgateway-system", BackendType: "mcp"},
+		},
+		HTTPRoutes: []HTTPRouteResource{
+			{Name: "tools", Namespace: "agentgateway-system", ParentGateway: "agentgateway",
+				Hostnames: []string{"mcp.example.com"}, BackendRefs: []string{"tools-backend"}},
+		},
+	}
+}
+
+func httpsListener(notAfter time.Time, dnsNames ...string) ListenerInfo {
+	return ListenerInfo{
+		Name: "https", Port: 443, Protocol: "HTTPS", TLSMode: "Terminate",
+		CertificateRefs: []string{"agentgateway-system/mcp-tls"},
+		Certificates:    []CertificateInfo{{Secret: "agentgateway-system/mcp-tls", NotAfter: notAfter, DNSNames: dnsNames}},
+	}
+}
+
+func gatewayTLSFindings(state *ClusterState, policy Policy) map[string]Finding {
+	out := map[string]Finding{}
+	for _, f := range checkGatewayTLS(state, policy) {
+		out[f.ID] = f
+	}
+	return out
+}
+
+func TestCheckGatewayTLS(t *testing.T) {
+	now := time.Now()
+	valid := now.Add(90 * 24 * time.Hour)
+
+	tests := []struct {
+		name         string
+		listeners    []ListenerInfo
+		wantID       string
+		wantSeverity string
+	}{
+		{"healthy HTTPS listener", []ListenerInfo{httpsListener(valid, "mcp.example.com")}, "", ""},
+		{"wildcard SAN", []ListenerInfo{httpsListener(valid, "*.example.com")}, "", ""},
+		{"HTTP only", []ListenerInfo{{Name: "http", Port: 80, Protocol: "HTTP"}}, "TLS-004-agentgateway-http", SeverityHigh},
+		{"HTTP next to HTTPS", []ListenerInfo{{Name: "http", Port: 80, Protocol: "HTTP"}, httpsListener(valid, "mcp.example.com")}, "TLS-004-agentgateway-http", SeverityMedium},
+		{"expiring soon", []ListenerInfo{httpsListener(now.Add(10*24*time.Hour), "mcp.example.com")}, "TLS-005-agentgateway-https", SeverityHigh},
+		{"expired", []ListenerInfo{httpsListener(now.Add(-time.Hour), "mcp.example.com")}, "TLS-005-agentgateway-https", SeverityCritical},
+		{"hostname not covered", []ListenerInfo{httpsListener(valid, "other.example.org")}, "TLS-006-agentgateway-https", SeverityHigh},
+		{"passthrough", []ListenerInfo{{Name: "tls", Port: 443, Protocol: "TLS", TLSMode: "Passthrough"}}, "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			findings := gatewayTLSFindings(gatewayTLSState(tt.listeners...), defaultPolicy())
+			if tt.wantID == "" {
+				if len(findings) != 0 {
+					t.Fatalf("findings = %v, want none", findings)
+				}
+				return
+			}
+			f, ok := findings[tt.wantID]
+			if !ok || len(findings) != 1 {
+				t.Fatalf("findings = %v, want only %s", findings, tt.wantID)
+			}
+			if f.Severity != tt.wantSeverity || f.Category != CategoryTLS || f.ResourceRef != "Gateway/agentgateway-system/agentgateway" {
+				t.Errorf("finding = %+v", f)
+			}
+		})
+	}
+}
+
+func TestCheckGatewayTLS_Scope(t *testing.T) {
+	// Listeners without MCP routes are out of scope.
+	state := gatewayTLSState(ListenerInfo{Name: "http", Port: 80, Protocol: "HTTP"})
+	state.AgentgatewayBackends[0].BackendType = "ai"
+	if f := gatewayTLSFindings(state, defaultPolicy()); len(f) != 0 {
+		t.Errorf("non-MCP route: findings = %v", f)
+	}
+
+	// A route bound to the HTTPS listener by sectionName leaves the HTTP listener unused.
+	state = gatewayTLSState(ListenerInfo{Name: "http", Port: 80, Protocol: "HTTP"}, httpsListener(time.Now().Add(90*24*time.Hour), "mcp.example.com"))
+	state.HTTPRoutes[0].ParentSectionName = "https"
+	if f := gatewayTLSFindings(state, defaultPolicy()); len(f) != 0 {
+		t.Errorf("sectionName https: findings = %v", f)
+	}
+
+	// The expiry window is configurable.
+	state = gatewayTLSState(httpsListener(time.Now().Add(45*24*time.Hour), "mcp.example.com"))
+	policy := defaultPolicy()
+	if f := gatewayTLSFindings(state, policy); len(f) != 0 {
+		t.Errorf("45 days left with a 30-day window: findings = %v", f)
+	}
+	policy.CertExpiryWarningDays = 60
+	if _, ok := gatewayTLSFindings(state, policy)["TLS-005-agentgateway-https"]; !ok {
+		t.Error("45 days left with a 60-day window should raise TLS-005")
+	}
+}
+
+func TestCertCovers(t *testing.T) {
+	tests := []struct {
+		sans []string
+		host string
+		want bool
+	}{
+		{[]string{"mcp.example.com"}, "MCP.example.com", true},
+		{[]string{"*.example.com"}, "mcp.example.com", true},
+		{[]string{"*.example.com"}, "a.mcp.example.com", false},
+		{[]string{"*.example.com"}, "example.com", false},
+		{[]string{"*.example.com"}, "*.example.com", true},
+		{[]string{"mcp.example.com"}, "*.example.com", false},
+	}
+	for _, tt := range tests {
+		if got := certCovers(tt.sans, tt.host); got != tt.want {
+			t.Errorf("certCovers(%v, %q) = %v, want %v", tt.sans, tt.host, got, tt.want)
+		}
+	}
+}
+
+func TestBuildMCPServerViews_GatewayCertificates(t *testing.T) {
+	state := gatewayTLSState(httpsListener(time.Now().Add(5*24*time.Hour), "mcp.example.com"))
+	state.AgentgatewayBackends[0].MCPTargets = []MCPTargetInfo{{Name: "tools", Host: "tools.mcp.svc.cluster.local", Port: 8080}}
+	state.KagentMCPServers = []KagentMCPServerResource{{Name: "tools", Namespace: "mcp", Port: 8080}}
+	policy := defaultPolicy()
+
+	views := BuildMCPServerViews(state, checkGatewayTLS(state, policy), policy)
+	if len(views) != 1 || len(views[0].RelatedGateways) != 1 {
+		t.Fatalf("views = %+v", views)
+	}
+	listeners, ok := views[0].RelatedGateways[0].Details["listeners"].([]ListenerInfo)
+	if !ok || len(listeners) != 1 || len(listeners[0].Certificates) != 1 {
+		t.Fatalf("gateway details = %+v", views[0].RelatedGateways[0].Details)
+	}
+	found := false
+	for _, f := range views[0].Findings {
+		found = found || strings.HasPrefix(f.ID, "TLS-005-")
+	}
+	if !found || views[0].ScoreBreakdown.TLS >= 100 {
+		t.Errorf("expiring certificate: findings = %v, TLS score = %d", views[0].Findings, views[0].ScoreBreakdown.TLS)
+	}
+}
+
+func TestTightenPolicy_CertExpiryWarningDays(t *testing.T) {
+	got := TightenPolicy(Policy{CertExpiryWarningDays: 30}, Policy{CertExpiryWarningDays: 45})
+	if got.CertExpiryWarningDays != 45 {
+		t.Errorf("CertExpiryWarningDays = %d, want the longer window 45", got.CertExpiryWarningDays)
+	}
+}
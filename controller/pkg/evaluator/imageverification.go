@@ -0,0 +1,190 @@
+package evaluator
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/imageverify"
+)
+
+// ImageVerificationPolicy configures offline cosign verification of workload
+// images and the registries images may be pulled from.
+type ImageVerificationPolicy struct {
+	// Enabled turns on signature verification (HDN-016, HDN-017, HDN-019).
+	Enabled bool
+
+	// PublicKeys are PEM-encoded cosign public keys trusted to sign images.
+	PublicKeys []string
+
+	// PublicKeysConfigMap ("namespace/name") holds additional PEM keys, one
+	// per data entry.
+	PublicKeysConfigMap string
+
+	// AllowedRegistries lists registry hosts ("ghcr.io") or repository
+	// prefixes ("ghcr.io/my-org") images may come from (HDN-018). Empty
+	// allows every registry.
+	AllowedRegistries []string
+
+	// RegistryMirror is the host[:port] of a local or in-cluster OCI registry
+	// serving the images and their signatures. Empty contacts each image's
+	// own registry.
+	RegistryMirror string
+
+	// InsecureRegistry uses plain HTTP to reach the registry.
+	InsecureRegistry bool
+}
+
+// VerifierConfig returns the imageverify configuration for the policy, with
+// the keys loaded from PublicKeysConfigMap appended.
+func (p ImageVerificationPolicy) VerifierConfig(configMapKeys []string) imageverify.Config {
+	return imageverify.Config{
+		PublicKeys: append(append([]string{}, p.PublicKeys...), configMapKeys...),
+		Mirror:     p.RegistryMirror,
+		Insecure:   p.InsecureRegistry,
+	}
+}
+
+// ImageVerificationTarget is a set of images verified with the same
+// settings: the effective image verification policy shared by Namespaces.
+type ImageVerificationTarget struct {
+	Policy     ImageVerificationPolicy
+	Namespaces []string
+	Images     []string
+}
+
+// ImageVerificationTargets groups the images of the workloads whose namespace
+// policy enables verification by that policy's keys and registry settings. A
+// namespace policy's keys are therefore only trusted for its own namespaces.
+func ImageVerificationTargets(state *ClusterState, policy Policy) []ImageVerificationTarget {
+	var targets []ImageVerificationTarget
+	index := map[string]int{}
+	for _, w := range state.Workloads {
+		iv := policy.ForNamespace(w.Namespace).ImageVerification
+		if !iv.Enabled || len(w.ImageNames) == 0 {
+			continue
+		}
+		key := imageverify.ConfigKey(iv.VerifierConfig(nil)) + "|" + iv.PublicKeysConfigMap
+		i, ok := index[key]
+		if !ok {
+			i = len(targets)
+			index[key] = i
+			targets = append(targets, ImageVerificationTarget{Policy: iv})
+		}
+		t := &targets[i]
+		t.Namespaces = appendUnique(t.Namespaces, w.Namespace)
+		for _, img := range w.ImageNames {
+			t.Images = appendUnique(t.Images, img)
+		}
+	}
+	return targets
+}
+
+// ImageSignatureKey is the ClusterState.ImageSignatures key of an image
+// verified for a namespace.
+func ImageSignatureKey(namespace, image string) string {
+	return namespace + "/" + image
+}
+
+// workloadImageSignatures returns the verification results for a workload's
+// images, in image order. Images without a result are reported as errors.
+func workloadImageSignatures(state *ClusterState, w WorkloadResource) []imageverify.Result {
+	var out []imageverify.Result
+	seen := map[string]bool{}
+	for _, img := range w.ImageNames {
+		if seen[img] {
+			continue
+		}
+		seen[img] = true
+		r, ok := state.ImageSignatures[ImageSignatureKey(w.Namespace, img)]
+		if !ok {
+			r = imageverify.Result{Image: img, Status: imageverify.StatusError, Reason: "not verified yet"}
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+// checkImageVerification reports workload images that are unsigned, wrongly
+// signed, unverifiable or pulled from registries outside the allowlist
+// (HDN-016..019). The findings count toward the Hardened Deployment score.
+func checkImageVerification(state *ClusterState, policy Policy) []Finding {
+	var findings []Finding
+	iv := policy.ImageVerification
+	if !policy.RequireHardenedDeployment || (!iv.Enabled && len(iv.AllowedRegistries) == 0) {
+		return findings
+	}
+	ts := time.Now().Format(time.RFC3339)
+
+	for _, w := range state.Workloads {
+		ref := fmt.Sprintf("%s/%s/%s", w.Kind, w.Namespace, w.Name)
+		add := func(code, severity, title, description, impact, remediation string) {
+			findings = append(findings, Finding{
+				ID:          fmt.Sprintf("HDN-%s-%s", code, w.Name),
+				Severity:    severity,
+				Category:    CategoryHardening,
+				Title:       title,
+				Description: description,
+				Impact:      impact,
+				Remediation: remediation,
+				ResourceRef: ref,
+				Namespace:   w.Namespace,
+				Timestamp:   ts,
+			})
+		}
+
+		if len(iv.AllowedRegistries) > 0 {
+			var disallowed []string
+			for _, img := range w.ImageNames {
+				if !imageverify.RegistryAllowed(img, iv.AllowedRegistries) {
+					disallowed = appendUnique(disallowed, img)
+				}
+			}
+			if len(disallowed) > 0 {
+				add("018", SeverityHigh,
+					fmt.Sprintf("%s '%s' pulls images from non-allowlisted registries", w.Kind, w.Name),
+					fmt.Sprintf("%s '%s/%s' uses images outside the allowed registries (%s): %s", w.Kind, w.Namespace, w.Name, strings.Join(iv.AllowedRegistries, ", "), strings.Join(disallowed, ", ")),
+					"Images from unvetted registries bypass the organisation's build, scanning and signing pipeline.",
+					"Mirror the image into an allowed registry and reference it from there, or add the registry to imageVerification.allowedRegistries after review.")
+			}
+		}
+
+		if !iv.Enabled {
+			continue
+		}
+		var unsigned, invalid, unverified []string
+		for _, r := range workloadImageSignatures(state, w) {
+			switch r.Status {
+			case imageverify.StatusUnsigned:
+				unsigned = append(unsigned, r.Image)
+			case imageverify.StatusInvalid:
+				invalid = append(invalid, fmt.Sprintf("%s (%s)", r.Image, r.Reason))
+			case imageverify.StatusError:
+				unverified = append(unverified, fmt.Sprintf("%s (%s)", r.Image, r.Reason))
+			}
+		}
+		if len(unsigned) > 0 {
+			add("016", SeverityHigh,
+				fmt.Sprintf("%s '%s' runs unsigned images", w.Kind, w.Name),
+				fmt.Sprintf("No cosign signature was found for these images of %s '%s/%s': %s", w.Kind, w.Namespace, w.Name, strings.Join(unsigned, ", ")),
+				"Image provenance cannot be established; a substituted or tampered image would run undetected.",
+				"Sign the images with a trusted key (cosign sign --key <key> <image>@<digest>) and push the signatures to the registry the controller verifies against.")
+		}
+		if len(invalid) > 0 {
+			add("017", SeverityCritical,
+				fmt.Sprintf("%s '%s' runs images with invalid signatures", w.Kind, w.Name),
+				fmt.Sprintf("Images of %s '%s/%s' carry cosign signatures that do not verify with any trusted key: %s", w.Kind, w.Namespace, w.Name, strings.Join(invalid, "; ")),
+				"The image was signed by an unknown key or its signature does not match its digest — a strong indicator of tampering or an untrusted build pipeline.",
+				"Rebuild and re-sign the image with a trusted key, or add the signing key to imageVerification.publicKeys after verifying its origin.")
+		}
+		if len(unverified) > 0 {
+			add("019", SeverityLow,
+				fmt.Sprintf("%s '%s' images could not be verified", w.Kind, w.Name),
+				fmt.Sprintf("Signature verification failed for images of %s '%s/%s': %s", w.Kind, w.Namespace, w.Name, strings.Join(unverified, "; ")),
+				"Images whose signatures cannot be checked may be unsigned or tampered with.",
+				"Make the registry (or imageVerification.registryMirror) reachable from the controller and ensure the images are available there.")
+		}
+	}
+
+	return findings
+}
@@ -0,0 +1,177 @@
+package evaluator
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/imageverify"
+)
+
+// imageState has one workload in namespace mcp; results are keyed by image.
+func imageState(results map[string]imageverify.Result, images ...string) *ClusterState {
+	var signatures map[string]imageverify.Result
+	if results != nil {
+		signatures = make(map[string]imageverify.Result, len(results))
+		for img, r := range results {
+			signatures[ImageSignatureKey("mcp", img)] = r
+		}
+	}
+	return &ClusterState{
+		Namespaces:       []string{"mcp"},
+		KagentMCPServers: []KagentMCPServerResource{{Name: "tools", Namespace: "mcp", Port: 8080}},
+		Workloads: []WorkloadResource{
+			{Name: "tools", Namespace: "mcp", Kind: "Deployment", ImageNames: images},
+		},
+		ImageSignatures: signatures,
+	}
+}
+
+func TestCheckImageVerification(t *testing.T) {
+	const img = "ghcr.io/my-org/tools:v1"
+	tests := []struct {
+		name         string
+		result       *imageverify.Result
+		allowed      []string
+		wantID       string
+		wantSeverity string
+	}{
+		{"unsigned", &imageverify.Result{Image: img, Status: imageverify.StatusUnsigned}, nil, "HDN-016-tools", SeverityHigh},
+		{"invalid signature", &imageverify.Result{Image: img, Status: imageverify.StatusInvalid, Reason: "untrusted key"}, nil, "HDN-017-tools", SeverityCritical},
+		{"registry not allowed", &imageverify.Result{Image: img, Status: imageverify.StatusVerified}, []string{"registry.local:5000"}, "HDN-018-tools", SeverityHigh},
+		{"registry error", &imageverify.Result{Image: img, Status: imageverify.StatusError, Reason: "timeout"}, nil, "HDN-019-tools", SeverityLow},
+		{"not verified yet", nil, nil, "HDN-019-tools", SeverityLow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := map[string]imageverify.Result{}
+			if tt.result != nil {
+				results[img] = *tt.result
+			}
+			policy := Policy{RequireHardenedDeployment: true,
+				ImageVerification: ImageVerificationPolicy{Enabled: true, AllowedRegistries: tt.allowed}}
+
+			findings := checkImageVerification(imageState(results, img), policy)
+
+			if len(findings) != 1 || findings[0].ID != tt.wantID {
+				t.Fatalf("findings = %v, want only %s", findings, tt.wantID)
+			}
+			f := findings[0]
+			if f.Severity != tt.wantSeverity || f.Category != CategoryHardening || f.ResourceRef != "Deployment/mcp/tools" {
+				t.Errorf("finding = %+v", f)
+			}
+		})
+	}
+}
+
+func TestCheckImageVerification_VerifiedAndDisabled(t *testing.T) {
+	const img = "ghcr.io/my-org/tools:v1"
+	state := imageState(map[string]imageverify.Result{img: {Image: img, Status: imageverify.StatusVerified}}, img, img)
+	policy := Policy{RequireHardenedDeployment: true,
+		ImageVerification: ImageVerificationPolicy{Enabled: true, AllowedRegistries: []string{"ghcr.io/my-org"}}}
+	if f := checkImageVerification(state, policy); len(f) != 0 {
+		t.Errorf("verified, allowlisted image: findings = %v", f)
+	}
+
+	state.ImageSignatures = nil
+	if f := checkImageVerification(state, Policy{RequireHardenedDeployment: true}); len(f) != 0 {
+		t.Errorf("verification disabled: findings = %v", f)
+	}
+	policy.RequireHardenedDeployment = false
+	if f := checkImageVerification(state, policy); len(f) != 0 {
+		t.Errorf("hardening disabled: findings = %v", f)
+	}
+}
+
+func TestCheckHardenedDeployment_ImageVerificationReplacesAnnotation(t *testing.T) {
+	state := &ClusterState{Workloads: []WorkloadResource{{Name: "tools", Namespace: "mcp", Kind: "Deployment"}}}
+	has010 := func(policy Policy) bool {
+		for _, f := range checkHardenedDeployment(state, policy) {
+			if f.ID == "HDN-010-tools" {
+				return true
+			}
+		}
+		return false
+	}
+	if !has010(Policy{RequireHardenedDeployment: true}) {
+		t.Error("HDN-010 should be reported without image verification")
+	}
+	if has010(Policy{RequireHardenedDeployment: true, ImageVerification: ImageVerificationPolicy{Enabled: true}}) {
+		t.Error("HDN-010 should be replaced by HDN-016..019 when image verification is enabled")
+	}
+}
+
+func TestBuildMCPServerViews_ImageSignatures(t *testing.T) {
+	const img = "ghcr.io/my-org/tools:v1"
+	state := imageState(map[string]imageverify.Result{img: {Image: img, Digest: "sha256:abc", Status: imageverify.StatusVerified, KeyID: "k1"}}, img)
+	policy := defaultPolicy()
+
+	views := BuildMCPServerViews(state, nil, policy)
+	if len(views) != 1 || views[0].ImageSignatures != nil {
+		t.Fatalf("verification disabled: views = %+v", views)
+	}
+
+	policy.ImageVerification.Enabled = true
+	views = BuildMCPServerViews(state, nil, policy)
+	sigs := views[0].ImageSignatures
+	if len(sigs) != 1 || sigs[0].Status != imageverify.StatusVerified || sigs[0].KeyID != "k1" {
+		t.Errorf("imageSignatures = %+v", sigs)
+	}
+}
+
+func TestImageVerificationTargets(t *testing.T) {
+	base := DefaultPolicy()
+	base.Name = "baseline"
+	base.ImageVerification = ImageVerificationPolicy{Enabled: true, PublicKeys: []string{"base-key"}, RegistryMirror: "mirror:5000"}
+	p := ResolvePolicies([]Policy{base,
+		{Name: "team", ScopeNamespaces: []string{"team"}, ImageVerification: ImageVerificationPolicy{
+			Enabled: true, PublicKeys: []string{"team-key"}, PublicKeysConfigMap: "team/cosign-keys"}},
+	})
+	state := &ClusterState{Workloads: []WorkloadResource{
+		{Name: "a", Namespace: "apps", ImageNames: []string{"ghcr.io/org/a:v1"}},
+		{Name: "b", Namespace: "other", ImageNames: []string{"ghcr.io/org/a:v1", "ghcr.io/org/b:v1"}},
+		{Name: "t", Namespace: "team", ImageNames: []string{"ghcr.io/org/a:v1"}},
+	}}
+
+	targets := ImageVerificationTargets(state, p)
+	if len(targets) != 2 {
+		t.Fatalf("targets = %+v, want one per key set", targets)
+	}
+	shared, team := targets[0], targets[1]
+	if !reflect.DeepEqual(shared.Policy.PublicKeys, []string{"base-key"}) || shared.Policy.PublicKeysConfigMap != "" ||
+		!reflect.DeepEqual(shared.Namespaces, []string{"apps", "other"}) ||
+		!reflect.DeepEqual(shared.Images, []string{"ghcr.io/org/a:v1", "ghcr.io/org/b:v1"}) {
+		t.Errorf("baseline target = %+v", shared)
+	}
+	if !reflect.DeepEqual(team.Policy.PublicKeys, []string{"base-key", "team-key"}) || team.Policy.PublicKeysConfigMap != "team/cosign-keys" ||
+		!reflect.DeepEqual(team.Namespaces, []string{"team"}) || team.Policy.RegistryMirror != "mirror:5000" {
+		t.Errorf("team target = %+v", team)
+	}
+
+	if targets := ImageVerificationTargets(state, DefaultPolicy()); targets != nil {
+		t.Errorf("verification disabled: targets = %+v", targets)
+	}
+}
+
+func TestCheckImageVerification_ScopedKeyStaysInNamespace(t *testing.T) {
+	const img = "ghcr.io/org/a:v1"
+	// The image was only verified with the team's key set; another namespace
+	// running the same image has no result under its own key set.
+	state := &ClusterState{
+		Workloads: []WorkloadResource{
+			{Name: "t", Namespace: "team", Kind: "Deployment", ImageNames: []string{img}},
+			{Name: "o", Namespace: "other", Kind: "Deployment", ImageNames: []string{img}},
+		},
+		ImageSignatures: map[string]imageverify.Result{
+			ImageSignatureKey("team", img): {Image: img, Status: imageverify.StatusVerified, KeyID: "team-key"},
+		},
+	}
+	policy := Policy{RequireHardenedDeployment: true, ImageVerification: ImageVerificationPolicy{Enabled: true}}
+
+	ids := map[string]bool{}
+	for _, f := range checkImageVerification(state, policy) {
+		ids[f.ID] = true
+	}
+	if ids["HDN-019-t"] || !ids["HDN-019-o"] {
+		t.Errorf("findings = %v, want only the other namespace's workload unverified", ids)
+	}
+}
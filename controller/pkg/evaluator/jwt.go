@@ -0,0 +1,249 @@
+package evaluator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// JWTProvider is one token issuer accepted by an AgentgatewayPolicy's
+// traffic.jwtAuthentication.
+type JWTProvider struct {
+	Issuer         string   `json:"issuer,omitempty"`
+	Audiences      []string `json:"audiences,omitempty"`
+	JWKSSource     string   `json:"jwksSource,omitempty"` // "inline" or "remote"; empty when no JWKS is configured
+	JWKSURL        string   `json:"jwksUrl,omitempty"`    // remote JWKS URL, or backendRef ns/name + jwksPath
+	Algorithms     []string `json:"algorithms,omitempty"` // allowed algorithms, plus those implied by inline JWKS keys
+	RequiredClaims []string `json:"requiredClaims,omitempty"`
+}
+
+// symmetricJWTAlgorithms are HMAC algorithms: anyone able to verify a token
+// holds the secret needed to mint one.
+var symmetricJWTAlgorithms = map[string]bool{"HS256": true, "HS384": true, "HS512": true}
+
+// policyTargetsOverlap reports whether two policies attach to a common target.
+// Policies without targetRefs apply cluster-wide and overlap with everything.
+func policyTargetsOverlap(a, b AgentgatewayPolicyResource) bool {
+	if len(a.TargetRefs) == 0 || len(b.TargetRefs) == 0 {
+		return true
+	}
+	for _, ta := range a.TargetRefs {
+		for _, tb := range b.TargetRefs {
+			if ta.Kind == tb.Kind && ta.Name == tb.Name && a.Namespace == b.Namespace {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// audiencesOverlap reports whether a token minted for one provider could be
+// accepted by the other: they share an audience, or either accepts any.
+func audiencesOverlap(a, b []string) bool {
+	if len(a) == 0 || len(b) == 0 || containsString(a, "*") || containsString(b, "*") {
+		return true
+	}
+	for _, aud := range a {
+		if containsString(b, aud) {
+			return true
+		}
+	}
+	return false
+}
+
+func agentgatewayPolicyRef(p AgentgatewayPolicyResource) string {
+	return fmt.Sprintf("AgentgatewayPolicy/%s/%s", p.Namespace, p.Name)
+}
+
+// checkJWTKeySource flags JWT providers fetching their JWKS over plain HTTP
+// (AUTH-006).
+func checkJWTKeySource(state *ClusterState, policy Policy) []Finding {
+	var findings []Finding
+	if !policy.RequireJWTAuth {
+		return findings
+	}
+	ts := time.Now().Format(time.RFC3339)
+	for _, p := range state.AgentgatewayPolicies {
+		var insecure []string
+		for _, prov := range p.JWTProviders {
+			if prov.JWKSSource == "remote" && strings.HasPrefix(strings.ToLower(prov.JWKSURL), "http://") {
+				insecure = append(insecure, prov.JWKSURL)
+			}
+		}
+		if len(insecure) == 0 {
+			continue
+		}
+		findings = append(findings, Finding{
+			ID:          fmt.Sprintf("AUTH-006-%s-%s", p.Namespace, p.Name),
+			Severity:    SeverityHigh,
+			Category:    CategoryAuthentication,
+			Title:       fmt.Sprintf("JWKS fetched over plain HTTP on policy '%s'", p.Name),
+			Description: fmt.Sprintf("AgentgatewayPolicy '%s/%s' loads JWT signing keys from %s without TLS.", p.Namespace, p.Name, strings.Join(insecure, ", ")),
+			Impact:      "An attacker on the network path can substitute their own signing keys and mint tokens the gateway accepts.",
+			Remediation: "Serve the JWKS over HTTPS, or pin the keys with jwks.inline.",
+			ResourceRef: agentgatewayPolicyRef(p),
+			Namespace:   p.Namespace,
+			Timestamp:   ts,
+		})
+	}
+	return findings
+}
+
+// checkJWTSharedIssuer flags issuers trusted by policies that protect
+// unrelated targets with overlapping audiences (AUTH-007): a token minted for
+// one is replayable against the other.
+func checkJWTSharedIssuer(state *ClusterState, policy Policy) []Finding {
+	var findings []Finding
+	if !policy.RequireJWTAuth {
+		return findings
+	}
+	ts := time.Now().Format(time.RFC3339)
+	for i, p := range state.AgentgatewayPolicies {
+		var shared []string
+		var issuers []string
+		for j, other := range state.AgentgatewayPolicies {
+			if i == j || policyTargetsOverlap(p, other) {
+				continue
+			}
+			for _, a := range p.JWTProviders {
+				for _, b := range other.JWTProviders {
+					if a.Issuer == "" || a.Issuer != b.Issuer || !audiencesOverlap(a.Audiences, b.Audiences) {
+						continue
+					}
+					shared = appendUnique(shared, other.Namespace+"/"+other.Name)
+					issuers = appendUnique(issuers, a.Issuer)
+				}
+			}
+		}
+		if len(shared) == 0 {
+			continue
+		}
+		sort.Strings(shared)
+		findings = append(findings, Finding{
+			ID:          fmt.Sprintf("AUTH-007-%s-%s", p.Namespace, p.Name),
+			Severity:    SeverityMedium,
+			Category:    CategoryAuthentication,
+			Title:       fmt.Sprintf("JWT issuer shared with unrelated policies on '%s'", p.Name),
+			Description: fmt.Sprintf("AgentgatewayPolicy '%s/%s' trusts issuer %s, which %s also trust for different targets with overlapping audiences.", p.Namespace, p.Name, strings.Join(issuers, ", "), strings.Join(shared, ", ")),
+			Impact:      "Tokens issued for one MCP endpoint are accepted by another, so a token leaked from a low-trust client unlocks unrelated servers.",
+			Remediation: "Give each protected MCP endpoint its own audience and list only that audience in the policy's jwtAuthentication.",
+			ResourceRef: agentgatewayPolicyRef(p),
+			Namespace:   p.Namespace,
+			Timestamp:   ts,
+		})
+	}
+	return findings
+}
+
+// checkJWTAlgorithms flags JWT providers accepting HMAC-signed tokens
+// (AUTH-008).
+func checkJWTAlgorithms(state *ClusterState, policy Policy) []Finding {
+	var findings []Finding
+	if !policy.RequireJWTAuth {
+		return findings
+	}
+	ts := time.Now().Format(time.RFC3339)
+	for _, p := range state.AgentgatewayPolicies {
+		var symmetric []string
+		for _, prov := range p.JWTProviders {
+			for _, alg := range prov.Algorithms {
+				if symmetricJWTAlgorithms[strings.ToUpper(alg)] {
+					symmetric = appendUnique(symmetric, strings.ToUpper(alg))
+				}
+			}
+		}
+		if len(symmetric) == 0 {
+			continue
+		}
+		findings = append(findings, Finding{
+			ID:          fmt.Sprintf("AUTH-008-%s-%s", p.Namespace, p.Name),
+			Severity:    SeverityHigh,
+			Category:    CategoryAuthentication,
+			Title:       fmt.Sprintf("Symmetric JWT algorithms accepted on policy '%s'", p.Name),
+			Description: fmt.Sprintf("AgentgatewayPolicy '%s/%s' accepts tokens signed with %s. The verification key is also the signing key.", p.Namespace, p.Name, strings.Join(symmetric, ", ")),
+			Impact:      "Every party holding the shared secret — including the gateway itself — can mint valid tokens for any principal.",
+			Remediation: "Use asymmetric algorithms (RS256, ES256, EdDSA) and publish only public keys in the JWKS.",
+			ResourceRef: agentgatewayPolicyRef(p),
+			Namespace:   p.Namespace,
+			Timestamp:   ts,
+		})
+	}
+	return findings
+}
+
+// checkJWTPermissive flags policies in Permissive mode, which forwards
+// requests even when their token fails validation (AUTH-009).
+func checkJWTPermissive(state *ClusterState, policy Policy) []Finding {
+	var findings []Finding
+	if !policy.RequireJWTAuth {
+		return findings
+	}
+	ts := time.Now().Format(time.RFC3339)
+	for _, p := range state.AgentgatewayPolicies {
+		if !p.HasJWT || p.JWTMode != "Permissive" {
+			continue
+		}
+		findings = append(findings, Finding{
+			ID:          fmt.Sprintf("AUTH-009-%s-%s", p.Namespace, p.Name),
+			Severity:    SeverityCritical,
+			Category:    CategoryAuthentication,
+			Title:       fmt.Sprintf("JWT auth mode is 'Permissive' on policy '%s'", p.Name),
+			Description: fmt.Sprintf("AgentgatewayPolicy '%s/%s' validates JWTs in Permissive mode: requests with missing, expired or forged tokens are still forwarded.", p.Namespace, p.Name),
+			Impact:      "Authentication is advisory only — any client reaches the MCP tools.",
+			Remediation: "Set jwtAuthentication.mode to 'Strict' in the AgentgatewayPolicy.",
+			ResourceRef: agentgatewayPolicyRef(p),
+			Namespace:   p.Namespace,
+			Timestamp:   ts,
+		})
+	}
+	return findings
+}
+
+// checkJWTRequiredClaims flags JWT providers that do not require every claim
+// listed in the governance policy's requiredJWTClaims (AUTH-010).
+func checkJWTRequiredClaims(state *ClusterState, policy Policy) []Finding {
+	var findings []Finding
+	if !policy.RequireJWTAuth || len(policy.RequiredJWTClaims) == 0 {
+		return findings
+	}
+	ts := time.Now().Format(time.RFC3339)
+	for _, p := range state.AgentgatewayPolicies {
+		var missing []string
+		for _, prov := range p.JWTProviders {
+			for _, claim := range policy.RequiredJWTClaims {
+				if !containsString(prov.RequiredClaims, claim) {
+					missing = appendUnique(missing, claim)
+				}
+			}
+		}
+		if len(missing) == 0 {
+			continue
+		}
+		findings = append(findings, Finding{
+			ID:          fmt.Sprintf("AUTH-010-%s-%s", p.Namespace, p.Name),
+			Severity:    SeverityMedium,
+			Category:    CategoryAuthentication,
+			Title:       fmt.Sprintf("JWT provider on policy '%s' does not require %s", p.Name, strings.Join(missing, ", ")),
+			Description: fmt.Sprintf("AgentgatewayPolicy '%s/%s' accepts tokens without the claims %s required by the governance policy.", p.Namespace, p.Name, strings.Join(missing, ", ")),
+			Impact:      "Tokens lacking these claims cannot be attributed to a principal or scoped by authorization rules.",
+			Remediation: fmt.Sprintf("Add %s to the provider's required claims in traffic.jwtAuthentication.", strings.Join(missing, ", ")),
+			ResourceRef: agentgatewayPolicyRef(p),
+			Namespace:   p.Namespace,
+			Timestamp:   ts,
+		})
+	}
+	return findings
+}
+
+// isJWTQualityFinding reports whether a finding ID is a JWT configuration
+// issue that lowers the per-server Authentication score even when JWT is
+// present (AUTH-005..010).
+func isJWTQualityFinding(id string) bool {
+	for _, code := range []string{"AUTH-005-", "AUTH-006-", "AUTH-007-", "AUTH-008-", "AUTH-009-", "AUTH-010-"} {
+		if strings.HasPrefix(id, code) {
+			return true
+		}
+	}
+	return false
+}
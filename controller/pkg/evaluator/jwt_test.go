@@ -0,0 +1,123 @@
+package evaluator
+
+import (
+	"strings"
+	"testing"
+)
+
+func jwtPolicy(name, target string, providers ...JWTProvider) AgentgatewayPolicyResource {
+	return AgentgatewayPolicyResource{
+		Name: name, Namespace: "agentgateway-system", HasJWT: true, JWTMode: "Strict",
+		TargetRefs:   []PolicyTargetRef{{Kind: "HTTPRoute", Name: target}},
+		JWTAudiences: []string{"mcp"}, JWTProviders: providers,
+	}
+}
+
+var goodProvider = JWTProvider{
+	Issuer: "https://idp.example.com", Audiences: []string{"mcp"},
+	JWKSSource: "remote", JWKSURL: "https://idp.example.com/.well-known/jwks.json",
+	Algorithms: []string{"RS256"}, RequiredClaims: []string{"sub"},
+}
+
+func jwtFindingIDs(state *ClusterState, policy Policy) []string {
+	var ids []string
+	for _, check := range []func(*ClusterState, Policy) []Finding{
+		checkJWTKeySource, checkJWTSharedIssuer, checkJWTAlgorithms, checkJWTPermissive, checkJWTRequiredClaims,
+	} {
+		for _, f := range check(state, policy) {
+			ids = append(ids, f.ID)
+		}
+	}
+	return ids
+}
+
+func TestJWTProviderChecks(t *testing.T) {
+	plainJWKS := goodProvider
+	plainJWKS.JWKSURL = "http://idp.example.com/jwks"
+	hmac := goodProvider
+	hmac.Algorithms = []string{"RS256", "hs256"}
+	noSub := goodProvider
+	noSub.RequiredClaims = nil
+	permissive := jwtPolicy("p1", "tools", goodProvider)
+	permissive.JWTMode = "Permissive"
+
+	policy := defaultPolicy()
+	policy.RequiredJWTClaims = []string{"sub"}
+
+	tests := []struct {
+		name   string
+		policy AgentgatewayPolicyResource
+		want   string
+	}{
+		{"well configured", jwtPolicy("p1", "tools", goodProvider), ""},
+		{"JWKS over HTTP", jwtPolicy("p1", "tools", plainJWKS), "AUTH-006-agentgateway-system-p1"},
+		{"symmetric algorithm", jwtPolicy("p1", "tools", hmac), "AUTH-008-agentgateway-system-p1"},
+		{"permissive mode", permissive, "AUTH-009-agentgateway-system-p1"},
+		{"missing required claim", jwtPolicy("p1", "tools", noSub), "AUTH-010-agentgateway-system-p1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids := jwtFindingIDs(&ClusterState{AgentgatewayPolicies: []AgentgatewayPolicyResource{tt.policy}}, policy)
+			if tt.want == "" {
+				if len(ids) != 0 {
+					t.Errorf("findings = %v, want none", ids)
+				}
+				return
+			}
+			if len(ids) != 1 || ids[0] != tt.want {
+				t.Errorf("findings = %v, want [%s]", ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckJWTSharedIssuer(t *testing.T) {
+	other := goodProvider
+	state := &ClusterState{AgentgatewayPolicies: []AgentgatewayPolicyResource{
+		jwtPolicy("tools-auth", "tools", goodProvider),
+		jwtPolicy("billing-auth", "billing", other),
+	}}
+	var ids []string
+	for _, f := range checkJWTSharedIssuer(state, defaultPolicy()) {
+		ids = append(ids, f.ID)
+		if !strings.Contains(f.Description, "https://idp.example.com") {
+			t.Errorf("description %q does not name the issuer", f.Description)
+		}
+	}
+	if len(ids) != 2 {
+		t.Errorf("same issuer and audience on unrelated routes: findings = %v", ids)
+	}
+
+	// Distinct audiences keep tokens from being replayed across routes.
+	state.AgentgatewayPolicies[1].JWTProviders[0].Audiences = []string{"billing"}
+	if f := checkJWTSharedIssuer(state, defaultPolicy()); len(f) != 0 {
+		t.Errorf("distinct audiences: findings = %v", f)
+	}
+
+	// Policies on the same target are related.
+	state.AgentgatewayPolicies[1].JWTProviders[0].Audiences = []string{"mcp"}
+	state.AgentgatewayPolicies[1].TargetRefs = state.AgentgatewayPolicies[0].TargetRefs
+	if f := checkJWTSharedIssuer(state, defaultPolicy()); len(f) != 0 {
+		t.Errorf("same target: findings = %v", f)
+	}
+}
+
+func TestCheckAuthentication_PermissiveNotAUTH001(t *testing.T) {
+	p := jwtPolicy("p1", "tools", goodProvider)
+	p.JWTMode = "Permissive"
+	for _, f := range checkAuthentication(&ClusterState{AgentgatewayPolicies: []AgentgatewayPolicyResource{p}}, defaultPolicy()) {
+		if f.ID == "AUTH-001-p1" {
+			t.Error("Permissive mode is reported by AUTH-009, not AUTH-001")
+		}
+	}
+}
+
+func TestScoreMCPServer_JWTProviderFindings(t *testing.T) {
+	policy := defaultPolicy()
+	view := &MCPServerView{HasJWT: true, JWTMode: "Strict", RoutedThroughGateway: true,
+		Findings: []Finding{{ID: "AUTH-008-agentgateway-system-p1", Severity: SeverityHigh, Category: CategoryAuthentication}}}
+	scoreMCPServer(view, policy)
+	if want := 100 - policy.SeverityPenalties.High; view.ScoreBreakdown.Authentication != want {
+		t.Errorf("Authentication = %d, want %d", view.ScoreBreakdown.Authentication, want)
+	}
+}
@@ -0,0 +1,238 @@
+package evaluator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/mcpprobe"
+)
+
+// MCPProbePolicy configures live probing of MCP endpoints: the controller
+// connects to each server, runs initialize and tools/list, and compares the
+// tools it serves with the tools declared on the resource.
+type MCPProbePolicy struct {
+	// Enabled turns on probing (PROBE-001..PROBE-003).
+	Enabled bool
+
+	// AllowedNamespaces lists the namespaces whose MCP servers may be
+	// probed; "*" allows every namespace. Empty probes nothing.
+	AllowedNamespaces []string
+
+	// Concurrency is the number of servers probed at once.
+	Concurrency int
+
+	// TimeoutSeconds bounds the probe of one server.
+	TimeoutSeconds int
+
+	// AuthBypass also sends unauthenticated, expired-token and
+	// wrong-audience requests through the gateway and directly to the
+	// backend (AUTH-011, AUTH-012).
+	AuthBypass bool
+}
+
+// DefaultMCPProbePolicy returns the default probe settings (disabled).
+func DefaultMCPProbePolicy() MCPProbePolicy {
+	return MCPProbePolicy{Concurrency: 4, TimeoutSeconds: 10}
+}
+
+// ProberConfig returns the mcpprobe configuration for the policy; unset
+// limits take the defaults.
+func (p MCPProbePolicy) ProberConfig() mcpprobe.Config {
+	d := DefaultMCPProbePolicy()
+	if p.Concurrency <= 0 {
+		p.Concurrency = d.Concurrency
+	}
+	if p.TimeoutSeconds <= 0 {
+		p.TimeoutSeconds = d.TimeoutSeconds
+	}
+	return mcpprobe.Config{
+		Timeout:     time.Duration(p.TimeoutSeconds) * time.Second,
+		Concurrency: p.Concurrency,
+	}
+}
+
+// AllowsNamespace reports whether servers in ns may be probed.
+func (p MCPProbePolicy) AllowsNamespace(ns string) bool {
+	return containsString(p.AllowedNamespaces, "*") || containsString(p.AllowedNamespaces, ns)
+}
+
+// MCPProbeEnabled reports whether the policy or any of its namespace policies
+// enables live probing.
+func (p Policy) MCPProbeEnabled() bool {
+	for _, ep := range p.EffectivePolicies() {
+		if ep.MCPProbe.Enabled {
+			return true
+		}
+	}
+	return false
+}
+
+// probesNamespace reports whether the effective policy of ns enables probing
+// and allows it for the servers in ns.
+func (p Policy) probesNamespace(ns string) bool {
+	mp := p.ForNamespace(ns).MCPProbe
+	return mp.Enabled && mp.AllowsNamespace(ns)
+}
+
+// MCPProbeTargets returns the endpoints of the MCP servers whose namespace
+// policy allows probing, keyed by MCPServerView ID. Kagent MCPServers are
+// reached through their Service on /mcp (Streamable HTTP) or /sse.
+func MCPProbeTargets(state *ClusterState, policy Policy) []mcpprobe.Target {
+	if !policy.MCPProbeEnabled() {
+		return nil
+	}
+	var targets []mcpprobe.Target
+	for _, s := range state.KagentRemoteMCPServers {
+		if s.URL == "" || !policy.probesNamespace(s.Namespace) {
+			continue
+		}
+		t := mcpprobe.Target{ID: fmt.Sprintf("KagentRemoteMCPServer/%s/%s", s.Namespace, s.Name), URL: s.URL}
+		switch s.Protocol {
+		case "SSE":
+			t.Transport = mcpprobe.TransportSSE
+		case "STREAMABLE_HTTP":
+			t.Transport = mcpprobe.TransportStreamableHTTP
+		}
+		targets = append(targets, t)
+	}
+	for _, s := range state.KagentMCPServers {
+		if !s.HasService || s.Port == 0 || !policy.probesNamespace(s.Namespace) {
+			continue
+		}
+		t := mcpprobe.Target{
+			ID:        fmt.Sprintf("KagentMCPServer/%s/%s", s.Namespace, s.Name),
+			URL:       fmt.Sprintf("http://%s.%s.svc:%d/mcp", s.Name, s.Namespace, s.Port),
+			Transport: mcpprobe.TransportStreamableHTTP,
+		}
+		if s.Transport == "sse" {
+			t.URL = fmt.Sprintf("http://%s.%s.svc:%d/sse", s.Name, s.Namespace, s.Port)
+			t.Transport = mcpprobe.TransportSSE
+		}
+		targets = append(targets, t)
+	}
+	return targets
+}
+
+// applyLiveProbe attaches the probe result to a view. Tools served live but
+// not declared on a RemoteMCPServer are added to the view's inventory, so tool
+// scope, sensitivity and authorization coverage account for them; servers
+// that declare no tools, such as MCPServers, take the live inventory as is.
+func applyLiveProbe(view *MCPServerView, state *ClusterState, policy Policy) {
+	r, ok := state.MCPProbes[view.ID]
+	if !ok || !policy.ForNamespace(view.Namespace).MCPProbe.Enabled {
+		return
+	}
+	view.LiveProbe = &r
+	if r.Status != mcpprobe.StatusOK {
+		return
+	}
+	if view.Source != "KagentRemoteMCPServer" || len(view.ToolNames) == 0 {
+		view.ToolNames = append([]string{}, r.Tools...)
+		view.ToolCount = len(view.ToolNames)
+		return
+	}
+	view.UndeclaredTools = undeclaredTools(view.ToolNames, r.Tools)
+	if len(view.UndeclaredTools) == 0 {
+		return
+	}
+	view.ToolNames = append(append([]string{}, view.ToolNames...), view.UndeclaredTools...)
+	view.ToolCount = len(view.ToolNames)
+}
+
+// undeclaredTools returns the live tools missing from declared. Servers that
+// declare no tools have nothing to compare against.
+func undeclaredTools(declared, live []string) []string {
+	if len(declared) == 0 {
+		return nil
+	}
+	var out []string
+	for _, t := range live {
+		if !containsString(declared, t) {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// checkMCPProbes compares live tool inventories with the tools declared on
+// RemoteMCPServers: undeclared tools (PROBE-001), declared tools the server
+// no longer serves (PROBE-002) and servers that could not be probed
+// (PROBE-003).
+func checkMCPProbes(state *ClusterState, policy Policy) []Finding {
+	if !policy.MCPProbe.Enabled || state.MCPProbes == nil {
+		return nil
+	}
+	var findings []Finding
+	ts := time.Now().Format(time.RFC3339)
+	add := func(code, severity, kind, ns, name, title, desc, impact, remediation string) {
+		findings = append(findings, Finding{
+			ID:          fmt.Sprintf("PROBE-%s-%s-%s", code, ns, name),
+			Severity:    severity,
+			Category:    CategoryToolScope,
+			Title:       title,
+			Description: desc,
+			Impact:      impact,
+			Remediation: remediation,
+			ResourceRef: fmt.Sprintf("%s/%s/%s", kind, ns, name),
+			Namespace:   ns,
+			Timestamp:   ts,
+		})
+	}
+	failed := func(kind, ns, name string, r mcpprobe.Result) {
+		add("003", SeverityLow, kind, ns, name,
+			fmt.Sprintf("MCP server '%s' could not be probed", name),
+			fmt.Sprintf("The live probe of %s (%s) failed: %s", r.URL, r.Transport, r.Reason),
+			"Its real tool inventory cannot be compared with the declared tools.",
+			"Make the endpoint reachable from the controller, or remove its namespace from mcpProbe.allowedNamespaces.")
+	}
+
+	for _, s := range state.KagentRemoteMCPServers {
+		r, ok := state.MCPProbes[fmt.Sprintf("KagentRemoteMCPServer/%s/%s", s.Namespace, s.Name)]
+		if !ok {
+			continue
+		}
+		if r.Status == mcpprobe.StatusError {
+			failed("RemoteMCPServer", s.Namespace, s.Name, r)
+			continue
+		}
+		if r.Status != mcpprobe.StatusOK {
+			continue
+		}
+		if undeclared := undeclaredTools(s.ToolNames, r.Tools); len(undeclared) > 0 {
+			add("001", SeverityHigh, "RemoteMCPServer", s.Namespace, s.Name,
+				fmt.Sprintf("MCP server '%s' serves undeclared tools", s.Name),
+				fmt.Sprintf("%s (%s %s) serves %d tool(s) not declared on RemoteMCPServer '%s/%s': %s",
+					r.URL, r.ServerLabel(), r.ProtocolVersion, len(undeclared), s.Namespace, s.Name, strings.Join(undeclared, ", ")),
+				"Undeclared tools escape review and tool-level authorization rules written against the declared inventory; they may be added or swapped by a compromised server.",
+				"Review the tools and refresh the RemoteMCPServer's discovered tools, or restrict the server with an AgentgatewayPolicy authorization rule that only admits the declared tools.")
+		}
+		var stale []string
+		for _, t := range s.ToolNames {
+			if !containsString(r.Tools, t) {
+				stale = append(stale, t)
+			}
+		}
+		if len(stale) > 0 {
+			sort.Strings(stale)
+			add("002", SeverityLow, "RemoteMCPServer", s.Namespace, s.Name,
+				fmt.Sprintf("MCP server '%s' no longer serves declared tools", s.Name),
+				fmt.Sprintf("%s does not list %d declared tool(s) of RemoteMCPServer '%s/%s': %s", r.URL, len(stale), s.Namespace, s.Name, strings.Join(stale, ", ")),
+				"The declared inventory is stale, so tool scope and authorization reviews are based on tools that do not exist.",
+				"Refresh the RemoteMCPServer's discovered tools and remove rules for tools that were retired.")
+		}
+	}
+	for _, s := range state.KagentMCPServers {
+		if r, ok := state.MCPProbes[fmt.Sprintf("KagentMCPServer/%s/%s", s.Namespace, s.Name)]; ok && r.Status == mcpprobe.StatusError {
+			failed("MCPServer", s.Namespace, s.Name, r)
+		}
+	}
+	return findings
+}
+
+// isProbeFinding reports whether a finding ID comes from the live probe
+// (PROBE-001..003); these lower the per-server ToolScope score.
+func isProbeFinding(id string) bool {
+	return strings.HasPrefix(id, "PROBE-")
+}
@@ -0,0 +1,151 @@
+package evaluator
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/mcpprobe"
+)
+
+func probeState(results map[string]mcpprobe.Result) *ClusterState {
+	return &ClusterState{
+		Namespaces: []string{"mcp", "other"},
+		KagentRemoteMCPServers: []KagentRemoteMCPServerResource{
+			{Name: "remote", Namespace: "mcp", URL: "http://remote.mcp:8080/sse", Protocol: "SSE",
+				ToolNames: []string{"read", "write"}, ToolCount: 2},
+			{Name: "elsewhere", Namespace: "other", URL: "http://elsewhere.other:8080/mcp"},
+		},
+		KagentMCPServers: []KagentMCPServerResource{
+			{Name: "local", Namespace: "mcp", Port: 3000, HasService: true},
+			{Name: "no-service", Namespace: "mcp", Port: 3000},
+		},
+		MCPProbes: results,
+	}
+}
+
+func probePolicy(namespaces ...string) Policy {
+	p := defaultPolicy()
+	p.MCPProbe = MCPProbePolicy{Enabled: true, AllowedNamespaces: namespaces}
+	return p
+}
+
+func TestMCPProbeTargets(t *testing.T) {
+	state := probeState(nil)
+
+	if targets := MCPProbeTargets(state, defaultPolicy()); targets != nil {
+		t.Errorf("probing disabled: targets = %+v", targets)
+	}
+	if targets := MCPProbeTargets(state, probePolicy()); targets != nil {
+		t.Errorf("empty allowlist: targets = %+v", targets)
+	}
+
+	want := []mcpprobe.Target{
+		{ID: "KagentRemoteMCPServer/mcp/remote", URL: "http://remote.mcp:8080/sse", Transport: mcpprobe.TransportSSE},
+		{ID: "KagentMCPServer/mcp/local", URL: "http://local.mcp.svc:3000/mcp", Transport: mcpprobe.TransportStreamableHTTP},
+	}
+	if targets := MCPProbeTargets(state, probePolicy("mcp")); !reflect.DeepEqual(targets, want) {
+		t.Errorf("targets = %+v", targets)
+	}
+	if targets := MCPProbeTargets(state, probePolicy("*")); len(targets) != 3 {
+		t.Errorf("wildcard: targets = %+v", targets)
+	}
+}
+
+func TestMCPProbeTargets_NamespacePolicy(t *testing.T) {
+	state := probeState(nil)
+	p := defaultPolicy()
+	p.NamespacePolicies = map[string]Policy{"mcp": TightenPolicy(p, probePolicy("mcp"))}
+
+	want := []mcpprobe.Target{
+		{ID: "KagentRemoteMCPServer/mcp/remote", URL: "http://remote.mcp:8080/sse", Transport: mcpprobe.TransportSSE},
+		{ID: "KagentMCPServer/mcp/local", URL: "http://local.mcp.svc:3000/mcp", Transport: mcpprobe.TransportStreamableHTTP},
+	}
+	if targets := MCPProbeTargets(state, p); !reflect.DeepEqual(targets, want) {
+		t.Errorf("targets = %+v", targets)
+	}
+
+	// Enabling probing in a namespace policy still requires the namespace to
+	// be allowlisted.
+	p.NamespacePolicies = map[string]Policy{"other": TightenPolicy(p, probePolicy("mcp"))}
+	if targets := MCPProbeTargets(state, p); targets != nil {
+		t.Errorf("other namespace not allowed: targets = %+v", targets)
+	}
+}
+
+func TestUndeclaredTools(t *testing.T) {
+	if got := undeclaredTools(nil, []string{"read"}); got != nil {
+		t.Errorf("nothing declared: undeclared = %v", got)
+	}
+	if got := undeclaredTools([]string{"read"}, []string{"write", "read"}); !reflect.DeepEqual(got, []string{"write"}) {
+		t.Errorf("undeclared = %v", got)
+	}
+}
+
+func TestCheckMCPProbes(t *testing.T) {
+	state := probeState(map[string]mcpprobe.Result{
+		"KagentRemoteMCPServer/mcp/remote": {Status: mcpprobe.StatusOK, URL: "http://remote.mcp:8080/sse",
+			ServerName: "remote", ServerVersion: "1.0", Tools: []string{"exec", "read"}},
+		"KagentMCPServer/mcp/local": {Status: mcpprobe.StatusError, Reason: "connection refused"},
+	})
+
+	if f := checkMCPProbes(state, defaultPolicy()); len(f) != 0 {
+		t.Errorf("probing disabled: findings = %v", f)
+	}
+
+	got := map[string]Finding{}
+	for _, f := range checkMCPProbes(state, probePolicy("mcp")) {
+		got[f.ID] = f
+	}
+	want := map[string]string{
+		"PROBE-001-mcp-remote": SeverityHigh,
+		"PROBE-002-mcp-remote": SeverityLow,
+		"PROBE-003-mcp-local":  SeverityLow,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("findings = %v", got)
+	}
+	for id, sev := range want {
+		if f, ok := got[id]; !ok || f.Severity != sev || f.Category != CategoryToolScope {
+			t.Errorf("%s = %+v", id, f)
+		}
+	}
+	if ref := got["PROBE-001-mcp-remote"].ResourceRef; ref != "RemoteMCPServer/mcp/remote" {
+		t.Errorf("PROBE-001 resourceRef = %s", ref)
+	}
+	if ref := got["PROBE-003-mcp-local"].ResourceRef; ref != "MCPServer/mcp/local" {
+		t.Errorf("PROBE-003 resourceRef = %s", ref)
+	}
+
+	// An unauthorized answer is reported by the auth checks, not as a probe failure.
+	state.MCPProbes = map[string]mcpprobe.Result{"KagentMCPServer/mcp/local": {Status: mcpprobe.StatusUnauthorized}}
+	if f := checkMCPProbes(state, probePolicy("mcp")); len(f) != 0 {
+		t.Errorf("unauthorized: findings = %v", f)
+	}
+}
+
+func TestBuildMCPServerViews_LiveProbe(t *testing.T) {
+	state := probeState(map[string]mcpprobe.Result{
+		"KagentRemoteMCPServer/mcp/remote": {Status: mcpprobe.StatusOK, Tools: []string{"delete_all", "read", "write"}},
+		"KagentMCPServer/mcp/local":        {Status: mcpprobe.StatusOK, Tools: []string{"search"}},
+	})
+
+	views := map[string]MCPServerView{}
+	for _, v := range BuildMCPServerViews(state, nil, probePolicy("mcp")) {
+		views[v.ID] = v
+	}
+	remote := views["KagentRemoteMCPServer/mcp/remote"]
+	if remote.LiveProbe == nil || !reflect.DeepEqual(remote.UndeclaredTools, []string{"delete_all"}) ||
+		!reflect.DeepEqual(remote.ToolNames, []string{"read", "write", "delete_all"}) || remote.ToolCount != 3 {
+		t.Errorf("remote view: probe = %+v, undeclared = %v, tools = %v (%d)", remote.LiveProbe, remote.UndeclaredTools, remote.ToolNames, remote.ToolCount)
+	}
+	local := views["KagentMCPServer/mcp/local"]
+	if !reflect.DeepEqual(local.ToolNames, []string{"search"}) || local.ToolCount != 1 || local.UndeclaredTools != nil {
+		t.Errorf("local view: tools = %v (%d)", local.ToolNames, local.ToolCount)
+	}
+
+	for _, v := range BuildMCPServerViews(state, nil, defaultPolicy()) {
+		if v.LiveProbe != nil || v.UndeclaredTools != nil {
+			t.Errorf("probing disabled: %s has probe data", v.ID)
+		}
+	}
+}
@@ -2,7 +2,11 @@ package evaluator
 
 import (
 	"fmt"
+	"sort"
 	"strings"
+
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/imageverify"
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/mcpprobe"
 )
 
 // MCPServerView represents a unified view of an MCP server and all related resources.
@@ -28,6 +32,8 @@ type MCPServerView struct {
 	ToolsByRoute       map[string][]string         `json:"toolsByRoute,omitempty"` // Route name -> allowed tools for that route
 	ToolsByPolicy      map[string]map[string][]string `json:"toolsByPolicy,omitempty"` // Route name -> Policy name -> allowed tools
 	PathTools          map[string][]string         `json:"pathTools,omitempty"` // Path label (e.g., "/ro", "/rw") -> allowed tools
+	ToolSensitivity    map[string]string           `json:"toolSensitivity,omitempty"` // Tool name -> read/write/destructive/exec
+	ExposedToolRisk    ToolRiskSummary             `json:"exposedToolRisk"` // Sensitivity classes of the effective tools
 
 	// Related resources (populated by correlation)
 	RelatedBackends  []RelatedResource `json:"relatedBackends"`
@@ -47,7 +53,38 @@ type MCPServerView struct {
 	HasCORS              bool   `json:"hasCORS"`
 	HasRateLimit         bool   `json:"hasRateLimit"`
 	HasPromptGuard       bool   `json:"hasPromptGuard"`
-	HasWorkload          bool   `json:"hasWorkload"` // true if a Deployment/StatefulSet backing this MCP server was discovered
+	HasWorkload          bool   `json:"hasWorkload"` // true if a workload backing this MCP server was discovered
+
+	// Network segmentation of the backing workload (NetworkPolicy podSelector matching)
+	NetworkPolicies            []string `json:"networkPolicies"`            // NetworkPolicies selecting the workload's pods
+	IngressRestrictedToGateway bool     `json:"ingressRestrictedToGateway"` // only the gateway namespace may connect
+	EgressRestricted           bool     `json:"egressRestricted"`           // egress is isolated and not open to every destination
+
+	// Paths into the backing workload that do not traverse agentgateway
+	GatewayBypasses []GatewayBypass `json:"gatewayBypasses,omitempty"`
+
+	// Kubernetes RBAC of the backing workload's ServiceAccount
+	WorkloadRBAC *WorkloadRBAC `json:"workloadRBAC,omitempty"`
+
+	// Cosign verification of the backing workload's images (when enabled by policy)
+	ImageSignatures []imageverify.Result `json:"imageSignatures,omitempty"`
+
+	// Trivy Operator scan results of the backing workload (nil when no reports exist)
+	Vulnerabilities *WorkloadVulnerabilities `json:"vulnerabilities,omitempty"`
+
+	// Live initialize/tools/list probe of the endpoint (when enabled by policy)
+	LiveProbe       *mcpprobe.Result `json:"liveProbe,omitempty"`
+	UndeclaredTools []string         `json:"undeclaredTools,omitempty"` // served live but not declared on the resource
+
+	// Unauthenticated and invalid-token requests sent through the gateway and
+	// to the backend (when enabled by policy), and whether the Authentication
+	// score is inferred from configuration or verified by them
+	AuthProbes     []AuthProbe `json:"authProbes,omitempty"`
+	AuthConfidence string      `json:"authConfidence"`
+
+	// Ownership (resolved from Policy.Ownership label/annotation keys)
+	Owner       string `json:"owner"`
+	OwnerSource string `json:"ownerSource,omitempty"` // Kind/ns/name of the object the owner was read from
 
 	// Scoring
 	Score             int                      `json:"score"`
@@ -78,6 +115,8 @@ type MCPServerScoreBreakdown struct {
 	PromptGuard        int `json:"promptGuard"`
 	ToolScope          int `json:"toolScope"`
 	HardeningScore     int `json:"hardenedDeployment"`
+	WorkloadRBAC       int `json:"workloadRBAC"`
+	Vulnerabilities    int `json:"vulnerabilities"`
 }
 
 // ScoreExplanation describes how a single security control score was calculated.
@@ -118,6 +157,8 @@ func BuildMCPServerViews(state *ClusterState, findings []Finding, policy Policy)
 			Transport: mcp.Transport,
 			Port:      mcp.Port,
 		}
+		applyLiveProbe(&view, state, policy)
+		applyAuthProbes(&view, state, policy)
 		correlateMCPServer(&view, state, findings, policy)
 		views = append(views, view)
 	}
@@ -133,10 +174,17 @@ func BuildMCPServerViews(state *ClusterState, findings []Finding, policy Policy)
 			ToolCount: rms.ToolCount,
 			ToolNames: rms.ToolNames,
 		}
+		applyLiveProbe(&view, state, policy)
+		applyAuthProbes(&view, state, policy)
 		correlateMCPServer(&view, state, findings, policy)
 		views = append(views, view)
 	}
 
+	owners := newOwnerResolver(state, policy.Ownership)
+	for i := range views {
+		views[i].Owner, views[i].OwnerSource = owners.resolve(mcpServerViewKind(views[i].Source), views[i].Namespace, views[i].Name)
+	}
+
 	return views
 }
 
@@ -257,6 +305,7 @@ func correlateMCPServer(view *MCPServerView, state *ClusterState, findings []Fin
 				Details: map[string]interface{}{
 					"gatewayClassName": gw.GatewayClassName,
 					"programmed":       gw.Programmed,
+					"listeners":        gw.Listeners,
 				},
 			})
 			if gw.GatewayClassName == "agentgateway" && len(view.RelatedBackends) > 0 {
@@ -296,6 +345,20 @@ func correlateMCPServer(view *MCPServerView, state *ClusterState, findings []Fin
 			related = true
 		}
 		if related {
+			// Resolve the parsed authorization rules against this server's
+			// tools, so prefix matches, exclusions and Deny rules count.
+			allowedTools := p.AllowedTools
+			var toolAccess []ToolAccess
+			if p.Authorization != nil {
+				var restricted bool
+				toolAccess, restricted = p.Authorization.ResolveTools(view.ToolNames)
+				allowedTools = nil
+				if restricted {
+					for _, t := range toolAccess {
+						allowedTools = append(allowedTools, t.Tool)
+					}
+				}
+			}
 			view.RelatedPolicies = append(view.RelatedPolicies, RelatedResource{
 				Kind:      "AgentgatewayPolicy",
 				Name:      p.Name,
@@ -308,7 +371,13 @@ func correlateMCPServer(view *MCPServerView, state *ClusterState, findings []Fin
 					"hasRBAC":       p.HasRBAC,
 					"hasRateLimit":  p.HasRateLimit,
 					"hasPromptGuard": p.HasPromptGuard,
-					"allowedTools":  p.AllowedTools,
+					"allowedTools":  allowedTools,
+					"toolAccess":    toolAccess,
+					"authorization": p.Authorization,
+					"jwtProviders":  p.JWTProviders,
+					"cors":          p.CORS,
+					"rateLimits":    p.RateLimits,
+					"promptGuardRules": p.PromptGuardRules,
 				},
 			})
 			if p.HasJWT {
@@ -328,9 +397,9 @@ func correlateMCPServer(view *MCPServerView, state *ClusterState, findings []Fin
 				view.HasPromptGuard = true
 			}
 			// Collect allowed tools from authorization policies
-			if len(p.AllowedTools) > 0 {
+			if len(allowedTools) > 0 {
 				view.HasToolRestriction = true
-				view.EffectiveToolNames = append(view.EffectiveToolNames, p.AllowedTools...)
+				view.EffectiveToolNames = append(view.EffectiveToolNames, allowedTools...)
 			}
 		}
 	}
@@ -427,6 +496,7 @@ func correlateMCPServer(view *MCPServerView, state *ClusterState, findings []Fin
 				for tool := range pti.tools {
 					toolList = append(toolList, tool)
 				}
+				sort.Strings(toolList)
 				view.ToolsByPolicy[routeName][pti.name] = toolList
 				
 				// Map tools to actual HTTPRoute path (if available)
@@ -540,28 +610,30 @@ func correlateMCPServer(view *MCPServerView, state *ClusterState, findings []Fin
 	// --- Check if a backing workload (Deployment/StatefulSet) exists for this MCP server ---
 	// For KagentMCPServers, the workload is expected to share the same name and namespace.
 	// For RemoteMCPServers, we match by the deployment name extracted from the URL.
-	for _, w := range state.Workloads {
-		if w.Name == view.Name && w.Namespace == view.Namespace {
-			view.HasWorkload = true
-			break
+	if w, ok := backingWorkload(state, view); ok {
+		view.HasWorkload = true
+		exposure := workloadNetworkExposure(state, w)
+		if len(exposure.Policies) > 0 {
+			view.NetworkPolicies = exposure.Policies
 		}
-		// Also match by deployment name derived from a RemoteMCPServer URL
-		if view.Source == "KagentRemoteMCPServer" && view.URL != "" {
-			if parts := strings.Split(view.URL, "://"); len(parts) > 1 {
-				hostPort := parts[1]
-				if p := strings.Split(hostPort, "/"); len(p) > 0 {
-					hostPort = p[0]
-				}
-				if p := strings.Split(hostPort, "."); len(p) > 0 {
-					if w.Name == p[0] {
-						view.HasWorkload = true
-						break
-					}
-				}
-			}
+		view.IngressRestrictedToGateway = exposure.IngressRestrictedToGateway
+		view.EgressRestricted = exposure.EgressRestricted
+		view.GatewayBypasses = workloadGatewayBypasses(state, w)
+		rbac := analyzeWorkloadRBAC(state, w)
+		view.WorkloadRBAC = &rbac
+		if policy.ImageVerification.Enabled {
+			view.ImageSignatures = workloadImageSignatures(state, w)
+		}
+		if len(state.VulnerabilityReports) > 0 {
+			vulns := analyzeWorkloadVulnerabilities(state, w)
+			view.Vulnerabilities = &vulns
 		}
 	}
 
+	// --- Classify tools as read/write/destructive/exec ---
+	nsPolicy := policy.ForNamespace(view.Namespace)
+	classifyViewTools(view, nsPolicy.ToolSensitivity)
+
 	// --- Collect findings for this MCP server ---
 	view.Findings = collectMCPServerFindings(view, findings)
 	if view.Findings == nil {
@@ -569,7 +641,7 @@ func correlateMCPServer(view *MCPServerView, state *ClusterState, findings []Fin
 	}
 
 	// --- Score this MCP server ---
-	scoreMCPServer(view, policy)
+	scoreMCPServer(view, nsPolicy)
 }
 
 // ensureNonNilSlices makes sure all slice fields are non-nil (for clean JSON encoding).
@@ -598,6 +670,9 @@ func ensureNonNilSlices(view *MCPServerView) {
 	if view.EffectiveToolNames == nil {
 		view.EffectiveToolNames = []string{}
 	}
+	if view.NetworkPolicies == nil {
+		view.NetworkPolicies = []string{}
+	}
 }
 
 // collectMCPServerFindings gathers findings relevant to this MCP server.
@@ -653,6 +728,10 @@ func collectMCPServerFindings(view *MCPServerView, allFindings []Finding) []Find
 		if view.HasToolRestriction && strings.HasPrefix(f.ID, "TOOLS-001-") && strings.Contains(f.ID, view.Name) {
 			continue
 		}
+		// Likewise TOOLS-002 when the policy leaves no destructive/exec tool exposed
+		if sensitiveToolsRestricted(view) && strings.HasPrefix(f.ID, "TOOLS-002-") && strings.Contains(f.ID, view.Name) {
+			continue
+		}
 
 		// Suppress EXP-001 if the MCP server is actually routed through gateway
 		// (the cluster-level evaluator checks URL-based routing, but correlation
@@ -697,6 +776,35 @@ func collectMCPServerFindings(view *MCPServerView, allFindings []Finding) []Find
 	return result
 }
 
+// breakdownField returns the per-server score field a finding category counts toward.
+func breakdownField(bd *MCPServerScoreBreakdown, category string) *int {
+	switch category {
+	case CategoryAgentGateway, CategoryExposure:
+		return &bd.GatewayRouting
+	case CategoryAuthentication:
+		return &bd.Authentication
+	case CategoryAuthorization:
+		return &bd.Authorization
+	case CategoryTLS:
+		return &bd.TLS
+	case CategoryCORS:
+		return &bd.CORS
+	case CategoryRateLimit:
+		return &bd.RateLimit
+	case CategoryPromptGuard:
+		return &bd.PromptGuard
+	case CategoryToolScope:
+		return &bd.ToolScope
+	case CategoryHardening:
+		return &bd.HardeningScore
+	case CategoryWorkloadRBAC:
+		return &bd.WorkloadRBAC
+	case CategoryVulnerabilities:
+		return &bd.Vulnerabilities
+	}
+	return nil
+}
+
 func isClusterWideFinding(f Finding) bool {
 	switch f.ID {
 	case "AGW-001", "AGW-003", "AGW-004", "AUTH-002", "CORS-001", "CORS-002",
@@ -719,19 +827,30 @@ func scoreMCPServer(view *MCPServerView, policy Policy) {
 		PromptGuard:     100,
 		ToolScope:       100,
 		HardeningScore:  100,
+		WorkloadRBAC:    100,
+		Vulnerabilities: 100,
 	}
 
 	// Gateway routing
-	if policy.RequireAgentGateway {
+	if policy.RequireAgentGateway && !policy.checkDisabled("AGW-100") {
 		if !view.RoutedThroughGateway {
 			bd.GatewayRouting = 0
 		} else if len(view.RelatedBackends) == 0 {
 			bd.GatewayRouting = 30
 		}
 	}
+	// A direct path around the gateway undermines routing even when a gateway route exists
+	for _, f := range view.Findings {
+		if isGatewayBypassFinding(f.ID) {
+			bd.GatewayRouting -= policy.findingPenalty(f)
+		}
+	}
+	if bd.GatewayRouting < 0 {
+		bd.GatewayRouting = 0
+	}
 
 	// Authentication
-	if policy.RequireJWTAuth {
+	if policy.RequireJWTAuth && !policy.checkDisabled("AUTH-002") {
 		if !view.HasJWT && !view.HasAuth {
 			bd.Authentication = 0
 		} else if view.HasJWT && view.JWTMode == "Optional" {
@@ -740,11 +859,11 @@ func scoreMCPServer(view *MCPServerView, policy Policy) {
 			bd.Authentication = 70
 		}
 	}
-	// Tier 2 #18: penalise for overly-broad JWT audience (AUTH-005) even when JWT is present.
-	// AUTH-005 is High severity → deduct 25 points per occurrence so the score reflects the issue.
+	// Tier 2 #18: penalise for overly-broad JWT audience (AUTH-005) and the JWT
+	// provider issues AUTH-006..010 even when JWT is present.
 	for _, f := range view.Findings {
-		if strings.HasPrefix(f.ID, "AUTH-005-") {
-			bd.Authentication -= severityPenalty(f.Severity, policy.SeverityPenalties)
+		if isJWTQualityFinding(f.ID) || isAuthBypassFinding(f.ID) {
+			bd.Authentication -= policy.findingPenalty(f)
 		}
 	}
 	if bd.Authentication < 0 {
@@ -752,23 +871,33 @@ func scoreMCPServer(view *MCPServerView, policy Policy) {
 	}
 
 	// Authorization
-	if policy.RequireRBAC {
+	if policy.RequireRBAC && !policy.checkDisabled("RBAC-001") {
 		if !view.HasRBAC {
 			bd.Authorization = 0
 		}
 	}
+	// Penalise tautological, contradictory, unreachable or invalid
+	// authorization rules (RBAC-003..006) even when RBAC is present.
+	for _, f := range view.Findings {
+		if isAuthorizationRuleFinding(f.ID) {
+			bd.Authorization -= policy.findingPenalty(f)
+		}
+	}
+	if bd.Authorization < 0 {
+		bd.Authorization = 0
+	}
 
 	// TLS
-	if policy.RequireTLS {
+	if policy.RequireTLS && !policy.checkDisabled("TLS-001") {
 		if !view.HasTLS {
 			bd.TLS = 0
 		}
 	}
-	// Tier 2 #19: penalise for one-way TLS (TLS-003) even when TLS is present.
-	// TLS-003 is Medium severity → deduct 15 points per occurrence.
+	// Tier 2 #19: penalise for one-way TLS (TLS-003) even when TLS is present,
+	// and for Gateway listener issues (TLS-004..006) on the client side.
 	for _, f := range view.Findings {
-		if strings.HasPrefix(f.ID, "TLS-003-") {
-			bd.TLS -= severityPenalty(f.Severity, policy.SeverityPenalties)
+		if isTLSQualityFinding(f.ID) {
+			bd.TLS -= policy.findingPenalty(f)
 		}
 	}
 	if bd.TLS < 0 {
@@ -776,32 +905,59 @@ func scoreMCPServer(view *MCPServerView, policy Policy) {
 	}
 
 	// CORS
-	if policy.RequireCORS {
+	if policy.RequireCORS && !policy.checkDisabled("CORS-001") {
 		if !view.HasCORS {
 			bd.CORS = 0
 		}
 	}
+	// Penalise permissive CORS settings (CORS-004..005) even when CORS is present.
+	for _, f := range view.Findings {
+		if isCORSQualityFinding(f.ID) {
+			bd.CORS -= policy.findingPenalty(f)
+		}
+	}
+	if bd.CORS < 0 {
+		bd.CORS = 0
+	}
 
 	// Rate Limit
 	// Score 100 only if configured, otherwise 0 (feature not deployed)
 	// If not required by policy, it still counts toward weighted score but at 0
-	if !view.HasRateLimit {
+	if !view.HasRateLimit && !policy.checkDisabled("RL-001") {
+		bd.RateLimit = 0
+	}
+	// Penalise limits above the policy ceiling or without any limit (RL-003..004).
+	for _, f := range view.Findings {
+		if isRateLimitQualityFinding(f.ID) {
+			bd.RateLimit -= policy.findingPenalty(f)
+		}
+	}
+	if bd.RateLimit < 0 {
 		bd.RateLimit = 0
 	}
 
 	// Prompt Guard
 	// Score 100 only if configured, otherwise 0 (feature not deployed)
 	// If not required by policy, it still counts toward weighted score but at 0
-	if !view.HasPromptGuard {
+	if !view.HasPromptGuard && !policy.checkDisabled("PG-001") {
+		bd.PromptGuard = 0
+	}
+	// Penalise guards that never reject, skip responses or miss required
+	// builtins (PG-003..005).
+	for _, f := range view.Findings {
+		if isPromptGuardQualityFinding(f.ID) {
+			bd.PromptGuard -= policy.findingPenalty(f)
+		}
+	}
+	if bd.PromptGuard < 0 {
 		bd.PromptGuard = 0
 	}
 
 	// Tool Scope - score based on effective tool count (after policy restrictions)
 	// An MCP server with 0 tools is not properly configured
 	if view.ToolCount == 0 {
-		bd.ToolScope = 0
-		// Add a finding for 0-tools
-		view.Findings = append(view.Findings, Finding{
+		// Add a finding for 0-tools (unless disabled via spec.checkOverrides)
+		if f, ok := policy.overrideFinding(Finding{
 			ID:          fmt.Sprintf("TOOLS-000-%s", view.Name),
 			Severity:    SeverityHigh,
 			Category:    "Tool Governance",
@@ -811,12 +967,38 @@ func scoreMCPServer(view *MCPServerView, policy Policy) {
 			Remediation: "Ensure the MCP server exposes tools and that tool discovery is working correctly. Verify the MCP server spec.tools or spec.toolsets configuration.",
 			ResourceRef: view.ID,
 			Namespace:   view.Namespace,
-		})
+		}); ok {
+			bd.ToolScope = 0
+			view.Findings = append(view.Findings, f)
+		}
+	} else if policy.checkDisabled("TOOLS-001") {
+		// Tool-count thresholds disabled via spec.checkOverrides
 	} else if policy.MaxToolsCritical > 0 && view.EffectiveToolCount > policy.MaxToolsCritical {
 		bd.ToolScope = 0
 	} else if policy.MaxToolsWarning > 0 && view.EffectiveToolCount > policy.MaxToolsWarning {
 		bd.ToolScope = 50
 	}
+	// Risk-weight the exposed tools: destructive/exec tools cost more, and more
+	// again when no RBAC policy restricts who can call them (TOOLS-002).
+	toolRiskPenalty := 0
+	if view.ToolCount > 0 && !policy.checkDisabled("TOOLS-002") {
+		toolRiskPenalty = policy.ToolSensitivity.exposurePenalty(view.ExposedToolRisk, view.HasRBAC)
+		bd.ToolScope -= toolRiskPenalty
+		if bd.ToolScope < 0 {
+			bd.ToolScope = 0
+		}
+	}
+	// Live probe: undeclared, stale or unprobeable tool inventories (PROBE-*)
+	probePenalty := 0
+	for _, f := range view.Findings {
+		if isProbeFinding(f.ID) {
+			probePenalty += policy.findingPenalty(f)
+		}
+	}
+	bd.ToolScope -= probePenalty
+	if bd.ToolScope < 0 {
+		bd.ToolScope = 0
+	}
 
 	// Hardening Score — derived from HDN-* findings for this server's namespace/name
 	if policy.RequireHardenedDeployment {
@@ -827,33 +1009,38 @@ func scoreMCPServer(view *MCPServerView, policy Policy) {
 		// This handles the case where the cluster has other workloads (so HDN-000 is not
 		// emitted cluster-wide) but this particular MCP server has no Deployment/StatefulSet.
 		if !isRemote && !view.HasWorkload {
-			bd.HardeningScore = 0
-			view.Findings = append(view.Findings, Finding{
+			f, ok := policy.overrideFinding(Finding{
 				ID:          fmt.Sprintf("HDN-000-%s", view.Name),
 				Severity:    SeverityHigh,
 				Category:    CategoryHardening,
 				Title:       fmt.Sprintf("No workload found for MCP server '%s'", view.Name),
-				Description: fmt.Sprintf("No Deployment or StatefulSet named '%s' was discovered in namespace '%s'. Hardening checks require a backing workload to evaluate container security posture.", view.Name, view.Namespace),
+				Description: fmt.Sprintf("No workload named '%s' was discovered in namespace '%s'. Hardening checks require a backing workload to evaluate container security posture.", view.Name, view.Namespace),
 				Impact:      "Container security posture cannot be assessed. The MCP server may not be deployed or may be using a different resource name.",
 				Remediation: fmt.Sprintf("Deploy the MCP server as a Deployment or StatefulSet named '%s' in namespace '%s', or verify the workload is in an evaluated namespace.", view.Name, view.Namespace),
 				ResourceRef: view.ID,
 				Namespace:   view.Namespace,
 			})
+			if ok {
+				bd.HardeningScore = 0
+				view.Findings = append(view.Findings, f)
+			}
 		} else {
 			bd.HardeningScore = 100
 			hdnPenalty := 0
 			for _, f := range view.Findings {
 				if f.Category == CategoryHardening {
+					penalty := 0
 					switch f.Severity {
 					case SeverityCritical:
-						hdnPenalty += 40
+						penalty = 40
 					case SeverityHigh:
-						hdnPenalty += 25
+						penalty = 25
 					case SeverityMedium:
-						hdnPenalty += 15
+						penalty = 15
 					case SeverityLow:
-						hdnPenalty += 5
+						penalty = 5
 					}
+					hdnPenalty += policy.overridePenalty(f, penalty)
 				}
 			}
 			bd.HardeningScore -= hdnPenalty
@@ -863,6 +1050,44 @@ func scoreMCPServer(view *MCPServerView, policy Policy) {
 		}
 	}
 
+	// Workload RBAC — KRBAC-* findings on the ServiceAccount of this server's workload
+	if policy.RequireWorkloadRBAC {
+		for _, f := range view.Findings {
+			if strings.HasPrefix(f.ID, "KRBAC-") {
+				bd.WorkloadRBAC -= policy.findingPenalty(f)
+			}
+		}
+		if bd.WorkloadRBAC < 0 {
+			bd.WorkloadRBAC = 0
+		}
+	}
+
+	// Vulnerabilities — VULN-* findings from the Trivy reports of this server's workload
+	if policy.Vulnerabilities.Enabled {
+		for _, f := range view.Findings {
+			if strings.HasPrefix(f.ID, "VULN-") {
+				bd.Vulnerabilities -= policy.findingPenalty(f)
+			}
+		}
+		if bd.Vulnerabilities < 0 {
+			bd.Vulnerabilities = 0
+		}
+	}
+
+	// Custom rule findings deduct from the category they declare.
+	// Hardening findings are already covered by the HDN penalty loop above.
+	for _, f := range view.Findings {
+		if !strings.HasPrefix(f.ID, "CUSTOM-") || strings.HasPrefix(f.ID, "CUSTOM-000-") || f.Category == CategoryHardening {
+			continue
+		}
+		if field := breakdownField(&bd, f.Category); field != nil {
+			*field -= policy.findingPenalty(f)
+			if *field < 0 {
+				*field = 0
+			}
+		}
+	}
+
 	view.ScoreBreakdown = bd
 
 	// Weighted average using policy weights
@@ -883,8 +1108,10 @@ func scoreMCPServer(view *MCPServerView, policy Policy) {
 		{bd.CORS, w.CORSPolicy, policy.RequireCORS},
 		{bd.RateLimit, w.RateLimit, policy.RequireRateLimit},
 		{bd.PromptGuard, w.PromptGuard, policy.RequirePromptGuard},
-		{bd.ToolScope, w.ToolScope, policy.MaxToolsWarning > 0 || policy.MaxToolsCritical > 0 || view.ToolCount == 0},
+		{bd.ToolScope, w.ToolScope, policy.MaxToolsWarning > 0 || policy.MaxToolsCritical > 0 || view.ToolCount == 0 || toolRiskPenalty > 0 || probePenalty > 0},
 		{bd.HardeningScore, w.HardenedDeployment, policy.RequireHardenedDeployment},
+		{bd.WorkloadRBAC, w.WorkloadRBAC, policy.RequireWorkloadRBAC && view.WorkloadRBAC != nil},
+		{bd.Vulnerabilities, w.Vulnerabilities, policy.Vulnerabilities.Enabled && view.Vulnerabilities != nil},
 	}
 
 	for _, e := range entries {
@@ -942,6 +1169,9 @@ func ComputeSuppressedFindingIDs(views []MCPServerView, allFindings []Finding) m
 			if view.HasToolRestriction && strings.HasPrefix(f.ID, "TOOLS-001-") && strings.Contains(f.ID, view.Name) {
 				suppressed[f.ID] = true
 			}
+			if sensitiveToolsRestricted(&view) && strings.HasPrefix(f.ID, "TOOLS-002-") && strings.Contains(f.ID, view.Name) {
+				suppressed[f.ID] = true
+			}
 			if view.RoutedThroughGateway && strings.HasPrefix(f.ID, "EXP-001-") && strings.Contains(f.ID, view.Name) {
 				suppressed[f.ID] = true
 			}
@@ -956,6 +1186,12 @@ func ComputeSuppressedFindingIDs(views []MCPServerView, allFindings []Finding) m
 	return suppressed
 }
 
+// sensitiveToolsRestricted reports whether a tool restriction policy leaves
+// none of the server's destructive or exec tools exposed.
+func sensitiveToolsRestricted(view *MCPServerView) bool {
+	return view.HasToolRestriction && view.ExposedToolRisk.Destructive+view.ExposedToolRisk.Exec == 0
+}
+
 // FilterFindings removes suppressed findings from a raw findings slice.
 func FilterFindings(findings []Finding, suppressed map[string]bool) []Finding {
 	if len(suppressed) == 0 {
@@ -1073,6 +1309,13 @@ func buildScoreExplanations(view *MCPServerView, policy Policy) []ScoreExplanati
 				exp.Reasons = append(exp.Reasons, "MCP server is NOT routed through agentgateway.")
 				exp.Suggestions = append(exp.Suggestions, "Create a Gateway (agentgateway class), AgentgatewayBackend, and HTTPRoute to proxy traffic through agentgateway.")
 			}
+			for _, b := range view.GatewayBypasses {
+				exp.Reasons = append(exp.Reasons, fmt.Sprintf("%s '%s' (%s) reaches the MCP server directly at %s.", b.Kind, b.Name, b.Via, strings.Join(b.Hosts, ", ")))
+				exp.Sources = append(exp.Sources, fmt.Sprintf("%s/%s/%s", b.Kind, b.Namespace, b.Name))
+			}
+			if len(view.GatewayBypasses) > 0 {
+				exp.Suggestions = append(exp.Suggestions, "Expose the MCP server Service only as ClusterIP and publish it exclusively through agentgateway.")
+			}
 		}
 		explanations = append(explanations, exp)
 	}
@@ -1107,9 +1350,12 @@ func buildScoreExplanations(view *MCPServerView, policy Policy) []ScoreExplanati
 				exp.Reasons = append(exp.Reasons, "No authentication is configured.")
 				exp.Suggestions = append(exp.Suggestions, "Create an AgentgatewayPolicy with traffic.jwtAuthentication targeting your Gateway or HTTPRoute.")
 			}
-			// Tier 2 #18: surface AUTH-005 audience-scope issues in the pop-up
+			if view.AuthConfidence == AuthConfidenceVerified {
+				exp.Reasons = append(exp.Reasons, authProbeSummary(view.AuthProbes))
+			}
+			// Tier 2 #18: surface AUTH-005 audience-scope and JWT provider issues in the pop-up
 			for _, f := range view.Findings {
-				if strings.HasPrefix(f.ID, "AUTH-005-") {
+				if isJWTQualityFinding(f.ID) || isAuthBypassFinding(f.ID) {
 					exp.Reasons = append(exp.Reasons, fmt.Sprintf("[%s] %s", f.Severity, f.Title))
 					exp.Suggestions = append(exp.Suggestions, f.Remediation)
 				}
@@ -1140,6 +1386,12 @@ func buildScoreExplanations(view *MCPServerView, policy Policy) []ScoreExplanati
 				exp.Reasons = append(exp.Reasons, "No RBAC authorization is configured.")
 				exp.Suggestions = append(exp.Suggestions, "Add an AgentgatewayPolicy with traffic.authorization using CEL matchExpressions for tool-level access control.")
 			}
+			for _, f := range view.Findings {
+				if isAuthorizationRuleFinding(f.ID) {
+					exp.Reasons = append(exp.Reasons, fmt.Sprintf("[%s] %s", f.Severity, f.Title))
+					exp.Suggestions = append(exp.Suggestions, f.Remediation)
+				}
+			}
 		}
 		explanations = append(explanations, exp)
 	}
@@ -1163,9 +1415,9 @@ func buildScoreExplanations(view *MCPServerView, policy Policy) []ScoreExplanati
 				exp.Reasons = append(exp.Reasons, "No TLS encryption is configured.")
 				exp.Suggestions = append(exp.Suggestions, "Add spec.policies.tls with an SNI to the AgentgatewayBackend for encrypted backend connections.")
 			}
-			// Tier 2 #19: surface TLS-003 mTLS issues in the pop-up
+			// Tier 2 #19: surface TLS-003 mTLS and Gateway listener issues in the pop-up
 			for _, f := range view.Findings {
-				if strings.HasPrefix(f.ID, "TLS-003-") {
+				if isTLSQualityFinding(f.ID) {
 					exp.Reasons = append(exp.Reasons, fmt.Sprintf("[%s] %s", f.Severity, f.Title))
 					exp.Suggestions = append(exp.Suggestions, f.Remediation)
 				}
@@ -1215,6 +1467,12 @@ func buildScoreExplanations(view *MCPServerView, policy Policy) []ScoreExplanati
 				exp.Reasons = append(exp.Reasons, "No CORS policy is configured.")
 				exp.Suggestions = append(exp.Suggestions, "Add traffic.cors to an AgentgatewayPolicy or add a CORS filter to the HTTPRoute.")
 			}
+			for _, f := range view.Findings {
+				if isCORSQualityFinding(f.ID) {
+					exp.Reasons = append(exp.Reasons, fmt.Sprintf("[%s] %s", f.Severity, f.Title))
+					exp.Suggestions = append(exp.Suggestions, f.Remediation)
+				}
+			}
 		}
 		explanations = append(explanations, exp)
 	}
@@ -1238,6 +1496,12 @@ func buildScoreExplanations(view *MCPServerView, policy Policy) []ScoreExplanati
 				exp.Reasons = append(exp.Reasons, "No rate limiting is configured.")
 				exp.Suggestions = append(exp.Suggestions, "Add traffic.rateLimit.local to an AgentgatewayPolicy to enforce request rate limits.")
 			}
+			for _, f := range view.Findings {
+				if isRateLimitQualityFinding(f.ID) {
+					exp.Reasons = append(exp.Reasons, fmt.Sprintf("[%s] %s", f.Severity, f.Title))
+					exp.Suggestions = append(exp.Suggestions, f.Remediation)
+				}
+			}
 		}
 		explanations = append(explanations, exp)
 	}
@@ -1261,6 +1525,12 @@ func buildScoreExplanations(view *MCPServerView, policy Policy) []ScoreExplanati
 				exp.Reasons = append(exp.Reasons, "No prompt guard is configured.")
 				exp.Suggestions = append(exp.Suggestions, "Add backend.ai.promptGuard to an AgentgatewayPolicy with regex reject/mask patterns for injection protection.")
 			}
+			for _, f := range view.Findings {
+				if isPromptGuardQualityFinding(f.ID) {
+					exp.Reasons = append(exp.Reasons, fmt.Sprintf("[%s] %s", f.Severity, f.Title))
+					exp.Suggestions = append(exp.Suggestions, f.Remediation)
+				}
+			}
 		}
 		explanations = append(explanations, exp)
 	}
@@ -1272,7 +1542,17 @@ func buildScoreExplanations(view *MCPServerView, policy Policy) []ScoreExplanati
 			Score:    bd.ToolScope,
 			MaxScore: 100,
 		}
-		hasToolPolicy := policy.MaxToolsWarning > 0 || policy.MaxToolsCritical > 0
+		riskPenalty := 0
+		if !policy.checkDisabled("TOOLS-002") {
+			riskPenalty = policy.ToolSensitivity.exposurePenalty(view.ExposedToolRisk, view.HasRBAC)
+		}
+		var probeFindings []Finding
+		for _, f := range view.Findings {
+			if isProbeFinding(f.ID) {
+				probeFindings = append(probeFindings, f)
+			}
+		}
+		hasToolPolicy := policy.MaxToolsWarning > 0 || policy.MaxToolsCritical > 0 || riskPenalty > 0 || len(probeFindings) > 0
 		if !hasToolPolicy {
 			exp.Status = "not-required"
 			exp.Reasons = []string{"Tool scope limits are not configured in the governance policy."}
@@ -1295,6 +1575,24 @@ func buildScoreExplanations(view *MCPServerView, policy Policy) []ScoreExplanati
 				exp.Reasons = append(exp.Reasons, fmt.Sprintf("Effective tool count (%d) exceeds critical threshold (%d).", view.EffectiveToolCount, policy.MaxToolsCritical))
 				exp.Suggestions = append(exp.Suggestions, "Urgently restrict tool exposure via CEL authorization policies.")
 			}
+			if riskPenalty > 0 {
+				risk := view.ExposedToolRisk
+				reason := fmt.Sprintf("Exposed tools include %d write, %d destructive and %d exec tools (-%d points).", risk.Write, risk.Destructive, risk.Exec, riskPenalty)
+				if !view.HasRBAC {
+					reason += " Penalty multiplied because no RBAC policy restricts them."
+				}
+				exp.Reasons = append(exp.Reasons, reason)
+				if len(risk.Sensitive) > 0 {
+					exp.Suggestions = append(exp.Suggestions, fmt.Sprintf("Restrict the sensitive tools (%s) to the agents that need them with a CEL authorization rule.", strings.Join(risk.Sensitive, ", ")))
+				}
+			}
+			if view.LiveProbe != nil && view.LiveProbe.Status == mcpprobe.StatusOK {
+				exp.Reasons = append(exp.Reasons, fmt.Sprintf("Live probe of %s listed %d tools.", view.LiveProbe.URL, len(view.LiveProbe.Tools)))
+			}
+			for _, f := range probeFindings {
+				exp.Reasons = append(exp.Reasons, fmt.Sprintf("[%s] %s", f.Severity, f.Title))
+				exp.Suggestions = append(exp.Suggestions, f.Remediation)
+			}
 		}
 		explanations = append(explanations, exp)
 	}
@@ -1321,11 +1619,97 @@ func buildScoreExplanations(view *MCPServerView, policy Policy) []ScoreExplanati
 				}
 			}
 			if len(hdnReasons) == 0 {
-				exp.Reasons = []string{"All hardening checks passed: non-root, read-only FS, no priv escalation, cap drop, seccomp, NetworkPolicy, no :latest tag, secret store."}
+				exp.Reasons = []string{"All hardening checks passed: non-root, read-only FS, no priv escalation, cap drop, seccomp, NetworkPolicy, no :latest tag, secret store, no privileged or host access, resource limits, image signatures."}
 			} else {
 				exp.Reasons = hdnReasons
 				exp.Suggestions = hdnSuggestions
 			}
+			if view.HasWorkload {
+				if view.IngressRestrictedToGateway {
+					exp.Reasons = append(exp.Reasons, "Ingress is restricted to the agentgateway namespace by NetworkPolicy.")
+				} else {
+					exp.Suggestions = append(exp.Suggestions, "Restrict ingress to the agentgateway namespace with a NetworkPolicy selecting this workload, so clients cannot bypass the gateway.")
+				}
+				if view.EgressRestricted {
+					exp.Reasons = append(exp.Reasons, "Egress is restricted by NetworkPolicy.")
+				} else {
+					exp.Suggestions = append(exp.Suggestions, "Add an Egress NetworkPolicy for this workload that allows only the destinations the MCP server needs.")
+				}
+				verified := 0
+				for _, sig := range view.ImageSignatures {
+					if sig.Status == imageverify.StatusVerified {
+						verified++
+					}
+				}
+				if verified > 0 && verified == len(view.ImageSignatures) {
+					exp.Reasons = append(exp.Reasons, fmt.Sprintf("All %d image(s) carry a cosign signature from a trusted key.", verified))
+				}
+			}
+		}
+		explanations = append(explanations, exp)
+	}
+
+	// 10. Workload RBAC
+	{
+		exp := ScoreExplanation{
+			Category: "Workload RBAC",
+			Score:    bd.WorkloadRBAC,
+			MaxScore: 100,
+		}
+		if !policy.RequireWorkloadRBAC {
+			exp.Status = "not-required"
+			exp.Reasons = []string{"Workload RBAC checks are not required by the governance policy."}
+		} else if view.WorkloadRBAC == nil {
+			exp.Status = "not-required"
+			exp.Reasons = []string{"No backing workload was discovered, so its ServiceAccount cannot be assessed."}
+		} else {
+			exp.Status = statusFor(bd.WorkloadRBAC)
+			exp.Sources = []string{"ServiceAccount/" + view.Namespace + "/" + view.WorkloadRBAC.ServiceAccount}
+			for _, f := range view.Findings {
+				if strings.HasPrefix(f.ID, "KRBAC-") {
+					exp.Reasons = append(exp.Reasons, fmt.Sprintf("[%s] %s", f.Severity, f.Title))
+					exp.Suggestions = append(exp.Suggestions, f.Remediation)
+				}
+			}
+			if len(exp.Reasons) == 0 {
+				if view.WorkloadRBAC.HasAPIAccess() {
+					exp.Reasons = []string{fmt.Sprintf("ServiceAccount '%s' has no wildcard, secrets, pods/exec or escalation grants.", view.WorkloadRBAC.ServiceAccount)}
+				} else {
+					exp.Reasons = []string{fmt.Sprintf("ServiceAccount '%s' has no RBAC bindings and its token is not mounted.", view.WorkloadRBAC.ServiceAccount)}
+				}
+			}
+		}
+		explanations = append(explanations, exp)
+	}
+
+	// 11. Vulnerabilities
+	{
+		exp := ScoreExplanation{
+			Category: "Vulnerabilities",
+			Score:    bd.Vulnerabilities,
+			MaxScore: 100,
+		}
+		if !policy.Vulnerabilities.Enabled {
+			exp.Status = "not-required"
+			exp.Reasons = []string{"Vulnerability checks are not enabled by the governance policy."}
+		} else if view.Vulnerabilities == nil {
+			exp.Status = "not-required"
+			exp.Reasons = []string{"No Trivy VulnerabilityReports were found for this server's workload (is the Trivy Operator installed?)."}
+		} else {
+			exp.Status = statusFor(bd.Vulnerabilities)
+			for _, img := range view.Vulnerabilities.Images {
+				exp.Sources = append(exp.Sources, "VulnerabilityReport/"+view.Namespace+"/"+img.Report)
+			}
+			for _, f := range view.Findings {
+				if strings.HasPrefix(f.ID, "VULN-") {
+					exp.Reasons = append(exp.Reasons, fmt.Sprintf("[%s] %s", f.Severity, f.Title))
+					exp.Suggestions = append(exp.Suggestions, f.Remediation)
+				}
+			}
+			if len(exp.Reasons) == 0 {
+				exp.Reasons = []string{fmt.Sprintf("%d critical and %d high CVEs across %d scanned image(s), within the policy thresholds.",
+					view.Vulnerabilities.Critical, view.Vulnerabilities.High, len(view.Vulnerabilities.Images))}
+			}
 		}
 		explanations = append(explanations, exp)
 	}
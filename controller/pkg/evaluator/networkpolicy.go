@@ -0,0 +1,277 @@
+package evaluator
+
+import (
+	"sort"
+	"strings"
+)
+
+// LabelSelector mirrors metav1.LabelSelector. A nil selector matches nothing;
+// an empty one matches everything.
+type LabelSelector struct {
+	MatchLabels      map[string]string
+	MatchExpressions []LabelSelectorRequirement
+}
+
+// LabelSelectorRequirement mirrors metav1.LabelSelectorRequirement.
+type LabelSelectorRequirement struct {
+	Key      string
+	Operator string // In, NotIn, Exists, DoesNotExist
+	Values   []string
+}
+
+// NetworkPolicyRule is one ingress or egress rule. A rule with no peers allows
+// all sources (ingress) or destinations (egress); a rule with no ports allows
+// all ports.
+type NetworkPolicyRule struct {
+	Peers []NetworkPolicyPeer
+	Ports []string // "TCP/8080", "UDP/53", "TCP" (all TCP ports)
+}
+
+// NetworkPolicyPeer is one from/to entry of a rule.
+type NetworkPolicyPeer struct {
+	PodSelector       *LabelSelector
+	NamespaceSelector *LabelSelector
+	IPBlock           string // CIDR, empty unless the peer is an ipBlock
+	IPBlockExcept     []string
+}
+
+// Matches reports whether the selector matches the labels.
+func (s *LabelSelector) Matches(labels map[string]string) bool {
+	if s == nil {
+		return false
+	}
+	for k, v := range s.MatchLabels {
+		if got, ok := labels[k]; !ok || got != v {
+			return false
+		}
+	}
+	for _, r := range s.MatchExpressions {
+		val, ok := labels[r.Key]
+		switch r.Operator {
+		case "In":
+			if !ok || !containsString(r.Values, val) {
+				return false
+			}
+		case "NotIn":
+			if ok && containsString(r.Values, val) {
+				return false
+			}
+		case "Exists":
+			if !ok {
+				return false
+			}
+		case "DoesNotExist":
+			if ok {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// isEmpty reports whether the selector is present and selects everything.
+func (s *LabelSelector) isEmpty() bool {
+	return s != nil && len(s.MatchLabels) == 0 && len(s.MatchExpressions) == 0
+}
+
+// selectsPod reports whether the policy applies to a pod with these labels in
+// the given namespace. Policies discovered before selectors were captured
+// fall back to PodSelectorLabels.
+func (np NetworkPolicyResource) selectsPod(namespace string, podLabels map[string]string) bool {
+	if np.Namespace != namespace {
+		return false
+	}
+	sel := &LabelSelector{MatchLabels: np.PodSelectorLabels, MatchExpressions: np.PodSelectorExpressions}
+	return sel.Matches(podLabels)
+}
+
+// isolates reports whether the policy isolates selected pods for the given
+// direction ("Ingress" or "Egress"). Without explicit policyTypes Kubernetes
+// assumes Ingress, plus Egress when egress rules are present.
+func (np NetworkPolicyResource) isolates(direction string) bool {
+	if len(np.PolicyTypes) > 0 {
+		return containsString(np.PolicyTypes, direction)
+	}
+	if direction == "Ingress" {
+		return true
+	}
+	return np.HasEgressRules || len(np.Egress) > 0
+}
+
+// NetworkExposure summarizes how NetworkPolicies restrict one workload.
+type NetworkExposure struct {
+	Policies                   []string // names of the NetworkPolicies selecting the workload
+	IngressRestrictedToGateway bool     // only the gateway namespaces may connect
+	EgressRestricted           bool     // egress is isolated and no rule allows every destination
+}
+
+// workloadNetworkExposure evaluates the NetworkPolicies that select the pods
+// of a workload. Policies apply additively: one rule admitting any source is
+// enough to leave ingress open.
+func workloadNetworkExposure(state *ClusterState, w WorkloadResource) NetworkExposure {
+	var exp NetworkExposure
+	podLabels := w.PodLabels
+	if podLabels == nil {
+		podLabels = map[string]string{}
+	}
+
+	gatewayNS := gatewayNamespaces(state)
+	namespaceLabels := namespaceLabelSets(state)
+
+	ingressIsolated, egressIsolated := false, false
+	ingressOpen, egressOpen := false, false
+	for _, np := range state.NetworkPolicies {
+		if !np.selectsPod(w.Namespace, podLabels) {
+			continue
+		}
+		exp.Policies = append(exp.Policies, np.Name)
+		if np.isolates("Ingress") {
+			ingressIsolated = true
+			for _, rule := range np.Ingress {
+				if !ruleRestrictedTo(rule, np.Namespace, gatewayNS, namespaceLabels) {
+					ingressOpen = true
+				}
+			}
+		}
+		if np.isolates("Egress") {
+			egressIsolated = true
+			for _, rule := range np.Egress {
+				if ruleAllowsAll(rule) {
+					egressOpen = true
+				}
+			}
+		}
+	}
+	sort.Strings(exp.Policies)
+
+	exp.IngressRestrictedToGateway = ingressIsolated && !ingressOpen
+	exp.EgressRestricted = egressIsolated && !egressOpen
+	return exp
+}
+
+// ruleRestrictedTo reports whether every peer of an ingress rule only admits
+// pods from the allowed namespaces.
+func ruleRestrictedTo(rule NetworkPolicyRule, policyNS string, allowed map[string]bool, namespaceLabels map[string]map[string]string) bool {
+	if len(rule.Peers) == 0 {
+		return false
+	}
+	for _, p := range rule.Peers {
+		if p.IPBlock != "" {
+			return false
+		}
+		if p.NamespaceSelector == nil {
+			// podSelector alone selects pods in the policy's own namespace
+			if !allowed[policyNS] {
+				return false
+			}
+			continue
+		}
+		if p.NamespaceSelector.isEmpty() {
+			return false
+		}
+		for ns, labels := range namespaceLabels {
+			if p.NamespaceSelector.Matches(labels) && !allowed[ns] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// ruleAllowsAll reports whether an egress rule allows every destination on
+// every port.
+func ruleAllowsAll(rule NetworkPolicyRule) bool {
+	if len(rule.Ports) > 0 {
+		return false
+	}
+	if len(rule.Peers) == 0 {
+		return true
+	}
+	for _, p := range rule.Peers {
+		if p.IPBlock == "0.0.0.0/0" || p.IPBlock == "::/0" {
+			if len(p.IPBlockExcept) == 0 {
+				return true
+			}
+		}
+		if p.IPBlock == "" && p.NamespaceSelector.isEmpty() && (p.PodSelector == nil || p.PodSelector.isEmpty()) {
+			return true
+		}
+	}
+	return false
+}
+
+// gatewayNamespaces returns the namespaces of agentgateway Gateways, or
+// agentgateway-system when none were discovered.
+func gatewayNamespaces(state *ClusterState) map[string]bool {
+	out := map[string]bool{}
+	for _, gw := range state.Gateways {
+		if gw.GatewayClassName == "agentgateway" {
+			out[gw.Namespace] = true
+		}
+	}
+	if len(out) == 0 {
+		out["agentgateway-system"] = true
+	}
+	return out
+}
+
+// namespaceLabelSets returns the labels of every known namespace, including the
+// kubernetes.io/metadata.name label the API server sets automatically.
+func namespaceLabelSets(state *ClusterState) map[string]map[string]string {
+	out := make(map[string]map[string]string, len(state.Namespaces))
+	add := func(ns string) {
+		if _, ok := out[ns]; ok || ns == "" {
+			return
+		}
+		labels := map[string]string{"kubernetes.io/metadata.name": ns}
+		for k, v := range state.NamespaceMeta[ns].Labels {
+			labels[k] = v
+		}
+		out[ns] = labels
+	}
+	for _, ns := range state.Namespaces {
+		add(ns)
+	}
+	for ns := range state.NamespaceMeta {
+		add(ns)
+	}
+	return out
+}
+
+// backingWorkload returns the workload behind an MCP server view:
+// the workload with the same namespace/name or, for RemoteMCPServers, the one
+// named after the first label of the URL host, in the namespace of the second
+// label ("svc.ns.svc.cluster.local") or the view's own namespace.
+func backingWorkload(state *ClusterState, view *MCPServerView) (WorkloadResource, bool) {
+	host, hostNS := "", view.Namespace
+	if view.Source == "KagentRemoteMCPServer" && view.URL != "" {
+		if parts := strings.Split(view.URL, "://"); len(parts) > 1 {
+			hostname := strings.Split(strings.Split(parts[1], "/")[0], ":")[0]
+			labels := strings.Split(hostname, ".")
+			host = labels[0]
+			if len(labels) > 1 {
+				hostNS = labels[1]
+			}
+		}
+	}
+	for _, w := range state.Workloads {
+		if w.Name == view.Name && w.Namespace == view.Namespace {
+			return w, true
+		}
+		if host != "" && w.Name == host && w.Namespace == hostNS {
+			return w, true
+		}
+	}
+	return WorkloadResource{}, false
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
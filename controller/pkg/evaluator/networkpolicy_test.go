@@ -0,0 +1,155 @@
+package evaluator
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func netpolState(policies ...NetworkPolicyResource) *ClusterState {
+	return &ClusterState{
+		Namespaces: []string{"agentgateway-system", "mcp", "other"},
+		Gateways: []GatewayResource{
+			{Name: "agentgateway", Namespace: "agentgateway-system", GatewayClassName: "agentgateway"},
+		},
+		Workloads: []WorkloadResource{
+			{Name: "tools", Namespace: "mcp", Kind: "Deployment", PodLabels: map[string]string{"app": "tools", "tier": "mcp"}},
+		},
+		NetworkPolicies: policies,
+	}
+}
+
+var fromGatewayNS = NetworkPolicyRule{Peers: []NetworkPolicyPeer{{
+	NamespaceSelector: &LabelSelector{MatchLabels: map[string]string{"kubernetes.io/metadata.name": "agentgateway-system"}},
+}}}
+
+func TestWorkloadNetworkExposure(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      NetworkPolicyResource
+		wantSelects bool
+		wantIngress bool
+		wantEgress  bool
+	}{
+		{
+			name: "unrelated app",
+			policy: NetworkPolicyResource{Name: "web", Namespace: "mcp", PodSelectorLabels: map[string]string{"app": "web"},
+				HasIngressRules: true, Ingress: []NetworkPolicyRule{fromGatewayNS}},
+		},
+		{
+			name: "gateway ingress and DNS-only egress",
+			policy: NetworkPolicyResource{Name: "tools", Namespace: "mcp", PodSelectorLabels: map[string]string{"app": "tools"},
+				PolicyTypes: []string{"Ingress", "Egress"}, Ingress: []NetworkPolicyRule{fromGatewayNS},
+				Egress: []NetworkPolicyRule{{Ports: []string{"UDP/53"}}}},
+			wantSelects: true, wantIngress: true, wantEgress: true,
+		},
+		{
+			name: "ingress from any namespace",
+			policy: NetworkPolicyResource{Name: "tools", Namespace: "mcp", PodSelectorLabels: map[string]string{"app": "tools"},
+				Ingress: []NetworkPolicyRule{{Peers: []NetworkPolicyPeer{{NamespaceSelector: &LabelSelector{}}}}}},
+			wantSelects: true,
+		},
+		{
+			name: "ingress from own namespace, egress open",
+			policy: NetworkPolicyResource{Name: "tools", Namespace: "mcp",
+				PodSelectorExpressions: []LabelSelectorRequirement{{Key: "tier", Operator: "In", Values: []string{"mcp"}}},
+				PolicyTypes:            []string{"Ingress", "Egress"},
+				Ingress:                []NetworkPolicyRule{{Peers: []NetworkPolicyPeer{{PodSelector: &LabelSelector{}}}}},
+				Egress:                 []NetworkPolicyRule{{}}},
+			wantSelects: true,
+		},
+		{
+			name: "default deny",
+			policy: NetworkPolicyResource{Name: "deny", Namespace: "mcp", PodSelectorLabels: map[string]string{},
+				PolicyTypes: []string{"Ingress", "Egress"}},
+			wantSelects: true, wantIngress: true, wantEgress: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := netpolState(tt.policy)
+			exp := workloadNetworkExposure(state, state.Workloads[0])
+			if got := len(exp.Policies) > 0; got != tt.wantSelects {
+				t.Errorf("selects = %v, want %v", got, tt.wantSelects)
+			}
+			if exp.IngressRestrictedToGateway != tt.wantIngress || exp.EgressRestricted != tt.wantEgress {
+				t.Errorf("ingress = %v, egress = %v; want %v, %v",
+					exp.IngressRestrictedToGateway, exp.EgressRestricted, tt.wantIngress, tt.wantEgress)
+			}
+		})
+	}
+}
+
+func TestWorkloadNetworkExposure_OpenRuleInAnyPolicyWins(t *testing.T) {
+	sel := map[string]string{"app": "tools"}
+	state := netpolState(
+		NetworkPolicyResource{Name: "gw", Namespace: "mcp", PodSelectorLabels: sel, Ingress: []NetworkPolicyRule{fromGatewayNS}},
+		NetworkPolicyResource{Name: "other", Namespace: "mcp", PodSelectorLabels: sel, Ingress: []NetworkPolicyRule{{Peers: []NetworkPolicyPeer{{
+			NamespaceSelector: &LabelSelector{MatchLabels: map[string]string{"kubernetes.io/metadata.name": "other"}},
+		}}}}},
+	)
+	exp := workloadNetworkExposure(state, state.Workloads[0])
+	if exp.IngressRestrictedToGateway {
+		t.Error("a second policy admitting namespace 'other' should leave ingress unrestricted")
+	}
+	if !reflect.DeepEqual(exp.Policies, []string{"gw", "other"}) {
+		t.Errorf("policies = %v", exp.Policies)
+	}
+}
+
+func TestCheckHardenedDeployment_NetworkPolicyNotSelectingWorkload(t *testing.T) {
+	state := netpolState(NetworkPolicyResource{Name: "web", Namespace: "mcp",
+		PodSelectorLabels: map[string]string{"app": "web"}, HasIngressRules: true})
+
+	var found *Finding
+	for _, f := range checkHardenedDeployment(state, Policy{RequireHardenedDeployment: true}) {
+		if strings.HasPrefix(f.ID, "HDN-007-") {
+			f := f
+			found = &f
+		}
+	}
+	if found == nil || found.ID != "HDN-007-tools" || found.ResourceRef != "Deployment/mcp/tools" {
+		t.Fatalf("HDN-007 = %+v, want per-workload finding for tools", found)
+	}
+}
+
+func TestBuildMCPServerViews_NetworkExposure(t *testing.T) {
+	state := netpolState(NetworkPolicyResource{Name: "tools", Namespace: "mcp",
+		PodSelectorLabels: map[string]string{"app": "tools"}, HasIngressRules: true, Ingress: []NetworkPolicyRule{fromGatewayNS}})
+	state.KagentMCPServers = []KagentMCPServerResource{{Name: "tools", Namespace: "mcp", Port: 8080}}
+
+	views := BuildMCPServerViews(state, nil, defaultPolicy())
+	if len(views) != 1 {
+		t.Fatalf("got %d views", len(views))
+	}
+	v := views[0]
+	if !reflect.DeepEqual(v.NetworkPolicies, []string{"tools"}) || !v.IngressRestrictedToGateway || v.EgressRestricted {
+		t.Errorf("policies = %v, ingress = %v, egress = %v", v.NetworkPolicies, v.IngressRestrictedToGateway, v.EgressRestricted)
+	}
+}
+
+func TestBackingWorkload_RequiresNamespace(t *testing.T) {
+	state := netpolState()
+	state.Workloads = append(state.Workloads, WorkloadResource{Name: "tools", Namespace: "other", Kind: "Deployment"})
+	tests := []struct {
+		name   string
+		view   MCPServerView
+		wantNS string
+	}{
+		{"same name and namespace", MCPServerView{Name: "tools", Namespace: "other", Source: "KagentMCPServer"}, "other"},
+		{"remote host with namespace", MCPServerView{Name: "remote", Namespace: "apps", Source: "KagentRemoteMCPServer",
+			URL: "http://tools.mcp.svc.cluster.local:8080/mcp"}, "mcp"},
+		{"remote short host", MCPServerView{Name: "remote", Namespace: "other", Source: "KagentRemoteMCPServer",
+			URL: "http://tools:8080/mcp"}, "other"},
+		{"remote host in namespace without workload", MCPServerView{Name: "remote", Namespace: "mcp", Source: "KagentRemoteMCPServer",
+			URL: "http://tools.apps:8080/mcp"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, ok := backingWorkload(state, &tt.view)
+			if ok != (tt.wantNS != "") || (ok && w.Namespace != tt.wantNS) {
+				t.Errorf("backingWorkload = %s/%s (%v), want namespace %q", w.Namespace, w.Name, ok, tt.wantNS)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package evaluator
+
+import (
+	"strings"
+)
+
+// CheckOverride adjusts findings whose ID starts with a given prefix
+// (MCPGovernancePolicy spec.checkOverrides). Unlike CheckConfig, which acts on a
+// registered check as a whole, overrides target individual finding IDs such as
+// "TLS-003", a family such as "HDN-", or skill and custom-rule findings.
+type CheckOverride struct {
+	Severity string // If set, replaces the finding severity
+	Penalty  *int   // If set, replaces the severity penalty deducted for the finding
+	Disabled bool   // If true, matching findings are dropped
+
+	// RaiseOnly is set when a namespace-scoped policy contributed the override
+	// (see TightenPolicy): severity and penalty are then only applied when they
+	// are stricter than what the finding would otherwise get.
+	RaiseOnly bool
+}
+
+// appliedOverride records a finding changed or dropped by a CheckOverride.
+type appliedOverride struct {
+	Key              string
+	Finding          Finding // finding after the override
+	OriginalSeverity string
+	Disabled         bool
+}
+
+// checkOverrideFor returns the override whose key is the longest prefix of
+// findingID, together with that key.
+func (p Policy) checkOverrideFor(findingID string) (string, CheckOverride, bool) {
+	best := ""
+	var match CheckOverride
+	found := false
+	for key, o := range p.CheckOverrides {
+		if key == "" || !strings.HasPrefix(findingID, key) || len(key) <= len(best) {
+			continue
+		}
+		best, match, found = key, o, true
+	}
+	return best, match, found
+}
+
+// scoredFindingChecks maps the finding IDs the per-server score gates on to
+// the registered check that raises them.
+var scoredFindingChecks = map[string]string{
+	"AGW-100":   "agentgateway",
+	"AUTH-002":  "authentication",
+	"RBAC-001":  "authorization",
+	"TLS-001":   "tls",
+	"CORS-001":  "cors",
+	"RL-001":    "rate-limit",
+	"PG-001":    "prompt-guard",
+	"TOOLS-001": "tool-count",
+	"TOOLS-002": "tool-sensitivity",
+}
+
+// checkDisabled reports whether findings with the given ID are disabled,
+// either by a CheckOverride or by disabling the check that raises them in
+// spec.checks.
+func (p Policy) checkDisabled(findingID string) bool {
+	if id, ok := scoredFindingChecks[findingID]; ok && p.Checks[id].Disabled {
+		return true
+	}
+	_, o, ok := p.checkOverrideFor(findingID)
+	return ok && o.Disabled
+}
+
+// overrideFinding applies the matching override to a finding. It returns false
+// when the finding is disabled and should be dropped.
+func (p Policy) overrideFinding(f Finding) (Finding, bool) {
+	_, o, ok := p.checkOverrideFor(f.ID)
+	if !ok {
+		return f, true
+	}
+	if o.Disabled {
+		return f, false
+	}
+	if isValidSeverity(o.Severity) && (!o.RaiseOnly || severityRank(o.Severity) > severityRank(f.Severity)) {
+		f.Severity = o.Severity
+	}
+	return f, true
+}
+
+// findingPenalty returns the score penalty for a finding: the override penalty
+// when one is set, otherwise the policy's severity penalty.
+func (p Policy) findingPenalty(f Finding) int {
+	return p.overridePenalty(f, severityPenalty(f.Severity, p.SeverityPenalties))
+}
+
+// overridePenalty returns the override penalty for a finding, or penalty when
+// none is set. Raise-only overrides never go below penalty.
+func (p Policy) overridePenalty(f Finding, penalty int) int {
+	if _, o, ok := p.checkOverrideFor(f.ID); ok && o.Penalty != nil {
+		if o.RaiseOnly {
+			return maxInt(penalty, *o.Penalty)
+		}
+		return *o.Penalty
+	}
+	return penalty
+}
+
+// applyCheckOverrides applies spec.checkOverrides to findings, using each
+// finding's namespace-effective policy. It returns the kept findings and the
+// overrides that changed or dropped something, for audit logging.
+func applyCheckOverrides(findings []Finding, policy Policy) ([]Finding, []appliedOverride) {
+	if len(policy.CheckOverrides) == 0 && len(policy.NamespacePolicies) == 0 {
+		return findings, nil
+	}
+	kept := make([]Finding, 0, len(findings))
+	var applied []appliedOverride
+	for _, f := range findings {
+		p := policy.ForNamespace(findingNamespace(f))
+		key, _, ok := p.checkOverrideFor(f.ID)
+		if !ok {
+			kept = append(kept, f)
+			continue
+		}
+		out, keep := p.overrideFinding(f)
+		if keep {
+			kept = append(kept, out)
+		}
+		if !keep || out.Severity != f.Severity {
+			applied = append(applied, appliedOverride{Key: key, Finding: out, OriginalSeverity: f.Severity, Disabled: !keep})
+		}
+	}
+	return kept, applied
+}
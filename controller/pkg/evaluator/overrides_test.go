@@ -0,0 +1,206 @@
+package evaluator
+
+import (
+	"strings"
+	"testing"
+)
+
+// oneWayTLSState has a single routed MCP server whose backend uses one-way TLS (TLS-003).
+func oneWayTLSState() *ClusterState {
+	return &ClusterState{
+		Namespaces: []string{"mcp-system"},
+		Gateways: []GatewayResource{
+			{Name: "agentgateway", Namespace: "mcp-system", GatewayClassName: "agentgateway", Programmed: true},
+		},
+		AgentgatewayBackends: []AgentgatewayBackendResource{
+			{
+				Name: "mcp-backend", Namespace: "mcp-system", BackendType: "mcp", HasTLS: true,
+				MCPTargets: []MCPTargetInfo{
+					{Name: "my-mcp", Host: "my-mcp.mcp-system.svc.cluster.local", Port: 8080},
+				},
+			},
+		},
+		KagentMCPServers: []KagentMCPServerResource{
+			{Name: "my-mcp", Namespace: "mcp-system", Transport: "sse", Port: 8080, HasService: true},
+		},
+	}
+}
+
+func findView(t *testing.T, result *EvaluationResult, name string) MCPServerView {
+	t.Helper()
+	for _, v := range result.MCPServerViews {
+		if v.Name == name {
+			return v
+		}
+	}
+	t.Fatalf("No MCPServerView for %s", name)
+	return MCPServerView{}
+}
+
+func TestCheckOverrideFor_LongestPrefixWins(t *testing.T) {
+	p := Policy{CheckOverrides: map[string]CheckOverride{
+		"HDN-":     {Severity: SeverityLow},
+		"HDN-003":  {Severity: SeverityCritical},
+		"HDN-0031": {Disabled: true},
+	}}
+
+	key, o, ok := p.checkOverrideFor("HDN-003-web")
+	if !ok || key != "HDN-003" || o.Severity != SeverityCritical {
+		t.Errorf("HDN-003-web → %s %+v", key, o)
+	}
+	if key, _, _ := p.checkOverrideFor("HDN-001-web"); key != "HDN-" {
+		t.Errorf("HDN-001-web → %s, want HDN-", key)
+	}
+	if _, _, ok := p.checkOverrideFor("TLS-003-b"); ok {
+		t.Error("TLS-003-b should not match any override")
+	}
+}
+
+func TestApplyCheckOverrides(t *testing.T) {
+	penalty := 3
+	p := defaultPolicy()
+	p.CheckOverrides = map[string]CheckOverride{
+		"TLS-003": {Severity: SeverityHigh},
+		"CORS-":   {Disabled: true},
+		"RL-001":  {Penalty: &penalty},
+	}
+	findings := []Finding{
+		{ID: "TLS-003-b", Severity: SeverityMedium},
+		{ID: "CORS-001", Severity: SeverityHigh},
+		{ID: "RL-001", Severity: SeverityHigh},
+		{ID: "AGW-001", Severity: SeverityCritical},
+	}
+
+	kept, applied := applyCheckOverrides(findings, p)
+
+	if len(kept) != 3 {
+		t.Fatalf("Expected CORS-001 dropped, got %+v", kept)
+	}
+	if kept[0].Severity != SeverityHigh {
+		t.Errorf("TLS-003 severity = %s, want High", kept[0].Severity)
+	}
+	if len(applied) != 2 {
+		t.Errorf("Expected 2 applied overrides (severity + disable), got %+v", applied)
+	}
+	if got := p.findingPenalty(kept[1]); got != 3 {
+		t.Errorf("RL-001 penalty = %d, want overridden 3", got)
+	}
+	if got := p.findingPenalty(kept[2]); got != p.SeverityPenalties.Critical {
+		t.Errorf("AGW-001 penalty = %d, want default %d", got, p.SeverityPenalties.Critical)
+	}
+}
+
+func TestEvaluate_CheckOverrideRaisesTLS003(t *testing.T) {
+	policy := defaultPolicy()
+	before := Evaluate(oneWayTLSState(), policy)
+
+	policy.CheckOverrides = map[string]CheckOverride{"TLS-003": {Severity: SeverityHigh}}
+	after := Evaluate(oneWayTLSState(), policy)
+
+	found := false
+	for _, f := range after.Findings {
+		if strings.HasPrefix(f.ID, "TLS-003-") {
+			found = true
+			if f.Severity != SeverityHigh {
+				t.Errorf("TLS-003 severity = %s, want High", f.Severity)
+			}
+		}
+	}
+	if !found {
+		t.Fatal("Expected TLS-003 finding")
+	}
+
+	b, a := findView(t, before, "my-mcp"), findView(t, after, "my-mcp")
+	if a.ScoreBreakdown.TLS != 100-policy.SeverityPenalties.High || a.ScoreBreakdown.TLS >= b.ScoreBreakdown.TLS {
+		t.Errorf("per-server TLS: before=%d after=%d", b.ScoreBreakdown.TLS, a.ScoreBreakdown.TLS)
+	}
+}
+
+func TestEvaluate_CheckOverridePenaltyAndDisable(t *testing.T) {
+	zero := 0
+	policy := defaultPolicy()
+	policy.CheckOverrides = map[string]CheckOverride{"TLS-003": {Penalty: &zero}}
+	result := Evaluate(oneWayTLSState(), policy)
+	if v := findView(t, result, "my-mcp"); v.ScoreBreakdown.TLS != 100 {
+		t.Errorf("TLS with zero-penalty override = %d, want 100", v.ScoreBreakdown.TLS)
+	}
+
+	policy.CheckOverrides = map[string]CheckOverride{"TLS-": {Disabled: true}, "RL-": {Disabled: true}}
+	result = Evaluate(oneWayTLSState(), policy)
+	for _, f := range result.Findings {
+		if strings.HasPrefix(f.ID, "TLS-") || strings.HasPrefix(f.ID, "RL-") {
+			t.Errorf("Disabled finding %s still reported", f.ID)
+		}
+	}
+	if v := findView(t, result, "my-mcp"); v.ScoreBreakdown.RateLimit != 100 {
+		t.Errorf("RateLimit with RL- disabled = %d, want 100", v.ScoreBreakdown.RateLimit)
+	}
+}
+
+func TestScoreSkillCatalog_HonoursOverrides(t *testing.T) {
+	policy := defaultPolicy()
+	skill := SkillCatalogResource{Name: "s", Namespace: "ns"}
+	meta := []Finding{
+		{ID: "SKL-001-s", Severity: SeverityMedium},
+		{ID: "SKL-005-s", Severity: SeverityLow},
+	}
+	base := scoreSkillCatalog(skill, meta, nil, policy)
+
+	policy.CheckOverrides = map[string]CheckOverride{
+		"SKL-001": {Severity: SeverityHigh},
+		"SKL-005": {Disabled: true},
+	}
+	got := scoreSkillCatalog(skill, meta, nil, policy)
+
+	want := 100 - policy.SeverityPenalties.High
+	if got.Score != want {
+		t.Errorf("skill score = %d (before %d), want %d", got.Score, base.Score, want)
+	}
+	if len(got.Findings) != 1 || got.Findings[0].Severity != SeverityHigh {
+		t.Errorf("skill findings = %+v", got.Findings)
+	}
+}
+
+func TestOverridePenalty_RaiseOnly(t *testing.T) {
+	low, high := 10, 60
+	f := Finding{ID: "HDN-003-my-mcp", Severity: SeverityHigh}
+	tests := []struct {
+		name     string
+		override CheckOverride
+		want     int
+	}{
+		{"no penalty", CheckOverride{Severity: SeverityHigh}, 25},
+		{"lower penalty", CheckOverride{Penalty: &low}, 10},
+		{"raise-only lower penalty", CheckOverride{Penalty: &low, RaiseOnly: true}, 25},
+		{"raise-only higher penalty", CheckOverride{Penalty: &high, RaiseOnly: true}, 60},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := defaultPolicy()
+			policy.CheckOverrides = map[string]CheckOverride{"HDN-003": tt.override}
+			if got := policy.overridePenalty(f, 25); got != tt.want {
+				t.Errorf("overridePenalty = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluate_DisabledChecksScoreLikeDisabledOverrides(t *testing.T) {
+	state := oneWayTLSState()
+	state.AgentgatewayBackends[0].HasTLS = false
+
+	viaChecks := defaultPolicy()
+	viaChecks.Checks = map[string]CheckConfig{"tls": {Disabled: true}, "authentication": {Disabled: true}}
+	viaOverrides := defaultPolicy()
+	viaOverrides.CheckOverrides = map[string]CheckOverride{"TLS-001": {Disabled: true}, "AUTH-002": {Disabled: true}}
+
+	a := findView(t, Evaluate(state, viaChecks), "my-mcp")
+	b := findView(t, Evaluate(state, viaOverrides), "my-mcp")
+	if a.ScoreBreakdown.TLS != 100 || a.ScoreBreakdown.Authentication != 100 {
+		t.Errorf("spec.checks: tls = %d, authentication = %d, want 100", a.ScoreBreakdown.TLS, a.ScoreBreakdown.Authentication)
+	}
+	if a.ScoreBreakdown.TLS != b.ScoreBreakdown.TLS || a.ScoreBreakdown.Authentication != b.ScoreBreakdown.Authentication || a.Score != b.Score {
+		t.Errorf("spec.checks score %d (tls %d, auth %d) != checkOverrides score %d (tls %d, auth %d)",
+			a.Score, a.ScoreBreakdown.TLS, a.ScoreBreakdown.Authentication, b.Score, b.ScoreBreakdown.TLS, b.ScoreBreakdown.Authentication)
+	}
+}
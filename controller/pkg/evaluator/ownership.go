@@ -0,0 +1,151 @@
+package evaluator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UnownedTeam is the owner reported for findings and MCP servers whose
+// resource, workload and namespace carry none of the ownership keys.
+const UnownedTeam = "unowned"
+
+// OwnershipPolicy configures which labels and annotations identify the team
+// that owns a resource. Keys are checked in order; labels take precedence over
+// annotations on the same object.
+type OwnershipPolicy struct {
+	LabelKeys      []string // default: ["team", "owner"]
+	AnnotationKeys []string // default: ["team", "owner"]
+}
+
+// DefaultOwnershipPolicy returns the default ownership keys.
+func DefaultOwnershipPolicy() OwnershipPolicy {
+	return OwnershipPolicy{
+		LabelKeys:      []string{"team", "owner"},
+		AnnotationKeys: []string{"team", "owner"},
+	}
+}
+
+// ownerFrom returns the first non-empty owner found in labels, then annotations.
+func (o OwnershipPolicy) ownerFrom(labels, annotations map[string]string) string {
+	labelKeys, annotationKeys := o.LabelKeys, o.AnnotationKeys
+	if len(labelKeys) == 0 && len(annotationKeys) == 0 {
+		d := DefaultOwnershipPolicy()
+		labelKeys, annotationKeys = d.LabelKeys, d.AnnotationKeys
+	}
+	for _, k := range labelKeys {
+		if v := strings.TrimSpace(labels[k]); v != "" {
+			return v
+		}
+	}
+	for _, k := range annotationKeys {
+		if v := strings.TrimSpace(annotations[k]); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// ownerResolver attributes resources to teams. The owner is read from the
+// resource itself, then from the workload with the same namespace/name, then
+// from the namespace.
+type ownerResolver struct {
+	policy        OwnershipPolicy
+	resources     map[string]ObjectMeta // "Kind/ns/name" -> metadata
+	workloads     map[string]ObjectMeta // "ns/name" -> metadata
+	workloadKinds map[string]string     // "ns/name" -> workload kind
+	namespaces    map[string]ObjectMeta
+}
+
+func newOwnerResolver(state *ClusterState, policy OwnershipPolicy) *ownerResolver {
+	r := &ownerResolver{
+		policy:        policy,
+		resources:     make(map[string]ObjectMeta),
+		workloads:     make(map[string]ObjectMeta),
+		workloadKinds: make(map[string]string),
+		namespaces:    state.NamespaceMeta,
+	}
+	add := func(kind, ns, name string, labels, annotations map[string]string) {
+		r.resources[fmt.Sprintf("%s/%s/%s", kind, ns, name)] = ObjectMeta{Labels: labels, Annotations: annotations}
+	}
+	for _, b := range state.AgentgatewayBackends {
+		add("AgentgatewayBackend", b.Namespace, b.Name, b.Labels, b.Annotations)
+	}
+	for _, a := range state.KagentAgents {
+		add("Agent", a.Namespace, a.Name, a.Labels, a.Annotations)
+	}
+	for _, m := range state.KagentMCPServers {
+		add("MCPServer", m.Namespace, m.Name, m.Labels, m.Annotations)
+	}
+	for _, m := range state.KagentRemoteMCPServers {
+		add("RemoteMCPServer", m.Namespace, m.Name, m.Labels, m.Annotations)
+	}
+	for _, s := range state.Services {
+		add("Service", s.Namespace, s.Name, s.Labels, s.Annotations)
+	}
+	for _, sc := range state.SkillCatalogs {
+		add("SkillCatalog", sc.Namespace, sc.Name, sc.Labels, sc.Annotations)
+	}
+	for _, w := range state.Workloads {
+		add(w.Kind, w.Namespace, w.Name, w.Labels, w.Annotations)
+		key := w.Namespace + "/" + w.Name
+		r.workloads[key] = ObjectMeta{Labels: w.Labels, Annotations: w.Annotations}
+		r.workloadKinds[key] = w.Kind
+	}
+	return r
+}
+
+// resolve returns the owner of the resource and the "Kind/ns/name" of the
+// object the owner was read from. Unresolved resources belong to UnownedTeam.
+func (r *ownerResolver) resolve(kind, namespace, name string) (owner, source string) {
+	if name != "" {
+		ref := fmt.Sprintf("%s/%s/%s", kind, namespace, name)
+		if m, ok := r.resources[ref]; ok {
+			if o := r.policy.ownerFrom(m.Labels, m.Annotations); o != "" {
+				return o, ref
+			}
+		}
+		key := namespace + "/" + name
+		if m, ok := r.workloads[key]; ok {
+			if o := r.policy.ownerFrom(m.Labels, m.Annotations); o != "" {
+				return o, fmt.Sprintf("%s/%s", r.workloadKinds[key], key)
+			}
+		}
+	}
+	if namespace != "" {
+		if m, ok := r.namespaces[namespace]; ok {
+			if o := r.policy.ownerFrom(m.Labels, m.Annotations); o != "" {
+				return o, "Namespace/" + namespace
+			}
+		}
+	}
+	return UnownedTeam, ""
+}
+
+// resolveFinding resolves the owner of the finding's ResourceRef, falling back
+// to its namespace when the ref is missing or not of the form Kind/ns/name.
+func (r *ownerResolver) resolveFinding(f Finding) string {
+	if parts := strings.Split(f.ResourceRef, "/"); len(parts) == 3 {
+		owner, _ := r.resolve(parts[0], parts[1], parts[2])
+		return owner
+	}
+	owner, _ := r.resolve("", findingNamespace(f), "")
+	return owner
+}
+
+// assignOwners sets Owner on every finding.
+func assignOwners(r *ownerResolver, findings []Finding) {
+	for i := range findings {
+		findings[i].Owner = r.resolveFinding(findings[i])
+	}
+}
+
+// mcpServerViewKind maps an MCPServerView source to the kind used in resource refs.
+func mcpServerViewKind(source string) string {
+	switch source {
+	case "KagentMCPServer":
+		return "MCPServer"
+	case "KagentRemoteMCPServer":
+		return "RemoteMCPServer"
+	}
+	return source
+}
@@ -0,0 +1,109 @@
+package evaluator
+
+import "testing"
+
+func ownedState() *ClusterState {
+	state := oneWayTLSState()
+	state.Namespaces = append(state.Namespaces, "payments")
+	state.NamespaceMeta = map[string]ObjectMeta{
+		"mcp-system": {Labels: map[string]string{"team": "platform"}},
+		"payments":   {Annotations: map[string]string{"owner": "payments-team"}},
+	}
+	state.KagentMCPServers = append(state.KagentMCPServers,
+		KagentMCPServerResource{Name: "ledger-mcp", Namespace: "payments", Transport: "sse", Port: 8080},
+		KagentMCPServerResource{Name: "search-mcp", Namespace: "mcp-system", Transport: "sse", Port: 8080,
+			Labels: map[string]string{"team": "search"}},
+	)
+	state.Workloads = []WorkloadResource{
+		{Name: "my-mcp", Namespace: "mcp-system", Kind: "Deployment", Labels: map[string]string{"owner": "data"}},
+	}
+	return state
+}
+
+func TestOwnerResolver_Precedence(t *testing.T) {
+	r := newOwnerResolver(ownedState(), DefaultOwnershipPolicy())
+
+	cases := []struct {
+		kind, ns, name     string
+		wantOwner, wantSrc string
+	}{
+		{"MCPServer", "mcp-system", "search-mcp", "search", "MCPServer/mcp-system/search-mcp"},
+		{"MCPServer", "mcp-system", "my-mcp", "data", "Deployment/mcp-system/my-mcp"},
+		{"MCPServer", "payments", "ledger-mcp", "payments-team", "Namespace/payments"},
+		{"Gateway", "mcp-system", "agentgateway", "platform", "Namespace/mcp-system"},
+		{"MCPServer", "other", "x", UnownedTeam, ""},
+	}
+	for _, c := range cases {
+		owner, src := r.resolve(c.kind, c.ns, c.name)
+		if owner != c.wantOwner || src != c.wantSrc {
+			t.Errorf("%s/%s/%s → (%s, %s), want (%s, %s)", c.kind, c.ns, c.name, owner, src, c.wantOwner, c.wantSrc)
+		}
+	}
+}
+
+func TestOwnerResolver_CustomKeys(t *testing.T) {
+	state := ownedState()
+	state.KagentMCPServers[1].Labels = map[string]string{"app.kubernetes.io/part-of": "ledger"}
+
+	r := newOwnerResolver(state, OwnershipPolicy{LabelKeys: []string{"app.kubernetes.io/part-of"}})
+	if owner, _ := r.resolve("MCPServer", "payments", "ledger-mcp"); owner != "ledger" {
+		t.Errorf("owner = %s, want ledger", owner)
+	}
+	// Default keys are no longer consulted once custom keys are set
+	if owner, _ := r.resolve("MCPServer", "mcp-system", "search-mcp"); owner != UnownedTeam {
+		t.Errorf("owner = %s, want %s", owner, UnownedTeam)
+	}
+}
+
+func TestEvaluate_TeamScores(t *testing.T) {
+	result := Evaluate(ownedState(), defaultPolicy())
+
+	if v := findView(t, result, "my-mcp"); v.Owner != "data" || v.OwnerSource != "Deployment/mcp-system/my-mcp" {
+		t.Errorf("my-mcp owner = %s (%s)", v.Owner, v.OwnerSource)
+	}
+	for _, f := range result.Findings {
+		if f.Owner == "" {
+			t.Errorf("finding %s has no owner", f.ID)
+		}
+	}
+
+	teams := map[string]TeamScore{}
+	for _, ts := range result.TeamScores {
+		teams[ts.Team] = ts
+	}
+	for _, name := range []string{"data", "payments-team", "search"} {
+		ts, ok := teams[name]
+		if !ok {
+			t.Fatalf("missing team %s in %+v", name, result.TeamScores)
+		}
+		if ts.ServerCount != 1 || len(ts.WorstServers) != 1 {
+			t.Errorf("%s: servers=%d worst=%v", name, ts.ServerCount, ts.WorstServers)
+		}
+		if ts.Score != ts.WorstServers[0].Score {
+			t.Errorf("%s: score %d, want the single server's %d", name, ts.Score, ts.WorstServers[0].Score)
+		}
+	}
+
+	detail, ok := BuildTeamDetail(result, "payments-team")
+	if !ok || len(detail.Servers) != 1 || detail.Servers[0].Name != "ledger-mcp" {
+		t.Errorf("payments-team detail = %+v", detail)
+	}
+	for _, f := range detail.Findings {
+		if f.Owner != "payments-team" {
+			t.Errorf("detail finding %s owned by %s", f.ID, f.Owner)
+		}
+	}
+	if _, ok := BuildTeamDetail(result, "nobody"); ok {
+		t.Error("unknown team should not resolve")
+	}
+}
+
+func TestWorstServers_OrderAndLimit(t *testing.T) {
+	views := []MCPServerView{
+		{ID: "a", Score: 80}, {ID: "b", Score: 20}, {ID: "c", Score: 50}, {ID: "d", Score: 20},
+	}
+	got := worstServers(views, 3)
+	if len(got) != 3 || got[0].ID != "b" || got[1].ID != "d" || got[2].ID != "c" {
+		t.Errorf("worstServers = %+v", got)
+	}
+}
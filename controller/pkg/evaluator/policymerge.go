@@ -0,0 +1,347 @@
+package evaluator
+
+import (
+	"sort"
+)
+
+// ResolvePolicies merges every discovered MCPGovernancePolicy into a single
+// effective policy.
+//
+// Policies without ScopeNamespaces are cluster-wide baselines; they are merged
+// in name order, the first one supplying the settings that cannot be tightened
+// (weights, scan interval, namespaces, AI agent, skill governance, ...).
+// Policies with ScopeNamespaces are namespace-scoped: for every namespace they
+// list, the baseline is tightened with each applicable policy in name order and
+// stored in NamespacePolicies. With no baseline, DefaultPolicy is the baseline.
+func ResolvePolicies(policies []Policy) Policy {
+	sorted := make([]Policy, len(policies))
+	copy(sorted, policies)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	var baseline *Policy
+	scoped := map[string][]Policy{}
+	for _, p := range sorted {
+		if len(p.ScopeNamespaces) > 0 {
+			for _, ns := range p.ScopeNamespaces {
+				scoped[ns] = append(scoped[ns], p)
+			}
+			continue
+		}
+		if baseline == nil {
+			b := p
+			if len(b.SourcePolicies) == 0 {
+				b.SourcePolicies = []string{p.Name}
+			}
+			baseline = &b
+			continue
+		}
+		merged := TightenPolicy(*baseline, p)
+		baseline = &merged
+	}
+	if baseline == nil {
+		b := DefaultPolicy()
+		baseline = &b
+	}
+	baseline.ScopeNamespaces = nil
+	baseline.NamespacePolicies = nil
+	baseline.ScopedPolicies = nil
+	for _, p := range sorted {
+		if len(p.ScopeNamespaces) > 0 {
+			baseline.ScopedPolicies = append(baseline.ScopedPolicies, p)
+		}
+	}
+
+	if len(scoped) > 0 {
+		baseline.NamespacePolicies = make(map[string]Policy, len(scoped))
+		for ns, overlays := range scoped {
+			eff := *baseline
+			eff.NamespacePolicies = nil
+			eff.ScopedPolicies = nil
+			for _, o := range overlays {
+				eff = TightenPolicy(eff, o)
+			}
+			baseline.NamespacePolicies[ns] = eff
+		}
+	}
+	return *baseline
+}
+
+// TightenPolicy applies overlay on top of base, only ever making requirements
+// stricter. Settings that cannot be tightened are kept from base.
+func TightenPolicy(base, overlay Policy) Policy {
+	out := base
+	out.SourcePolicies = append(append([]string{}, base.SourcePolicies...), overlay.Name)
+
+	out.RequireAgentGateway = base.RequireAgentGateway || overlay.RequireAgentGateway
+	out.RequireCORS = base.RequireCORS || overlay.RequireCORS
+	out.RequireJWTAuth = base.RequireJWTAuth || overlay.RequireJWTAuth
+	out.RequireRBAC = base.RequireRBAC || overlay.RequireRBAC
+	out.RequirePromptGuard = base.RequirePromptGuard || overlay.RequirePromptGuard
+	out.RequireTLS = base.RequireTLS || overlay.RequireTLS
+	out.RequireRateLimit = base.RequireRateLimit || overlay.RequireRateLimit
+	out.RequireHardenedDeployment = base.RequireHardenedDeployment || overlay.RequireHardenedDeployment
+	out.RequireWorkloadRBAC = base.RequireWorkloadRBAC || overlay.RequireWorkloadRBAC
+	out.ImageVerification.Enabled = base.ImageVerification.Enabled || overlay.ImageVerification.Enabled
+	// Trusted keys are unioned so an overlay enabling verification brings the
+	// keys its images are signed with. Only one key ConfigMap can be named; the
+	// overlay's is used where base has none.
+	if len(overlay.ImageVerification.PublicKeys) > 0 {
+		out.ImageVerification.PublicKeys = append([]string{}, base.ImageVerification.PublicKeys...)
+		for _, k := range overlay.ImageVerification.PublicKeys {
+			out.ImageVerification.PublicKeys = appendUnique(out.ImageVerification.PublicKeys, k)
+		}
+	}
+	if base.ImageVerification.PublicKeysConfigMap == "" {
+		out.ImageVerification.PublicKeysConfigMap = overlay.ImageVerification.PublicKeysConfigMap
+	}
+	// Intersecting two registry allowlists could leave none, so the overlay's
+	// only applies where base has none.
+	if len(base.ImageVerification.AllowedRegistries) == 0 {
+		out.ImageVerification.AllowedRegistries = overlay.ImageVerification.AllowedRegistries
+	}
+	// An enabled overlay lowers the CVE thresholds.
+	if overlay.Vulnerabilities.Enabled {
+		out.Vulnerabilities = VulnerabilityPolicy{
+			Enabled:       true,
+			MaxCritical:   overlay.Vulnerabilities.MaxCritical,
+			MaxHigh:       overlay.Vulnerabilities.MaxHigh,
+			IgnoreUnfixed: overlay.Vulnerabilities.IgnoreUnfixed,
+		}
+		if base.Vulnerabilities.Enabled {
+			out.Vulnerabilities.MaxCritical = minInt(base.Vulnerabilities.MaxCritical, overlay.Vulnerabilities.MaxCritical)
+			out.Vulnerabilities.MaxHigh = minInt(base.Vulnerabilities.MaxHigh, overlay.Vulnerabilities.MaxHigh)
+			out.Vulnerabilities.IgnoreUnfixed = base.Vulnerabilities.IgnoreUnfixed && overlay.Vulnerabilities.IgnoreUnfixed
+		}
+	}
+
+	out.MaxToolsWarning = stricterThreshold(base.MaxToolsWarning, overlay.MaxToolsWarning)
+	out.MaxToolsCritical = stricterThreshold(base.MaxToolsCritical, overlay.MaxToolsCritical)
+	// A longer certificate expiry window warns earlier.
+	out.CertExpiryWarningDays = maxInt(base.CertExpiryWarningDays, overlay.CertExpiryWarningDays)
+	if len(overlay.RequiredJWTClaims) > 0 {
+		out.RequiredJWTClaims = append([]string{}, base.RequiredJWTClaims...)
+		for _, c := range overlay.RequiredJWTClaims {
+			out.RequiredJWTClaims = appendUnique(out.RequiredJWTClaims, c)
+		}
+	}
+	out.MaxRateLimitRPS = stricterThreshold(base.MaxRateLimitRPS, overlay.MaxRateLimitRPS)
+	if len(overlay.RequiredPromptGuardBuiltins) > 0 {
+		out.RequiredPromptGuardBuiltins = append([]string{}, base.RequiredPromptGuardBuiltins...)
+		for _, b := range overlay.RequiredPromptGuardBuiltins {
+			out.RequiredPromptGuardBuiltins = appendUnique(out.RequiredPromptGuardBuiltins, b)
+		}
+	}
+	// Allowed CORS origins are intersected; an empty list allows any origin.
+	if len(overlay.AllowedCORSOrigins) > 0 {
+		if len(base.AllowedCORSOrigins) == 0 {
+			out.AllowedCORSOrigins = append([]string{}, overlay.AllowedCORSOrigins...)
+		} else {
+			out.AllowedCORSOrigins = []string{}
+			for _, o := range base.AllowedCORSOrigins {
+				if containsFold(overlay.AllowedCORSOrigins, o) {
+					out.AllowedCORSOrigins = append(out.AllowedCORSOrigins, o)
+				}
+			}
+		}
+	}
+
+	out.SeverityPenalties = SeverityPenalties{
+		Critical: maxInt(base.SeverityPenalties.Critical, overlay.SeverityPenalties.Critical),
+		High:     maxInt(base.SeverityPenalties.High, overlay.SeverityPenalties.High),
+		Medium:   maxInt(base.SeverityPenalties.Medium, overlay.SeverityPenalties.Medium),
+		Low:      maxInt(base.SeverityPenalties.Low, overlay.SeverityPenalties.Low),
+	}
+
+	// Checks and check overrides can be re-enabled but never disabled, and
+	// raised but never lowered in severity or penalty.
+	if len(base.Checks) > 0 || len(overlay.Checks) > 0 {
+		out.Checks = make(map[string]CheckConfig, len(base.Checks)+len(overlay.Checks))
+		for id, cfg := range base.Checks {
+			out.Checks[id] = cfg
+		}
+		for id, o := range overlay.Checks {
+			cfg := out.Checks[id]
+			cfg.Disabled = cfg.Disabled && o.Disabled
+			current := cfg.Severity
+			if current == "" {
+				if c, ok := LookupCheck(id); ok {
+					current = c.Severity()
+				}
+			}
+			if severityRank(o.Severity) > severityRank(current) {
+				cfg.Severity = o.Severity
+			}
+			out.Checks[id] = cfg
+		}
+	}
+
+	if len(base.CheckOverrides) > 0 || len(overlay.CheckOverrides) > 0 {
+		out.CheckOverrides = make(map[string]CheckOverride, len(base.CheckOverrides)+len(overlay.CheckOverrides))
+		for prefix, o := range base.CheckOverrides {
+			out.CheckOverrides[prefix] = o
+		}
+		for prefix, o := range overlay.CheckOverrides {
+			cur, exists := out.CheckOverrides[prefix]
+			cur.Disabled = exists && cur.Disabled && o.Disabled
+			// Without a base value to compare against, the overlay value may
+			// only raise what the finding would otherwise get.
+			if severityRank(o.Severity) > severityRank(cur.Severity) {
+				cur.RaiseOnly = cur.RaiseOnly || cur.Severity == ""
+				cur.Severity = o.Severity
+			}
+			if o.Penalty != nil && (cur.Penalty == nil || *o.Penalty > *cur.Penalty) {
+				cur.RaiseOnly = cur.RaiseOnly || cur.Penalty == nil
+				penalty := *o.Penalty
+				cur.Penalty = &penalty
+			}
+			out.CheckOverrides[prefix] = cur
+		}
+	}
+
+	// Probing can be enabled, never disabled; the allowed namespaces are unioned.
+	out.MCPProbe.Enabled = base.MCPProbe.Enabled || overlay.MCPProbe.Enabled
+	out.MCPProbe.AuthBypass = base.MCPProbe.AuthBypass || overlay.MCPProbe.AuthBypass
+	if len(overlay.MCPProbe.AllowedNamespaces) > 0 {
+		out.MCPProbe.AllowedNamespaces = append([]string{}, base.MCPProbe.AllowedNamespaces...)
+		for _, ns := range overlay.MCPProbe.AllowedNamespaces {
+			out.MCPProbe.AllowedNamespaces = appendUnique(out.MCPProbe.AllowedNamespaces, ns)
+		}
+	}
+
+	out.ToolSensitivity = tightenToolSensitivity(base.ToolSensitivity, overlay.ToolSensitivity)
+
+	out.CustomRules = append([]CustomRule{}, base.CustomRules...)
+	seen := make(map[string]bool, len(base.CustomRules))
+	for _, r := range base.CustomRules {
+		seen[r.ID] = true
+	}
+	for _, r := range overlay.CustomRules {
+		if !seen[r.ID] {
+			out.CustomRules = append(out.CustomRules, r)
+			seen[r.ID] = true
+		}
+	}
+
+	return out
+}
+
+// tightenToolSensitivity unions the classification patterns (more tools flagged)
+// and keeps the higher penalty and multiplier of the two policies.
+func tightenToolSensitivity(base, overlay ToolSensitivityPolicy) ToolSensitivityPolicy {
+	out := ToolSensitivityPolicy{
+		UnrestrictedMultiplier: maxInt(base.multiplier(), overlay.multiplier()),
+	}
+	if len(base.Patterns) > 0 || len(overlay.Patterns) > 0 {
+		out.Patterns = make(map[string][]string)
+		for _, class := range toolClassOrder {
+			_, inBase := base.Patterns[class]
+			_, inOverlay := overlay.Patterns[class]
+			if !inBase && !inOverlay {
+				continue
+			}
+			seen := map[string]bool{}
+			for _, pat := range append(append([]string{}, base.patternsFor(class)...), overlay.patternsFor(class)...) {
+				if !seen[pat] {
+					seen[pat] = true
+					out.Patterns[class] = append(out.Patterns[class], pat)
+				}
+			}
+		}
+	}
+	if len(base.Penalties) > 0 || len(overlay.Penalties) > 0 {
+		out.Penalties = make(map[string]int)
+		for _, class := range []string{ToolClassRead, ToolClassWrite, ToolClassDestructive, ToolClassExec} {
+			out.Penalties[class] = maxInt(base.penaltyFor(class), overlay.penaltyFor(class))
+		}
+	}
+	return out
+}
+
+// EffectivePolicies returns the policy followed by the effective policy of
+// every namespace it tightens, in namespace order.
+func (p Policy) EffectivePolicies() []Policy {
+	namespaces := make([]string, 0, len(p.NamespacePolicies))
+	for ns := range p.NamespacePolicies {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+	out := make([]Policy, 0, len(namespaces)+1)
+	out = append(out, p)
+	for _, ns := range namespaces {
+		out = append(out, p.NamespacePolicies[ns])
+	}
+	return out
+}
+
+// ForNamespace returns the effective policy for a namespace: the namespace-
+// scoped override when one exists, otherwise the policy itself.
+func (p Policy) ForNamespace(ns string) Policy {
+	if eff, ok := p.NamespacePolicies[ns]; ok {
+		return eff
+	}
+	return p
+}
+
+// stricterThreshold returns the lower of two tool-count thresholds, where 0
+// means "disabled" and is therefore the loosest value.
+func stricterThreshold(a, b int) int {
+	switch {
+	case a == 0:
+		return b
+	case b == 0:
+		return a
+	case b < a:
+		return b
+	}
+	return a
+}
+
+// severityRank orders severities from Low (1) to Critical (4); unknown is 0.
+func severityRank(s string) int {
+	switch s {
+	case SeverityCritical:
+		return 4
+	case SeverityHigh:
+		return 3
+	case SeverityMedium:
+		return 2
+	case SeverityLow:
+		return 1
+	}
+	return 0
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// ScopedPolicyNamespaces returns, for every namespace-scoped MCPGovernancePolicy
+// merged into this policy, the sorted namespaces it applies to.
+func (p Policy) ScopedPolicyNamespaces() map[string][]string {
+	baseline := make(map[string]bool, len(p.SourcePolicies))
+	for _, name := range p.SourcePolicies {
+		baseline[name] = true
+	}
+	out := map[string][]string{}
+	for ns, eff := range p.NamespacePolicies {
+		for _, name := range eff.SourcePolicies {
+			if !baseline[name] {
+				out[name] = append(out[name], ns)
+			}
+		}
+	}
+	for name := range out {
+		sort.Strings(out[name])
+	}
+	return out
+}
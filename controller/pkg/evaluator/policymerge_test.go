@@ -0,0 +1,285 @@
+package evaluator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResolvePolicies_NoPolicies(t *testing.T) {
+	p := ResolvePolicies(nil)
+	if !p.RequireAgentGateway || p.MaxToolsWarning != DefaultPolicy().MaxToolsWarning {
+		t.Error("Expected DefaultPolicy when no policies are discovered")
+	}
+}
+
+func TestResolvePolicies_BaselinesMergedInNameOrder(t *testing.T) {
+	b := DefaultPolicy()
+	b.Name = "b-baseline"
+	b.RequireRateLimit = true
+	b.ScanInterval = "10m"
+	a := DefaultPolicy()
+	a.Name = "a-baseline"
+	a.ScanInterval = "1m"
+
+	p := ResolvePolicies([]Policy{b, a})
+
+	if p.Name != "a-baseline" || p.ScanInterval != "1m" {
+		t.Errorf("Expected first policy by name to supply non-tightenable settings, got %s / %s", p.Name, p.ScanInterval)
+	}
+	if !p.RequireRateLimit {
+		t.Error("Expected RequireRateLimit from second baseline to tighten the result")
+	}
+	if !reflect.DeepEqual(p.SourcePolicies, []string{"a-baseline", "b-baseline"}) {
+		t.Errorf("SourcePolicies = %v", p.SourcePolicies)
+	}
+}
+
+func TestResolvePolicies_NamespaceCanOnlyTighten(t *testing.T) {
+	base := DefaultPolicy()
+	base.Name = "baseline"
+	base.RequireCORS = true
+	base.MaxToolsWarning = 10
+	base.MaxToolsCritical = 15
+
+	team := Policy{
+		Name:             "team-a",
+		ScopeNamespaces:  []string{"team-a"},
+		RequireCORS:      false, // attempt to loosen
+		RequireRateLimit: true,  // tighten
+		MaxToolsWarning:  5,     // tighten
+		MaxToolsCritical: 30,    // attempt to loosen
+		Weights:          ScoringWeights{AgentGatewayIntegration: 99},
+	}
+
+	p := ResolvePolicies([]Policy{team, base})
+
+	if len(p.NamespacePolicies) != 1 {
+		t.Fatalf("Expected 1 namespace policy, got %d", len(p.NamespacePolicies))
+	}
+	eff := p.ForNamespace("team-a")
+	if !eff.RequireCORS {
+		t.Error("Namespace policy must not be able to disable RequireCORS")
+	}
+	if !eff.RequireRateLimit {
+		t.Error("Namespace policy should be able to require rate limiting")
+	}
+	if eff.MaxToolsWarning != 5 || eff.MaxToolsCritical != 15 {
+		t.Errorf("tool thresholds = %d/%d, want 5/15", eff.MaxToolsWarning, eff.MaxToolsCritical)
+	}
+	if eff.Weights != base.Weights {
+		t.Error("Namespace policy must not change scoring weights")
+	}
+	if p.RequireRateLimit {
+		t.Error("Baseline should be unaffected by namespace policy")
+	}
+	if other := p.ForNamespace("other"); other.RequireRateLimit {
+		t.Error("Other namespaces should get the baseline")
+	}
+}
+
+func TestTightenPolicy_Checks(t *testing.T) {
+	base := DefaultPolicy()
+	base.Checks = map[string]CheckConfig{
+		"cors": {Disabled: true},
+	}
+	overlay := Policy{Name: "o", Checks: map[string]CheckConfig{
+		"cors":         {Severity: SeverityHigh}, // re-enable
+		"tls":          {Disabled: true},         // attempt to disable
+		"agentgateway": {Severity: SeverityLow},  // attempt to lower
+		"mtls":         {Severity: SeverityHigh}, // raise above default Medium
+	}}
+
+	out := TightenPolicy(base, overlay)
+
+	if out.Checks["cors"].Disabled {
+		t.Error("Overlay should be able to re-enable a check")
+	}
+	if out.Checks["tls"].Disabled {
+		t.Error("Overlay must not disable a check")
+	}
+	if out.Checks["agentgateway"].Severity != "" {
+		t.Errorf("Overlay must not lower severity, got %q", out.Checks["agentgateway"].Severity)
+	}
+	if out.Checks["mtls"].Severity != SeverityHigh {
+		t.Errorf("Overlay should raise mtls severity, got %q", out.Checks["mtls"].Severity)
+	}
+}
+
+func TestTightenPolicy_CustomRulesUnion(t *testing.T) {
+	base := Policy{CustomRules: []CustomRule{{ID: "a", Expression: "true"}}}
+	overlay := Policy{CustomRules: []CustomRule{{ID: "a", Expression: "false"}, {ID: "b"}}}
+
+	out := TightenPolicy(base, overlay)
+
+	if len(out.CustomRules) != 2 || out.CustomRules[0].Expression != "true" || out.CustomRules[1].ID != "b" {
+		t.Errorf("CustomRules = %+v", out.CustomRules)
+	}
+	if len(base.CustomRules) != 1 {
+		t.Error("TightenPolicy must not mutate base")
+	}
+}
+
+func TestScopedPolicyNamespaces(t *testing.T) {
+	base := DefaultPolicy()
+	base.Name = "baseline"
+	p := ResolvePolicies([]Policy{
+		base,
+		{Name: "team", ScopeNamespaces: []string{"b", "a"}},
+	})
+
+	got := p.ScopedPolicyNamespaces()
+	if !reflect.DeepEqual(got, map[string][]string{"team": {"a", "b"}}) {
+		t.Errorf("ScopedPolicyNamespaces = %v", got)
+	}
+}
+
+func TestEvaluate_NamespacePolicyFindings(t *testing.T) {
+	state := emptyState()
+	state.Gateways = []GatewayResource{
+		{Name: "gw", Namespace: "gw-ns", GatewayClassName: "agentgateway", Programmed: true},
+	}
+	state.KagentRemoteMCPServers = []KagentRemoteMCPServerResource{
+		{Name: "strict-srv", Namespace: "strict", URL: "http://strict-srv.strict:80/mcp", ToolCount: 8},
+		{Name: "loose-srv", Namespace: "loose", URL: "http://loose-srv.loose:80/mcp", ToolCount: 8},
+	}
+	base := DefaultPolicy()
+	base.Name = "baseline"
+	policy := ResolvePolicies([]Policy{
+		base,
+		{Name: "strict-team", ScopeNamespaces: []string{"strict"}, MaxToolsWarning: 3},
+	})
+
+	result := Evaluate(state, policy)
+
+	strictWarn, looseWarn := false, false
+	for _, f := range result.Findings {
+		if f.ID == "TOOLS-001-strict-srv" {
+			strictWarn = true
+		}
+		if f.ID == "TOOLS-001-loose-srv" {
+			looseWarn = true
+		}
+	}
+	for _, v := range result.MCPServerViews {
+		for _, f := range v.Findings {
+			if f.ID == "TOOLS-001-strict-srv" {
+				strictWarn = true
+			}
+		}
+	}
+	if !strictWarn {
+		t.Error("Expected tool-count finding for server in namespace with tighter threshold")
+	}
+	if looseWarn {
+		t.Error("Server in baseline namespace should not get the tightened threshold")
+	}
+}
+
+func TestNamespaceCheckState(t *testing.T) {
+	state := emptyState()
+	state.Namespaces = []string{"strict", "loose", "gw-ns"}
+	state.Workloads = []WorkloadResource{{Name: "a", Namespace: "strict"}, {Name: "b", Namespace: "loose"}}
+	state.AgentgatewayBackends = []AgentgatewayBackendResource{{Name: "be", Namespace: "gw-ns"}}
+	state.HTTPRoutes = []HTTPRouteResource{{Name: "route", Namespace: "gw-ns"}}
+	state.Services = []ServiceResource{{Name: "agentgateway", Namespace: "gw-ns"}}
+
+	scoped := namespaceCheckState(state, "strict")
+
+	if len(scoped.Workloads) != 1 || scoped.Workloads[0].Namespace != "strict" {
+		t.Errorf("Workloads = %+v, want only namespace strict", scoped.Workloads)
+	}
+	if len(scoped.AgentgatewayBackends) != 1 || len(scoped.HTTPRoutes) != 1 || len(scoped.Services) != 1 {
+		t.Error("Expected agentgateway routing resources of every namespace to be kept")
+	}
+	if len(scoped.Namespaces) != 3 {
+		t.Errorf("Namespaces = %v, want all namespaces", scoped.Namespaces)
+	}
+}
+
+func TestRunPolicyChecks_NamespacePolicyKeepsGatewayRouting(t *testing.T) {
+	state := emptyState()
+	state.Namespaces = []string{"team", "agentgateway-system"}
+	state.Gateways = []GatewayResource{
+		{Name: "gw", Namespace: "agentgateway-system", GatewayClassName: "agentgateway", Programmed: true},
+	}
+	state.Services = []ServiceResource{{Name: "agentgateway", Namespace: "agentgateway-system"}}
+	state.KagentRemoteMCPServers = []KagentRemoteMCPServerResource{
+		{Name: "tools", Namespace: "team", URL: "http://agentgateway.agentgateway-system:8080/mcp/team/tools"},
+	}
+	base := DefaultPolicy()
+	base.Name = "baseline"
+	policy := ResolvePolicies([]Policy{
+		base,
+		{Name: "team", ScopeNamespaces: []string{"team"}, MaxToolsWarning: 3},
+	})
+
+	for _, f := range runPolicyChecks(state, policy) {
+		if f.ID == "EXP-001-tools" {
+			t.Error("A server routed through agentgateway should not get EXP-001 under a namespace policy")
+		}
+	}
+}
+
+func TestTightenPolicy_CheckOverrides(t *testing.T) {
+	ten, thirty := 10, 30
+	base := Policy{Name: "base", CheckOverrides: map[string]CheckOverride{
+		"HDN-006": {Disabled: true},
+		"TLS-003": {Severity: SeverityHigh, Penalty: &thirty},
+	}}
+	overlay := Policy{Name: "team", CheckOverrides: map[string]CheckOverride{
+		"HDN-006": {},
+		"TLS-003": {Severity: SeverityLow, Penalty: &ten},
+		"SKL-":    {Severity: SeverityMedium, Disabled: true},
+	}}
+
+	out := TightenPolicy(base, overlay)
+
+	if out.CheckOverrides["HDN-006"].Disabled {
+		t.Error("Overlay should re-enable HDN-006")
+	}
+	tls := out.CheckOverrides["TLS-003"]
+	if tls.Severity != SeverityHigh || *tls.Penalty != 30 || tls.RaiseOnly {
+		t.Errorf("TLS-003 should keep the stricter base values, got %+v", tls)
+	}
+	skl := out.CheckOverrides["SKL-"]
+	if skl.Disabled {
+		t.Error("Scoped policy must not disable findings the baseline reports")
+	}
+	if !skl.RaiseOnly || skl.Severity != SeverityMedium {
+		t.Errorf("New overlay override should be raise-only, got %+v", skl)
+	}
+
+	f, _ := out.overrideFinding(Finding{ID: "SKL-SEC-001-x", Severity: SeverityCritical})
+	if f.Severity != SeverityCritical {
+		t.Errorf("Raise-only override lowered severity to %s", f.Severity)
+	}
+	f, _ = out.overrideFinding(Finding{ID: "SKL-005-x", Severity: SeverityLow})
+	if f.Severity != SeverityMedium {
+		t.Errorf("Raise-only override should raise Low to Medium, got %s", f.Severity)
+	}
+}
+
+func TestTightenPolicy_MCPProbe(t *testing.T) {
+	base := defaultPolicy()
+	base.MCPProbe.AllowedNamespaces = []string{"mcp"}
+	overlay := defaultPolicy()
+	overlay.MCPProbe = MCPProbePolicy{Enabled: true, AuthBypass: true, AllowedNamespaces: []string{"team", "mcp"}, Concurrency: 32}
+
+	got := TightenPolicy(base, overlay).MCPProbe
+	if !got.Enabled || !got.AuthBypass {
+		t.Errorf("expected the overlay to enable probing, got %+v", got)
+	}
+	if !reflect.DeepEqual(got.AllowedNamespaces, []string{"mcp", "team"}) {
+		t.Errorf("allowed namespaces = %v", got.AllowedNamespaces)
+	}
+	if got.Concurrency != base.MCPProbe.Concurrency {
+		t.Errorf("concurrency = %d, want the base's %d", got.Concurrency, base.MCPProbe.Concurrency)
+	}
+	if len(base.MCPProbe.AllowedNamespaces) != 1 {
+		t.Errorf("base allowed namespaces were modified: %v", base.MCPProbe.AllowedNamespaces)
+	}
+
+	if got := TightenPolicy(overlay, defaultPolicy()).MCPProbe; !got.Enabled || !got.AuthBypass {
+		t.Errorf("a namespace policy cannot disable probing, got %+v", got)
+	}
+}
@@ -0,0 +1,178 @@
+package evaluator
+
+import "sort"
+
+// maxWorstServers is how many of a team's lowest-scoring MCP servers are listed
+// on its scorecard.
+const maxWorstServers = 5
+
+// TeamScore is the per-team scorecard. Teams are resolved from the ownership
+// labels/annotations configured in Policy.Ownership.
+type TeamScore struct {
+	Team               string          `json:"team"`
+	Score              int             `json:"score"`
+	Grade              string          `json:"grade"`
+	Namespaces         []string        `json:"namespaces"`
+	ServerCount        int             `json:"serverCount"`
+	OpenFindings       int             `json:"openFindings"`
+	FindingsBySeverity map[string]int  `json:"findingsBySeverity"`
+	WorstServers       []TeamServerRef `json:"worstServers"`
+}
+
+// TeamServerRef is a compact reference to one of a team's MCP servers.
+type TeamServerRef struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Score     int    `json:"score"`
+	Grade     string `json:"grade"`
+	Findings  int    `json:"findings"`
+}
+
+// calculateTeamScores groups findings and MCP server views by owner. A team's
+// score is the average of its servers' scores; teams that own no MCP server
+// are scored like namespaces, by deducting finding penalties from 100.
+func calculateTeamScores(findings []Finding, views []MCPServerView, policy Policy) []TeamScore {
+	teams := make(map[string]*TeamScore)
+	namespaces := make(map[string]map[string]bool)
+	penalties := make(map[string]int)
+	serverTotals := make(map[string]int)
+	servers := make(map[string][]MCPServerView)
+
+	team := func(owner string) *TeamScore {
+		if owner == "" {
+			owner = UnownedTeam
+		}
+		t, ok := teams[owner]
+		if !ok {
+			t = &TeamScore{Team: owner, FindingsBySeverity: map[string]int{}}
+			teams[owner] = t
+			namespaces[owner] = make(map[string]bool)
+		}
+		return t
+	}
+
+	for _, f := range findings {
+		t := team(f.Owner)
+		t.OpenFindings++
+		t.FindingsBySeverity[f.Severity]++
+		ns := findingNamespace(f)
+		if ns != "" {
+			namespaces[t.Team][ns] = true
+		}
+		penalties[t.Team] += policy.ForNamespace(ns).findingPenalty(f)
+	}
+	for _, v := range views {
+		t := team(v.Owner)
+		t.ServerCount++
+		namespaces[t.Team][v.Namespace] = true
+		serverTotals[t.Team] += v.Score
+		servers[t.Team] = append(servers[t.Team], v)
+	}
+
+	out := make([]TeamScore, 0, len(teams))
+	for name, t := range teams {
+		if t.ServerCount > 0 {
+			t.Score = serverTotals[name] / t.ServerCount
+		} else {
+			t.Score = 100 - penalties[name]
+			if t.Score < 0 {
+				t.Score = 0
+			}
+		}
+		t.Grade = scoreGrade(t.Score)
+
+		for ns := range namespaces[name] {
+			t.Namespaces = append(t.Namespaces, ns)
+		}
+		sort.Strings(t.Namespaces)
+
+		t.WorstServers = worstServers(servers[name], maxWorstServers)
+		out = append(out, *t)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Team < out[j].Team })
+	return out
+}
+
+// worstServers returns up to limit views as TeamServerRefs, lowest score first.
+func worstServers(views []MCPServerView, limit int) []TeamServerRef {
+	sorted := make([]MCPServerView, len(views))
+	copy(sorted, views)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Score != sorted[j].Score {
+			return sorted[i].Score < sorted[j].Score
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+	if limit > 0 && len(sorted) > limit {
+		sorted = sorted[:limit]
+	}
+	refs := make([]TeamServerRef, 0, len(sorted))
+	for _, v := range sorted {
+		refs = append(refs, TeamServerRef{
+			ID:        v.ID,
+			Name:      v.Name,
+			Namespace: v.Namespace,
+			Score:     v.Score,
+			Grade:     v.Grade,
+			Findings:  len(v.Findings),
+		})
+	}
+	return refs
+}
+
+// scoreGrade maps a 0-100 score to the letter grade used for MCP servers.
+func scoreGrade(score int) string {
+	switch {
+	case score >= 90:
+		return "A"
+	case score >= 70:
+		return "B"
+	case score >= 50:
+		return "C"
+	case score >= 30:
+		return "D"
+	default:
+		return "F"
+	}
+}
+
+// TeamDetail is the full view of one team: its scorecard plus every MCP
+// server and open finding it owns.
+type TeamDetail struct {
+	TeamScore
+	Servers  []TeamServerRef `json:"servers"`
+	Findings []Finding       `json:"findings"`
+}
+
+// BuildTeamDetail returns the detail for the named team, or false if no
+// finding or MCP server in the result is owned by it.
+func BuildTeamDetail(result *EvaluationResult, team string) (TeamDetail, bool) {
+	var detail TeamDetail
+	found := false
+	for _, ts := range result.TeamScores {
+		if ts.Team == team {
+			detail.TeamScore = ts
+			found = true
+			break
+		}
+	}
+	if !found {
+		return detail, false
+	}
+
+	var views []MCPServerView
+	for _, v := range result.MCPServerViews {
+		if v.Owner == team {
+			views = append(views, v)
+		}
+	}
+	detail.Servers = worstServers(views, 0)
+	detail.Findings = []Finding{}
+	for _, f := range result.Findings {
+		if f.Owner == team {
+			detail.Findings = append(detail.Findings, f)
+		}
+	}
+	return detail, true
+}
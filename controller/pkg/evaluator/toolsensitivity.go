@@ -0,0 +1,230 @@
+package evaluator
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Tool sensitivity classes, from least to most dangerous.
+const (
+	ToolClassRead        = "read"
+	ToolClassWrite       = "write"
+	ToolClassDestructive = "destructive"
+	ToolClassExec        = "exec"
+)
+
+// toolClassOrder is the match precedence: a tool matching several classes gets
+// the most dangerous one.
+var toolClassOrder = []string{ToolClassExec, ToolClassDestructive, ToolClassWrite}
+
+// ToolSensitivityPolicy configures how MCP tools are classified and how much
+// exposed sensitive tools cost in ToolScope scoring. Empty fields use defaults.
+type ToolSensitivityPolicy struct {
+	// Patterns maps a class (write, destructive, exec) to name/verb patterns.
+	// A plain pattern matches a word of the tool name ("delete" matches
+	// "delete_namespace" and "deleteNamespace"); a pattern containing '*' is a
+	// glob matched against the whole lower-cased name. A class listed here
+	// replaces its default patterns. Unmatched tools are "read".
+	Patterns map[string][]string
+
+	// Penalties maps a class to the ToolScope points deducted per exposed tool.
+	Penalties map[string]int
+
+	// UnrestrictedMultiplier scales the penalties when the MCP server has no
+	// RBAC (CEL authorization) restriction. Default: 2.
+	UnrestrictedMultiplier int
+}
+
+// DefaultToolSensitivityPolicy returns the built-in tool classification.
+func DefaultToolSensitivityPolicy() ToolSensitivityPolicy {
+	return ToolSensitivityPolicy{
+		Patterns: map[string][]string{
+			// "run", "command" and "script" alone name read tools as often as not
+			// (get_run_status, list_commands, run_query), so they only count as
+			// the verb of run_command and run_script.
+			ToolClassExec: {"exec", "execute", "shell", "bash", "cmd", "eval", "spawn", "ssh",
+				"portforward", "run?command*", "runcommand*", "run?script*", "runscript*"},
+			ToolClassDestructive: {"delete", "drop", "remove", "rm", "destroy", "purge", "truncate",
+				"kill", "terminate", "wipe", "erase", "uninstall", "revoke", "evict", "drain", "prune"},
+			ToolClassWrite: {"create", "update", "write", "put", "patch", "set", "add", "insert",
+				"upload", "edit", "modify", "apply", "deploy", "scale", "restart", "rollout", "send",
+				"move", "rename", "merge", "push", "approve", "grant", "install", "annotate",
+				"cordon", "upsert"},
+		},
+		Penalties: map[string]int{
+			ToolClassRead:        0,
+			ToolClassWrite:       2,
+			ToolClassDestructive: 10,
+			ToolClassExec:        15,
+		},
+		UnrestrictedMultiplier: 2,
+	}
+}
+
+// patternsFor returns the configured patterns for a class, or its defaults.
+func (p ToolSensitivityPolicy) patternsFor(class string) []string {
+	if pats, ok := p.Patterns[class]; ok {
+		return pats
+	}
+	return DefaultToolSensitivityPolicy().Patterns[class]
+}
+
+// penaltyFor returns the per-tool penalty for a class, or its default.
+func (p ToolSensitivityPolicy) penaltyFor(class string) int {
+	if v, ok := p.Penalties[class]; ok {
+		return v
+	}
+	return DefaultToolSensitivityPolicy().Penalties[class]
+}
+
+func (p ToolSensitivityPolicy) multiplier() int {
+	if p.UnrestrictedMultiplier > 0 {
+		return p.UnrestrictedMultiplier
+	}
+	return DefaultToolSensitivityPolicy().UnrestrictedMultiplier
+}
+
+// ClassifyTool returns the sensitivity class (read, write, destructive, exec)
+// of an MCP tool name.
+func (p ToolSensitivityPolicy) ClassifyTool(name string) string {
+	lower := strings.ToLower(name)
+	words := toolNameWords(name)
+	for _, class := range toolClassOrder {
+		for _, pat := range p.patternsFor(class) {
+			pat = strings.ToLower(pat)
+			if strings.Contains(pat, "*") {
+				if ok, _ := path.Match(pat, lower); ok {
+					return class
+				}
+				continue
+			}
+			if words[pat] {
+				return class
+			}
+		}
+	}
+	return ToolClassRead
+}
+
+// toolNameWords splits a tool name into lower-cased words on separators and
+// camelCase boundaries ("deleteNamespace", "k8s-delete_ns" → delete, namespace / k8s, delete, ns).
+func toolNameWords(name string) map[string]bool {
+	words := map[string]bool{}
+	var cur []rune
+	flush := func() {
+		if len(cur) > 0 {
+			words[strings.ToLower(string(cur))] = true
+			cur = cur[:0]
+		}
+	}
+	runes := []rune(name)
+	for i, r := range runes {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
+		case unicode.IsUpper(r) && i > 0 && (unicode.IsLower(runes[i-1]) ||
+			(i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1]))):
+			flush()
+			cur = append(cur, r)
+		default:
+			cur = append(cur, r)
+		}
+	}
+	flush()
+	return words
+}
+
+// ToolRiskSummary counts tools per sensitivity class.
+type ToolRiskSummary struct {
+	Read        int      `json:"read"`
+	Write       int      `json:"write"`
+	Destructive int      `json:"destructive"`
+	Exec        int      `json:"exec"`
+	Sensitive   []string `json:"sensitive,omitempty"` // destructive and exec tool names
+}
+
+// summarizeToolRisk classifies names and counts them per class.
+func (p ToolSensitivityPolicy) summarizeToolRisk(names []string) ToolRiskSummary {
+	var s ToolRiskSummary
+	for _, n := range names {
+		switch p.ClassifyTool(n) {
+		case ToolClassExec:
+			s.Exec++
+			s.Sensitive = append(s.Sensitive, n)
+		case ToolClassDestructive:
+			s.Destructive++
+			s.Sensitive = append(s.Sensitive, n)
+		case ToolClassWrite:
+			s.Write++
+		default:
+			s.Read++
+		}
+	}
+	sort.Strings(s.Sensitive)
+	return s
+}
+
+// exposurePenalty is the ToolScope deduction for the exposed tools in s. It is
+// multiplied when no RBAC policy restricts who may call them.
+func (p ToolSensitivityPolicy) exposurePenalty(s ToolRiskSummary, hasRBAC bool) int {
+	d := s.Write*p.penaltyFor(ToolClassWrite) +
+		s.Destructive*p.penaltyFor(ToolClassDestructive) +
+		s.Exec*p.penaltyFor(ToolClassExec) +
+		s.Read*p.penaltyFor(ToolClassRead)
+	if !hasRBAC {
+		d *= p.multiplier()
+	}
+	return d
+}
+
+// classifyViewTools tags every discovered tool of the view and summarizes the
+// classes of the tools that remain exposed after policy enforcement.
+func classifyViewTools(view *MCPServerView, p ToolSensitivityPolicy) {
+	if len(view.ToolNames) > 0 {
+		view.ToolSensitivity = make(map[string]string, len(view.ToolNames))
+		for _, n := range view.ToolNames {
+			view.ToolSensitivity[n] = p.ClassifyTool(n)
+		}
+	}
+	view.ExposedToolRisk = p.summarizeToolRisk(view.EffectiveToolNames)
+}
+
+// checkToolSensitivity flags RemoteMCPServers that expose destructive or exec
+// tools (TOOLS-002). Exec tools make the finding Critical.
+func checkToolSensitivity(state *ClusterState, policy Policy) []Finding {
+	var findings []Finding
+	ts := time.Now().Format(time.RFC3339)
+
+	for _, rms := range state.KagentRemoteMCPServers {
+		risk := policy.ToolSensitivity.summarizeToolRisk(rms.ToolNames)
+		if risk.Destructive+risk.Exec == 0 {
+			continue
+		}
+		severity := SeverityHigh
+		if risk.Exec > 0 {
+			severity = SeverityCritical
+		}
+		tagged := make([]string, 0, len(risk.Sensitive))
+		for _, n := range risk.Sensitive {
+			tagged = append(tagged, fmt.Sprintf("%s (%s)", n, policy.ToolSensitivity.ClassifyTool(n)))
+		}
+		findings = append(findings, Finding{
+			ID:          fmt.Sprintf("TOOLS-002-%s", rms.Name),
+			Severity:    severity,
+			Category:    CategoryToolScope,
+			Title:       fmt.Sprintf("RemoteMCPServer '%s' exposes %d destructive and %d exec tools", rms.Name, risk.Destructive, risk.Exec),
+			Description: fmt.Sprintf("RemoteMCPServer '%s/%s' exposes sensitive tools: %s.", rms.Namespace, rms.Name, strings.Join(tagged, ", ")),
+			Impact:      "An agent that is prompt-injected or over-privileged can delete resources or run arbitrary commands through these tools.",
+			Remediation: "Restrict destructive and exec tools with an AgentgatewayPolicy CEL authorization rule (mcp.tool.name) so only the agents that need them can call them, or move them to a separate MCP server.",
+			ResourceRef: fmt.Sprintf("RemoteMCPServer/%s/%s", rms.Namespace, rms.Name),
+			Namespace:   rms.Namespace,
+			Timestamp:   ts,
+		})
+	}
+
+	return findings
+}
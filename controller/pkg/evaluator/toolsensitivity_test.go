@@ -0,0 +1,166 @@
+package evaluator
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestClassifyTool_Defaults(t *testing.T) {
+	p := DefaultToolSensitivityPolicy()
+	cases := map[string]string{
+		"get_pods":          ToolClassRead,
+		"listNamespaces":    ToolClassRead,
+		"search":            ToolClassRead,
+		"create_issue":      ToolClassWrite,
+		"k8s_apply_yaml":    ToolClassWrite,
+		"delete_namespace":  ToolClassDestructive,
+		"dropTable":         ToolClassDestructive,
+		"exec_pod":          ToolClassExec,
+		"run-shell-command": ToolClassExec,
+		"deleteAndExec":     ToolClassExec, // most dangerous class wins
+		"undeleted_items":   ToolClassRead, // whole words only
+		"run_command":       ToolClassExec,
+		"runScript":         ToolClassExec,
+		"get_run_status":    ToolClassRead,
+		"list_commands":     ToolClassRead,
+		"run_query":         ToolClassRead,
+		"list_scripts":      ToolClassRead,
+	}
+	for name, want := range cases {
+		if got := p.ClassifyTool(name); got != want {
+			t.Errorf("ClassifyTool(%q) = %s, want %s", name, got, want)
+		}
+	}
+}
+
+func TestClassifyTool_CustomPatterns(t *testing.T) {
+	p := ToolSensitivityPolicy{Patterns: map[string][]string{
+		ToolClassDestructive: {"nuke", "*_forever"},
+	}}
+	if got := p.ClassifyTool("nuke_cluster"); got != ToolClassDestructive {
+		t.Errorf("nuke_cluster = %s", got)
+	}
+	if got := p.ClassifyTool("archive_forever"); got != ToolClassDestructive {
+		t.Errorf("archive_forever = %s", got)
+	}
+	// The destructive defaults are replaced, the other classes are kept
+	if got := p.ClassifyTool("delete_pod"); got != ToolClassRead {
+		t.Errorf("delete_pod = %s, want read once defaults are replaced", got)
+	}
+	if got := p.ClassifyTool("exec_pod"); got != ToolClassExec {
+		t.Errorf("exec_pod = %s, want exec", got)
+	}
+}
+
+func scoredToolView(tools []string, hasRBAC bool) MCPServerView {
+	view := MCPServerView{
+		Name: "srv", Namespace: "mcp", Source: "KagentRemoteMCPServer",
+		ToolCount: len(tools), ToolNames: tools, EffectiveToolCount: len(tools), EffectiveToolNames: tools,
+		HasRBAC: hasRBAC, Findings: []Finding{},
+	}
+	p := defaultPolicy()
+	classifyViewTools(&view, p.ToolSensitivity)
+	scoreMCPServer(&view, p)
+	return view
+}
+
+func TestScoreMCPServer_ToolScopeRiskWeighted(t *testing.T) {
+	var readOnly []string
+	for i := 0; i < 8; i++ {
+		readOnly = append(readOnly, fmt.Sprintf("get_item_%d", i))
+	}
+	dangerous := []string{"delete_namespace", "exec_pod", "drop_table"}
+
+	safe := scoredToolView(readOnly, false)
+	risky := scoredToolView(dangerous, true)
+	riskyNoRBAC := scoredToolView(dangerous, false)
+
+	if safe.ScoreBreakdown.ToolScope != 100 {
+		t.Errorf("read-only ToolScope = %d, want 100", safe.ScoreBreakdown.ToolScope)
+	}
+	// 2 destructive × 10 + 1 exec × 15
+	if risky.ScoreBreakdown.ToolScope != 65 {
+		t.Errorf("destructive ToolScope with RBAC = %d, want 65", risky.ScoreBreakdown.ToolScope)
+	}
+	if riskyNoRBAC.ScoreBreakdown.ToolScope != 30 {
+		t.Errorf("destructive ToolScope without RBAC = %d, want 30", riskyNoRBAC.ScoreBreakdown.ToolScope)
+	}
+	if r := riskyNoRBAC.ExposedToolRisk; r.Destructive != 2 || r.Exec != 1 || len(r.Sensitive) != 3 {
+		t.Errorf("ExposedToolRisk = %+v", r)
+	}
+	if riskyNoRBAC.ToolSensitivity["exec_pod"] != ToolClassExec {
+		t.Errorf("ToolSensitivity = %v", riskyNoRBAC.ToolSensitivity)
+	}
+}
+
+func TestScoreMCPServer_ToolRiskDisabledByOverride(t *testing.T) {
+	view := MCPServerView{
+		Name: "srv", ToolCount: 1, ToolNames: []string{"exec_pod"},
+		EffectiveToolCount: 1, EffectiveToolNames: []string{"exec_pod"}, Findings: []Finding{},
+	}
+	p := defaultPolicy()
+	p.CheckOverrides = map[string]CheckOverride{"TOOLS-002": {Disabled: true}}
+	classifyViewTools(&view, p.ToolSensitivity)
+	scoreMCPServer(&view, p)
+	if view.ScoreBreakdown.ToolScope != 100 {
+		t.Errorf("ToolScope = %d, want 100 with TOOLS-002 disabled", view.ScoreBreakdown.ToolScope)
+	}
+}
+
+func TestCheckToolSensitivity(t *testing.T) {
+	state := &ClusterState{
+		KagentRemoteMCPServers: []KagentRemoteMCPServerResource{
+			{Name: "k8s-tools", Namespace: "kagent", ToolCount: 3, ToolNames: []string{"get_pods", "delete_pod", "exec_pod"}},
+			{Name: "docs", Namespace: "kagent", ToolCount: 2, ToolNames: []string{"search_docs", "create_page"}},
+			{Name: "cleanup", Namespace: "kagent", ToolCount: 1, ToolNames: []string{"purge_cache"}},
+		},
+	}
+	findings := checkToolSensitivity(state, defaultPolicy())
+	if len(findings) != 2 {
+		t.Fatalf("findings = %+v", findings)
+	}
+	got := map[string]Finding{}
+	for _, f := range findings {
+		got[f.ID] = f
+	}
+	if f := got["TOOLS-002-k8s-tools"]; f.Severity != SeverityCritical || !strings.Contains(f.Description, "exec_pod (exec)") {
+		t.Errorf("k8s-tools finding = %+v", f)
+	}
+	if f := got["TOOLS-002-cleanup"]; f.Severity != SeverityHigh {
+		t.Errorf("cleanup severity = %s, want High", f.Severity)
+	}
+}
+
+func TestComputeSuppressedFindingIDs_ToolSensitivity(t *testing.T) {
+	view := MCPServerView{Name: "k8s-tools", HasToolRestriction: true,
+		ExposedToolRisk: ToolRiskSummary{Read: 2}}
+	findings := []Finding{{ID: "TOOLS-002-k8s-tools"}}
+	if s := ComputeSuppressedFindingIDs([]MCPServerView{view}, findings); !s["TOOLS-002-k8s-tools"] {
+		t.Error("TOOLS-002 should be suppressed when no sensitive tool remains exposed")
+	}
+	view.ExposedToolRisk.Exec = 1
+	if s := ComputeSuppressedFindingIDs([]MCPServerView{view}, findings); s["TOOLS-002-k8s-tools"] {
+		t.Error("TOOLS-002 should stay while an exec tool is exposed")
+	}
+}
+
+func TestTightenPolicy_ToolSensitivity(t *testing.T) {
+	base := defaultPolicy()
+	overlay := Policy{Name: "strict", ToolSensitivity: ToolSensitivityPolicy{
+		Patterns:               map[string][]string{ToolClassDestructive: {"nuke"}},
+		Penalties:              map[string]int{ToolClassDestructive: 20, ToolClassExec: 5},
+		UnrestrictedMultiplier: 3,
+	}}
+	out := TightenPolicy(base, overlay).ToolSensitivity
+
+	if out.ClassifyTool("nuke_db") != ToolClassDestructive || out.ClassifyTool("delete_db") != ToolClassDestructive {
+		t.Errorf("patterns not unioned: %v", out.Patterns[ToolClassDestructive])
+	}
+	if out.penaltyFor(ToolClassDestructive) != 20 || out.penaltyFor(ToolClassExec) != 15 {
+		t.Errorf("penalties = %v", out.Penalties)
+	}
+	if out.multiplier() != 3 {
+		t.Errorf("multiplier = %d", out.multiplier())
+	}
+}
@@ -0,0 +1,325 @@
+package evaluator
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultMaxRateLimitRPS is the highest sustained local rate limit, in
+// requests per second, accepted when the policy does not set maxRateLimitRPS.
+const DefaultMaxRateLimitRPS = 100
+
+// CORSConfig is the traffic.cors block of an AgentgatewayPolicy.
+type CORSConfig struct {
+	AllowOrigins     []string `json:"allowOrigins,omitempty"`
+	AllowMethods     []string `json:"allowMethods,omitempty"`
+	AllowHeaders     []string `json:"allowHeaders,omitempty"`
+	AllowCredentials bool     `json:"allowCredentials"`
+	MaxAge           int      `json:"maxAge,omitempty"` // seconds
+}
+
+// RateLimitRule is one entry of an AgentgatewayPolicy's traffic.rateLimit.
+type RateLimitRule struct {
+	Type     string `json:"type"`               // "local" or "global"
+	Requests int    `json:"requests,omitempty"` // tokens per unit (local only)
+	Unit     string `json:"unit,omitempty"`     // "Seconds", "Minutes", "Hours" or "Days"
+	Burst    int    `json:"burst,omitempty"`
+}
+
+// PromptGuardRule is one request or response guard of a promptGuard block.
+type PromptGuardRule struct {
+	Phase    string   `json:"phase"`            // "request" or "response"
+	Kind     string   `json:"kind"`             // "regex", "openAIModeration", "webhook", ...
+	Action   string   `json:"action,omitempty"` // regex action: "Reject" or "Mask"
+	Matches  []string `json:"matches,omitempty"`
+	Builtins []string `json:"builtins,omitempty"`
+}
+
+// blocks reports whether the guard rejects a matching request rather than
+// only masking it. Moderation and webhook guards reject unless they set an
+// explicit non-Reject action.
+func (r PromptGuardRule) blocks() bool {
+	return (r.Action == "" && r.Kind != "regex") || strings.EqualFold(r.Action, "Reject")
+}
+
+var rateLimitUnitSeconds = map[string]float64{
+	"second": 1, "seconds": 1, "minute": 60, "minutes": 60,
+	"hour": 3600, "hours": 3600, "day": 86400, "days": 86400,
+}
+
+// RequestsPerSecond returns the sustained rate a local rule allows, or false
+// when the rule has no request count or an unknown unit.
+func (r RateLimitRule) RequestsPerSecond() (float64, bool) {
+	secs, ok := rateLimitUnitSeconds[strings.ToLower(r.Unit)]
+	if !ok || r.Requests <= 0 {
+		return 0, false
+	}
+	return float64(r.Requests) / secs, true
+}
+
+// maxRateLimitRPS returns the policy's rate limit ceiling.
+func (p Policy) maxRateLimitRPS() int {
+	if p.MaxRateLimitRPS <= 0 {
+		return DefaultMaxRateLimitRPS
+	}
+	return p.MaxRateLimitRPS
+}
+
+// permissiveCORSOrigins returns the reasons a CORS configuration admits
+// origins beyond a fixed set of trusted HTTPS sites: wildcards, "null",
+// plain-HTTP origins and origins outside the policy's allowedCORSOrigins. A
+// nil allowlist accepts any HTTPS origin; an empty one (two namespace policies
+// with disjoint allowlists) accepts none.
+func permissiveCORSOrigins(c *CORSConfig, allowed []string) []string {
+	var out []string
+	for _, o := range c.AllowOrigins {
+		lo := strings.ToLower(o)
+		switch {
+		case o == "*":
+			out = append(out, "any origin (*)")
+		case lo == "null":
+			out = append(out, "the 'null' origin")
+		case strings.Contains(o, "*"):
+			out = append(out, fmt.Sprintf("wildcard origin %s", o))
+		case strings.HasPrefix(lo, "http://") && !isLoopbackOrigin(lo):
+			out = append(out, fmt.Sprintf("plain-HTTP origin %s", o))
+		case allowed != nil && !containsFold(allowed, o):
+			out = append(out, fmt.Sprintf("origin %s not in allowedCORSOrigins", o))
+		}
+	}
+	if containsString(c.AllowMethods, "*") {
+		out = append(out, "any method (*)")
+	}
+	if containsString(c.AllowHeaders, "*") {
+		out = append(out, "any request header (*)")
+	}
+	return out
+}
+
+func isLoopbackOrigin(origin string) bool {
+	host := strings.TrimPrefix(origin, "http://")
+	return host == "localhost" || strings.HasPrefix(host, "localhost:") ||
+		host == "127.0.0.1" || strings.HasPrefix(host, "127.0.0.1:")
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkCORSConfig scores the CORS settings of each AgentgatewayPolicy: any
+// origin combined with credentials (CORS-004) and origins, methods or headers
+// broader than the policy allows (CORS-005).
+func checkCORSConfig(state *ClusterState, policy Policy) []Finding {
+	var findings []Finding
+	if !policy.RequireCORS {
+		return findings
+	}
+	ts := time.Now().Format(time.RFC3339)
+	for _, p := range state.AgentgatewayPolicies {
+		if p.CORS == nil {
+			continue
+		}
+		if p.CORS.AllowCredentials && containsString(p.CORS.AllowOrigins, "*") {
+			findings = append(findings, Finding{
+				ID:          fmt.Sprintf("CORS-004-%s", p.Name),
+				Severity:    SeverityHigh,
+				Category:    CategoryCORS,
+				Title:       fmt.Sprintf("CORS on policy '%s' allows credentials from any origin", p.Name),
+				Description: fmt.Sprintf("AgentgatewayPolicy '%s/%s' sets allowOrigins to '*' together with allowCredentials: true.", p.Namespace, p.Name),
+				Impact:      "Any website a user visits can send credentialed requests to the MCP endpoint and read the responses, driving tools with the user's session.",
+				Remediation: "List the exact trusted origins in traffic.cors.allowOrigins, or set allowCredentials to false.",
+				ResourceRef: agentgatewayPolicyRef(p),
+				Namespace:   p.Namespace,
+				Timestamp:   ts,
+			})
+			continue
+		}
+		if reasons := permissiveCORSOrigins(p.CORS, policy.AllowedCORSOrigins); len(reasons) > 0 {
+			findings = append(findings, Finding{
+				ID:          fmt.Sprintf("CORS-005-%s", p.Name),
+				Severity:    SeverityMedium,
+				Category:    CategoryCORS,
+				Title:       fmt.Sprintf("Overly permissive CORS on policy '%s'", p.Name),
+				Description: fmt.Sprintf("AgentgatewayPolicy '%s/%s' allows %s.", p.Namespace, p.Name, strings.Join(reasons, ", ")),
+				Impact:      "Untrusted or unencrypted web origins can call MCP tools from a user's browser.",
+				Remediation: "Restrict traffic.cors.allowOrigins to the HTTPS origins of trusted MCP clients and list methods and headers explicitly.",
+				ResourceRef: agentgatewayPolicyRef(p),
+				Namespace:   p.Namespace,
+				Timestamp:   ts,
+			})
+		}
+	}
+	return findings
+}
+
+// checkRateLimitConfig scores the rate limits of each AgentgatewayPolicy:
+// local limits above the policy's maxRateLimitRPS (RL-003) and rateLimit
+// sections without any effective limit (RL-004).
+func checkRateLimitConfig(state *ClusterState, policy Policy) []Finding {
+	var findings []Finding
+	if !policy.RequireRateLimit {
+		return findings
+	}
+	ts := time.Now().Format(time.RFC3339)
+	maxRPS := policy.maxRateLimitRPS()
+	for _, p := range state.AgentgatewayPolicies {
+		if !p.HasRateLimit {
+			continue
+		}
+		effective := false
+		var loose []string
+		for _, r := range p.RateLimits {
+			if r.Type == "global" {
+				effective = true
+				continue
+			}
+			rps, ok := r.RequestsPerSecond()
+			if !ok {
+				continue
+			}
+			effective = true
+			if rps > float64(maxRPS) {
+				loose = append(loose, fmt.Sprintf("%d requests per %s (burst %d, %.0f/s)", r.Requests, strings.TrimSuffix(strings.ToLower(r.Unit), "s"), r.Burst, rps))
+			}
+		}
+		if !effective {
+			findings = append(findings, Finding{
+				ID:          fmt.Sprintf("RL-004-%s", p.Name),
+				Severity:    SeverityMedium,
+				Category:    CategoryRateLimit,
+				Title:       fmt.Sprintf("Rate limit on policy '%s' sets no limit", p.Name),
+				Description: fmt.Sprintf("AgentgatewayPolicy '%s/%s' has a traffic.rateLimit section, but no local rule with a request count and unit, and no global rule.", p.Namespace, p.Name),
+				Impact:      "Requests to the MCP endpoints are not actually limited.",
+				Remediation: "Add traffic.rateLimit.local entries with requests, unit and burst.",
+				ResourceRef: agentgatewayPolicyRef(p),
+				Namespace:   p.Namespace,
+				Timestamp:   ts,
+			})
+			continue
+		}
+		if len(loose) > 0 {
+			findings = append(findings, Finding{
+				ID:          fmt.Sprintf("RL-003-%s", p.Name),
+				Severity:    SeverityHigh,
+				Category:    CategoryRateLimit,
+				Title:       fmt.Sprintf("Rate limit on policy '%s' exceeds %d requests/s", p.Name, maxRPS),
+				Description: fmt.Sprintf("AgentgatewayPolicy '%s/%s' allows %s, above the governance maximum of %d requests per second.", p.Namespace, p.Name, strings.Join(loose, "; "), maxRPS),
+				Impact:      "The limit is too high to stop tool abuse, credential stuffing or runaway agents from exhausting the MCP server.",
+				Remediation: fmt.Sprintf("Lower traffic.rateLimit.local requests to at most %d per second, or raise maxRateLimitRPS in the governance policy if the load is expected.", maxRPS),
+				ResourceRef: agentgatewayPolicyRef(p),
+				Namespace:   p.Namespace,
+				Timestamp:   ts,
+			})
+		}
+	}
+	return findings
+}
+
+// checkPromptGuardConfig scores the prompt guard of each AgentgatewayPolicy:
+// no request guard that rejects (PG-003), no response guard (PG-004) and
+// builtin detectors required by the policy but not configured (PG-005).
+func checkPromptGuardConfig(state *ClusterState, policy Policy) []Finding {
+	var findings []Finding
+	if !policy.RequirePromptGuard {
+		return findings
+	}
+	ts := time.Now().Format(time.RFC3339)
+	for _, p := range state.AgentgatewayPolicies {
+		if !p.HasPromptGuard {
+			continue
+		}
+		ref := agentgatewayPolicyRef(p)
+		blocking, response := false, false
+		var builtins []string
+		for _, r := range p.PromptGuardRules {
+			if r.Phase == "request" && r.blocks() {
+				blocking = true
+			}
+			if r.Phase == "response" {
+				response = true
+			}
+			for _, b := range r.Builtins {
+				builtins = appendUnique(builtins, strings.ToLower(b))
+			}
+		}
+		if !blocking {
+			findings = append(findings, Finding{
+				ID:          fmt.Sprintf("PG-003-%s", p.Name),
+				Severity:    SeverityMedium,
+				Category:    CategoryPromptGuard,
+				Title:       fmt.Sprintf("Prompt guard on policy '%s' never rejects a request", p.Name),
+				Description: fmt.Sprintf("AgentgatewayPolicy '%s/%s' has a promptGuard section, but no request guard with action Reject, moderation or webhook.", p.Namespace, p.Name),
+				Impact:      "Prompt injection and jailbreak attempts reach the model and its MCP tools; matches are at most masked.",
+				Remediation: "Add promptGuard.request entries with regex action Reject (or a moderation/webhook guard) for injection patterns.",
+				ResourceRef: ref,
+				Namespace:   p.Namespace,
+				Timestamp:   ts,
+			})
+		}
+		if !response {
+			findings = append(findings, Finding{
+				ID:          fmt.Sprintf("PG-004-%s", p.Name),
+				Severity:    SeverityLow,
+				Category:    CategoryPromptGuard,
+				Title:       fmt.Sprintf("Prompt guard on policy '%s' does not inspect responses", p.Name),
+				Description: fmt.Sprintf("AgentgatewayPolicy '%s/%s' has no promptGuard.response rules.", p.Namespace, p.Name),
+				Impact:      "Sensitive data returned by models or tools is passed to clients unmasked.",
+				Remediation: "Add promptGuard.response regex rules with action Mask for sensitive builtins such as CreditCard, Ssn and Email.",
+				ResourceRef: ref,
+				Namespace:   p.Namespace,
+				Timestamp:   ts,
+			})
+		}
+		var missing []string
+		for _, b := range policy.RequiredPromptGuardBuiltins {
+			if !containsString(builtins, strings.ToLower(b)) {
+				missing = append(missing, b)
+			}
+		}
+		if len(missing) > 0 {
+			findings = append(findings, Finding{
+				ID:          fmt.Sprintf("PG-005-%s", p.Name),
+				Severity:    SeverityMedium,
+				Category:    CategoryPromptGuard,
+				Title:       fmt.Sprintf("Prompt guard on policy '%s' does not detect %s", p.Name, strings.Join(missing, ", ")),
+				Description: fmt.Sprintf("AgentgatewayPolicy '%s/%s' does not configure the builtin detectors %s required by the governance policy.", p.Namespace, p.Name, strings.Join(missing, ", ")),
+				Impact:      "These kinds of sensitive data pass through the gateway undetected.",
+				Remediation: fmt.Sprintf("Add %s to the builtins of a promptGuard regex rule.", strings.Join(missing, ", ")),
+				ResourceRef: ref,
+				Namespace:   p.Namespace,
+				Timestamp:   ts,
+			})
+		}
+	}
+	return findings
+}
+
+// isCORSQualityFinding reports whether a finding ID is a CORS configuration
+// issue that lowers the per-server CORS score even when CORS is present
+// (CORS-004..005).
+func isCORSQualityFinding(id string) bool {
+	return strings.HasPrefix(id, "CORS-004-") || strings.HasPrefix(id, "CORS-005-")
+}
+
+// isRateLimitQualityFinding reports whether a finding ID is a rate limit
+// configuration issue (RL-003..004).
+func isRateLimitQualityFinding(id string) bool {
+	return strings.HasPrefix(id, "RL-003-") || strings.HasPrefix(id, "RL-004-")
+}
+
+// isPromptGuardQualityFinding reports whether a finding ID is a prompt guard
+// configuration issue (PG-003..005).
+func isPromptGuardQualityFinding(id string) bool {
+	for _, code := range []string{"PG-003-", "PG-004-", "PG-005-"} {
+		if strings.HasPrefix(id, code) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+package evaluator
+
+import "testing"
+
+func trafficPolicy(mutate func(*AgentgatewayPolicyResource)) *ClusterState {
+	p := AgentgatewayPolicyResource{
+		Name: "p1", Namespace: "agentgateway-system",
+		HasCORS: true, HasCSRF: true, HasRateLimit: true, HasPromptGuard: true,
+		CORS:       &CORSConfig{AllowOrigins: []string{"https://app.example.com"}, AllowMethods: []string{"GET", "POST"}, AllowCredentials: true},
+		RateLimits: []RateLimitRule{{Type: "local", Requests: 100, Unit: "Minutes", Burst: 20}},
+		PromptGuardRules: []PromptGuardRule{
+			{Phase: "request", Kind: "regex", Action: "Reject", Builtins: []string{"CreditCard"}},
+			{Phase: "response", Kind: "regex", Action: "Mask", Builtins: []string{"Ssn"}},
+		},
+	}
+	mutate(&p)
+	return &ClusterState{AgentgatewayPolicies: []AgentgatewayPolicyResource{p}}
+}
+
+func trafficFindingIDs(state *ClusterState, policy Policy) []string {
+	var ids []string
+	for _, check := range []func(*ClusterState, Policy) []Finding{checkCORSConfig, checkRateLimitConfig, checkPromptGuardConfig} {
+		for _, f := range check(state, policy) {
+			ids = append(ids, f.ID)
+		}
+	}
+	return ids
+}
+
+func TestTrafficPolicyChecks(t *testing.T) {
+	policy := defaultPolicy()
+	policy.RequireRateLimit = true
+	policy.RequirePromptGuard = true
+
+	tests := []struct {
+		name   string
+		mutate func(*AgentgatewayPolicyResource)
+		want   string
+	}{
+		{"well configured", func(*AgentgatewayPolicyResource) {}, ""},
+		{"wildcard origin with credentials", func(p *AgentgatewayPolicyResource) { p.CORS.AllowOrigins = []string{"*"} }, "CORS-004-p1"},
+		{"wildcard origin", func(p *AgentgatewayPolicyResource) {
+			p.CORS.AllowOrigins = []string{"*"}
+			p.CORS.AllowCredentials = false
+		}, "CORS-005-p1"},
+		{"plain-HTTP origin", func(p *AgentgatewayPolicyResource) { p.CORS.AllowOrigins = []string{"http://app.example.com"} }, "CORS-005-p1"},
+		{"loopback origin", func(p *AgentgatewayPolicyResource) { p.CORS.AllowOrigins = []string{"http://localhost:3000"} }, ""},
+		{"any method", func(p *AgentgatewayPolicyResource) { p.CORS.AllowMethods = []string{"*"} }, "CORS-005-p1"},
+		{"one million per second", func(p *AgentgatewayPolicyResource) {
+			p.RateLimits = []RateLimitRule{{Type: "local", Requests: 1000000, Unit: "Seconds"}}
+		}, "RL-003-p1"},
+		{"empty rate limit", func(p *AgentgatewayPolicyResource) { p.RateLimits = nil }, "RL-004-p1"},
+		{"global rate limit", func(p *AgentgatewayPolicyResource) { p.RateLimits = []RateLimitRule{{Type: "global"}} }, ""},
+		{"mask-only request guard", func(p *AgentgatewayPolicyResource) { p.PromptGuardRules[0].Action = "Mask" }, "PG-003-p1"},
+		{"moderation request guard", func(p *AgentgatewayPolicyResource) {
+			p.PromptGuardRules[0] = PromptGuardRule{Phase: "request", Kind: "openAIModeration"}
+		}, ""},
+		{"no response guard", func(p *AgentgatewayPolicyResource) { p.PromptGuardRules = p.PromptGuardRules[:1] }, "PG-004-p1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids := trafficFindingIDs(trafficPolicy(tt.mutate), policy)
+			if tt.want == "" {
+				if len(ids) != 0 {
+					t.Errorf("findings = %v, want none", ids)
+				}
+				return
+			}
+			if len(ids) != 1 || ids[0] != tt.want {
+				t.Errorf("findings = %v, want [%s]", ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestTrafficPolicyChecks_PolicyThresholds(t *testing.T) {
+	policy := defaultPolicy()
+	policy.RequireRateLimit = true
+	policy.RequirePromptGuard = true
+	state := trafficPolicy(func(p *AgentgatewayPolicyResource) {
+		p.RateLimits = []RateLimitRule{{Type: "local", Requests: 600, Unit: "Minutes"}}
+	})
+
+	policy.MaxRateLimitRPS = 5
+	policy.AllowedCORSOrigins = []string{"https://admin.example.com"}
+	policy.RequiredPromptGuardBuiltins = []string{"creditcard", "Email"}
+	got := map[string]bool{}
+	for _, id := range trafficFindingIDs(state, policy) {
+		got[id] = true
+	}
+	for _, want := range []string{"RL-003-p1", "CORS-005-p1", "PG-005-p1"} {
+		if !got[want] {
+			t.Errorf("findings = %v, want %s", got, want)
+		}
+	}
+	if len(got) != 3 {
+		t.Errorf("findings = %v, want exactly 3", got)
+	}
+}
+
+func TestScoreMCPServer_TrafficPolicyFindings(t *testing.T) {
+	policy := defaultPolicy()
+	policy.RequireRateLimit = true
+	view := &MCPServerView{HasJWT: true, JWTMode: "Strict", RoutedThroughGateway: true, HasCORS: true, HasRateLimit: true,
+		Findings: []Finding{
+			{ID: "CORS-004-p1", Severity: SeverityHigh, Category: CategoryCORS},
+			{ID: "RL-003-p1", Severity: SeverityHigh, Category: CategoryRateLimit},
+		}}
+	scoreMCPServer(view, policy)
+	want := 100 - policy.SeverityPenalties.High
+	if view.ScoreBreakdown.CORS != want || view.ScoreBreakdown.RateLimit != want {
+		t.Errorf("CORS = %d, RateLimit = %d, want %d", view.ScoreBreakdown.CORS, view.ScoreBreakdown.RateLimit, want)
+	}
+}
+
+func TestTightenPolicy_TrafficThresholds(t *testing.T) {
+	base := Policy{MaxRateLimitRPS: 100, AllowedCORSOrigins: []string{"https://a.example.com", "https://b.example.com"}}
+	got := TightenPolicy(base, Policy{MaxRateLimitRPS: 10, AllowedCORSOrigins: []string{"https://b.example.com"}, RequiredPromptGuardBuiltins: []string{"Ssn"}})
+	if got.MaxRateLimitRPS != 10 || len(got.AllowedCORSOrigins) != 1 || got.AllowedCORSOrigins[0] != "https://b.example.com" || len(got.RequiredPromptGuardBuiltins) != 1 {
+		t.Errorf("tightened = %+v", got)
+	}
+
+	// Disjoint allowlists allow no origin rather than any.
+	got = TightenPolicy(base, Policy{AllowedCORSOrigins: []string{"https://c.example.com"}})
+	if got.AllowedCORSOrigins == nil || len(got.AllowedCORSOrigins) != 0 {
+		t.Errorf("disjoint allowlists = %#v", got.AllowedCORSOrigins)
+	}
+}
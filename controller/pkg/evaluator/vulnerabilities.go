@@ -0,0 +1,347 @@
+package evaluator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/imageverify"
+)
+
+// VulnerabilityReportResource holds a Trivy Operator VulnerabilityReport
+// (aquasecurity.github.io/v1alpha1) for one container of a workload.
+type VulnerabilityReportResource struct {
+	Name      string
+	Namespace string
+	OwnerKind string // workload kind; ReplicaSets are resolved to their Deployment
+	OwnerName string
+	Container string
+	Image     string // registry/repository:tag as scanned
+	Digest    string
+
+	// Counts from report.summary
+	Critical int
+	High     int
+	Medium   int
+	Low      int
+
+	// Critical and High vulnerabilities only; lower severities are counted
+	// but not kept.
+	Vulnerabilities []Vulnerability
+}
+
+// Vulnerability is a single CVE entry of a VulnerabilityReport.
+type Vulnerability struct {
+	ID               string `json:"id"`
+	Severity         string `json:"severity"` // CRITICAL, HIGH, ...
+	Package          string `json:"package"`
+	InstalledVersion string `json:"installedVersion"`
+	FixedVersion     string `json:"fixedVersion,omitempty"`
+	Title            string `json:"title,omitempty"`
+}
+
+// Fixable reports whether a fixed package version is available.
+func (v Vulnerability) Fixable() bool {
+	return v.FixedVersion != ""
+}
+
+// ConfigAuditReportResource holds a Trivy Operator ConfigAuditReport for a
+// workload.
+type ConfigAuditReportResource struct {
+	Name      string
+	Namespace string
+	OwnerKind string
+	OwnerName string
+	Critical  int
+	High      int
+	Medium    int
+	Low       int
+
+	// Failed Critical and High checks only
+	FailedChecks []ConfigAuditCheck
+}
+
+// ConfigAuditCheck is a failed check of a ConfigAuditReport.
+type ConfigAuditCheck struct {
+	ID       string
+	Severity string
+	Title    string
+}
+
+// VulnerabilityPolicy sets the CVE thresholds for MCP server workloads.
+type VulnerabilityPolicy struct {
+	// Enabled turns on the VULN-* checks and the Vulnerabilities score.
+	Enabled bool
+
+	// MaxCritical is the number of critical CVEs a workload may carry before
+	// VULN-001 is reported. Default: 0
+	MaxCritical int
+
+	// MaxHigh is the number of high CVEs a workload may carry before VULN-002
+	// is reported. Default: 5
+	MaxHigh int
+
+	// IgnoreUnfixed counts only CVEs with a fixed version available toward
+	// MaxCritical and MaxHigh.
+	IgnoreUnfixed bool
+}
+
+// DefaultVulnerabilityPolicy returns the built-in CVE thresholds.
+func DefaultVulnerabilityPolicy() VulnerabilityPolicy {
+	return VulnerabilityPolicy{Enabled: true, MaxCritical: 0, MaxHigh: 5}
+}
+
+// WorkloadVulnerabilities summarises the scan reports of an MCP server's
+// backing workload.
+type WorkloadVulnerabilities struct {
+	Scanned         bool                   `json:"scanned"` // at least one VulnerabilityReport matched the workload
+	Critical        int                    `json:"critical"`
+	High            int                    `json:"high"`
+	Medium          int                    `json:"medium"`
+	Low             int                    `json:"low"`
+	FixableCritical int                    `json:"fixableCritical"`
+	FixableHigh     int                    `json:"fixableHigh"`
+	Images          []ImageVulnerabilities `json:"images,omitempty"`
+
+	// Failed Critical/High checks of the workload's ConfigAuditReport
+	ConfigAuditFailures []string `json:"configAuditFailures,omitempty"`
+}
+
+// ImageVulnerabilities is the CVE summary of one scanned container image.
+type ImageVulnerabilities struct {
+	Container       string          `json:"container"`
+	Image           string          `json:"image"`
+	Digest          string          `json:"digest,omitempty"`
+	Report          string          `json:"report"`
+	Critical        int             `json:"critical"`
+	High            int             `json:"high"`
+	Medium          int             `json:"medium"`
+	Low             int             `json:"low"`
+	FixableCritical int             `json:"fixableCritical"`
+	FixableHigh     int             `json:"fixableHigh"`
+	Fixable         []Vulnerability `json:"fixable,omitempty"` // fixable Critical and High CVEs
+}
+
+// sameImage reports whether two image references name the same image. A
+// digest on both sides decides on its own; otherwise repository and tag must
+// match.
+func sameImage(a, b string) bool {
+	ra, err := imageverify.ParseReference(a)
+	if err != nil {
+		return false
+	}
+	rb, err := imageverify.ParseReference(b)
+	if err != nil {
+		return false
+	}
+	if ra.Name() != rb.Name() {
+		return false
+	}
+	if ra.Digest != "" && rb.Digest != "" {
+		return ra.Digest == rb.Digest
+	}
+	return ra.Tag != "" && ra.Tag == rb.Tag
+}
+
+// workloadRunsImage reports whether the workload currently runs the image,
+// matching by digest when the workload pins one.
+func workloadRunsImage(w WorkloadResource, image, digest string) bool {
+	for _, img := range w.ImageNames {
+		if sameImage(img, image) {
+			return true
+		}
+		if digest != "" && strings.HasSuffix(img, "@"+digest) {
+			return true
+		}
+	}
+	return false
+}
+
+// workloadVulnerabilityReports returns the VulnerabilityReports of the
+// workload, one per container. Reports are correlated by owner reference and
+// must scan an image the workload still runs, so reports left behind by old
+// ReplicaSets are ignored. Reports without a matching owner fall back to
+// image matching within the namespace.
+func workloadVulnerabilityReports(state *ClusterState, w WorkloadResource) []VulnerabilityReportResource {
+	var owned, byImage []VulnerabilityReportResource
+	for _, r := range state.VulnerabilityReports {
+		if r.Namespace != w.Namespace || !workloadRunsImage(w, r.Image, r.Digest) {
+			continue
+		}
+		if r.OwnerKind == w.Kind && r.OwnerName == w.Name {
+			owned = append(owned, r)
+		} else if r.OwnerName == "" {
+			byImage = append(byImage, r)
+		}
+	}
+	reports := owned
+	if len(reports) == 0 {
+		reports = byImage
+	}
+
+	var out []VulnerabilityReportResource
+	seen := map[string]bool{}
+	for _, r := range reports {
+		key := r.Container + "|" + r.Image
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		out = append(out, r)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Container < out[j].Container })
+	return out
+}
+
+// workloadConfigAudit returns the ConfigAuditReport owned by the workload.
+func workloadConfigAudit(state *ClusterState, w WorkloadResource) (ConfigAuditReportResource, bool) {
+	for _, r := range state.ConfigAuditReports {
+		if r.Namespace == w.Namespace && r.OwnerKind == w.Kind && r.OwnerName == w.Name {
+			return r, true
+		}
+	}
+	return ConfigAuditReportResource{}, false
+}
+
+// analyzeWorkloadVulnerabilities aggregates the scan reports of a workload.
+func analyzeWorkloadVulnerabilities(state *ClusterState, w WorkloadResource) WorkloadVulnerabilities {
+	var out WorkloadVulnerabilities
+	for _, r := range workloadVulnerabilityReports(state, w) {
+		img := ImageVulnerabilities{
+			Container: r.Container,
+			Image:     r.Image,
+			Digest:    r.Digest,
+			Report:    r.Name,
+			Critical:  r.Critical,
+			High:      r.High,
+			Medium:    r.Medium,
+			Low:       r.Low,
+		}
+		for _, v := range r.Vulnerabilities {
+			if !v.Fixable() {
+				continue
+			}
+			switch strings.ToUpper(v.Severity) {
+			case "CRITICAL":
+				img.FixableCritical++
+			case "HIGH":
+				img.FixableHigh++
+			default:
+				continue
+			}
+			img.Fixable = append(img.Fixable, v)
+		}
+		out.Scanned = true
+		out.Critical += img.Critical
+		out.High += img.High
+		out.Medium += img.Medium
+		out.Low += img.Low
+		out.FixableCritical += img.FixableCritical
+		out.FixableHigh += img.FixableHigh
+		out.Images = append(out.Images, img)
+	}
+	if audit, ok := workloadConfigAudit(state, w); ok {
+		for _, c := range audit.FailedChecks {
+			out.ConfigAuditFailures = append(out.ConfigAuditFailures, fmt.Sprintf("%s (%s): %s", c.ID, c.Severity, c.Title))
+		}
+	}
+	return out
+}
+
+// fixableSummary lists up to limit fixable CVEs as "CVE pkg installed→fixed".
+func fixableSummary(vulns []Vulnerability, limit int) string {
+	var parts []string
+	for i, v := range vulns {
+		if i == limit {
+			parts = append(parts, fmt.Sprintf("and %d more", len(vulns)-limit))
+			break
+		}
+		parts = append(parts, fmt.Sprintf("%s %s %s→%s", v.ID, v.Package, v.InstalledVersion, v.FixedVersion))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// checkVulnerabilities scores the Trivy Operator reports of MCP server
+// workloads against the policy's CVE thresholds (VULN-001..005). Nothing is
+// reported when no VulnerabilityReports exist, i.e. the operator is not
+// installed.
+func checkVulnerabilities(state *ClusterState, policy Policy) []Finding {
+	var findings []Finding
+	vp := policy.Vulnerabilities
+	if !vp.Enabled || len(state.VulnerabilityReports) == 0 {
+		return findings
+	}
+	ts := time.Now().Format(time.RFC3339)
+
+	for _, w := range mcpWorkloads(state) {
+		v := analyzeWorkloadVulnerabilities(state, w)
+		ref := fmt.Sprintf("%s/%s/%s", w.Kind, w.Namespace, w.Name)
+		add := func(id, severity, title, description, impact, remediation string) {
+			findings = append(findings, Finding{
+				ID:          id,
+				Severity:    severity,
+				Category:    CategoryVulnerabilities,
+				Title:       title,
+				Description: description,
+				Impact:      impact,
+				Remediation: remediation,
+				ResourceRef: ref,
+				Namespace:   w.Namespace,
+				Timestamp:   ts,
+			})
+		}
+
+		if !v.Scanned {
+			add("VULN-004-"+w.Name, SeverityLow,
+				fmt.Sprintf("MCP workload '%s' has no vulnerability report", w.Name),
+				fmt.Sprintf("No Trivy VulnerabilityReport matches the current images of %s '%s/%s'.", w.Kind, w.Namespace, w.Name),
+				"Known CVEs in the MCP server's image go unnoticed.",
+				"Check that the Trivy Operator scans this namespace and that the scan job for the workload succeeded.")
+		}
+
+		critical, high := v.Critical, v.High
+		qualifier := ""
+		if vp.IgnoreUnfixed {
+			critical, high = v.FixableCritical, v.FixableHigh
+			qualifier = "fixable "
+		}
+		if critical > vp.MaxCritical {
+			add("VULN-001-"+w.Name, SeverityCritical,
+				fmt.Sprintf("MCP workload '%s' has %d %scritical CVEs", w.Name, critical, qualifier),
+				fmt.Sprintf("The images of %s '%s/%s' carry %d %scritical vulnerabilities (policy allows %d).", w.Kind, w.Namespace, w.Name, critical, qualifier, vp.MaxCritical),
+				"Critical CVEs in an MCP server are often remotely exploitable; every agent calling its tools inherits the exposure.",
+				"Rebuild the image on a patched base image and upgrade the affected packages, then roll out the new image.")
+		}
+		if high > vp.MaxHigh {
+			add("VULN-002-"+w.Name, SeverityHigh,
+				fmt.Sprintf("MCP workload '%s' has %d %shigh CVEs", w.Name, high, qualifier),
+				fmt.Sprintf("The images of %s '%s/%s' carry %d %shigh-severity vulnerabilities (policy allows %d).", w.Kind, w.Namespace, w.Name, high, qualifier, vp.MaxHigh),
+				"A large number of high-severity CVEs widens the attack surface of the MCP server.",
+				"Upgrade the affected packages or switch to a minimal (distroless) base image.")
+		}
+		for _, img := range v.Images {
+			if len(img.Fixable) == 0 {
+				continue
+			}
+			severity := SeverityMedium
+			if img.FixableCritical > 0 {
+				severity = SeverityHigh
+			}
+			add(fmt.Sprintf("VULN-003-%s-%s", w.Name, img.Container), severity,
+				fmt.Sprintf("Image '%s' has %d fixable critical/high CVEs", img.Image, len(img.Fixable)),
+				fmt.Sprintf("Container '%s' of %s '%s/%s' runs %s with fixes available for: %s.", img.Container, w.Kind, w.Namespace, w.Name, img.Image, fixableSummary(img.Fixable, 10)),
+				"Patches for these vulnerabilities already exist; leaving them unapplied is avoidable risk.",
+				"Upgrade the listed packages to their fixed versions (or rebuild on an updated base image) and redeploy.")
+		}
+		if len(v.ConfigAuditFailures) > 0 {
+			add("VULN-005-"+w.Name, SeverityMedium,
+				fmt.Sprintf("MCP workload '%s' fails %d Trivy config audit checks", w.Name, len(v.ConfigAuditFailures)),
+				fmt.Sprintf("The ConfigAuditReport of %s '%s/%s' lists failed critical/high checks: %s.", w.Kind, w.Namespace, w.Name, strings.Join(v.ConfigAuditFailures, "; ")),
+				"Misconfigurations flagged by the scanner weaken the isolation of the MCP server.",
+				"Fix the listed checks in the workload manifest; see the ConfigAuditReport for details.")
+		}
+	}
+
+	return findings
+}
@@ -0,0 +1,157 @@
+package evaluator
+
+import (
+	"strings"
+	"testing"
+)
+
+const vulnImage = "ghcr.io/my-org/tools:v1"
+
+func vulnState(reports ...VulnerabilityReportResource) *ClusterState {
+	return &ClusterState{
+		Namespaces:       []string{"mcp"},
+		KagentMCPServers: []KagentMCPServerResource{{Name: "tools", Namespace: "mcp", Port: 8080}},
+		Workloads: []WorkloadResource{
+			{Name: "tools", Namespace: "mcp", Kind: "Deployment", ImageNames: []string{vulnImage}},
+		},
+		VulnerabilityReports: reports,
+	}
+}
+
+func toolsReport(critical, high int, vulns ...Vulnerability) VulnerabilityReportResource {
+	return VulnerabilityReportResource{
+		Name: "replicaset-tools-5d9f-app", Namespace: "mcp", OwnerKind: "Deployment", OwnerName: "tools",
+		Container: "app", Image: vulnImage, Critical: critical, High: high, Vulnerabilities: vulns,
+	}
+}
+
+func vulnFindings(state *ClusterState, vp VulnerabilityPolicy) map[string]Finding {
+	out := map[string]Finding{}
+	for _, f := range checkVulnerabilities(state, Policy{Vulnerabilities: vp}) {
+		out[f.ID] = f
+	}
+	return out
+}
+
+func TestCheckVulnerabilities(t *testing.T) {
+	fixableCritical := Vulnerability{ID: "CVE-2024-0001", Severity: "CRITICAL", Package: "openssl", InstalledVersion: "3.0.1", FixedVersion: "3.0.13"}
+	fixableHigh := Vulnerability{ID: "CVE-2024-0002", Severity: "HIGH", Package: "zlib", InstalledVersion: "1.2.11", FixedVersion: "1.2.13"}
+	unfixedCritical := Vulnerability{ID: "CVE-2024-0003", Severity: "CRITICAL", Package: "glibc", InstalledVersion: "2.31"}
+
+	tests := []struct {
+		name         string
+		report       VulnerabilityReportResource
+		policy       VulnerabilityPolicy
+		wantID       string
+		wantSeverity string
+	}{
+		{"critical above threshold", toolsReport(1, 0, unfixedCritical), DefaultVulnerabilityPolicy(), "VULN-001-tools", SeverityCritical},
+		{"high above threshold", toolsReport(0, 6), DefaultVulnerabilityPolicy(), "VULN-002-tools", SeverityHigh},
+		{"fixable high", toolsReport(0, 1, fixableHigh), DefaultVulnerabilityPolicy(), "VULN-003-tools-app", SeverityMedium},
+		{"fixable critical", toolsReport(1, 0, fixableCritical), VulnerabilityPolicy{Enabled: true, MaxCritical: 1, MaxHigh: 5}, "VULN-003-tools-app", SeverityHigh},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			findings := vulnFindings(vulnState(tt.report), tt.policy)
+			f, ok := findings[tt.wantID]
+			if !ok || len(findings) != 1 {
+				t.Fatalf("findings = %v, want only %s", findings, tt.wantID)
+			}
+			if f.Severity != tt.wantSeverity || f.Category != CategoryVulnerabilities || f.ResourceRef != "Deployment/mcp/tools" {
+				t.Errorf("finding = %+v", f)
+			}
+		})
+	}
+}
+
+func TestCheckVulnerabilities_IgnoreUnfixed(t *testing.T) {
+	state := vulnState(toolsReport(1, 0, Vulnerability{ID: "CVE-2024-0003", Severity: "CRITICAL", Package: "glibc"}))
+	vp := DefaultVulnerabilityPolicy()
+	if _, ok := vulnFindings(state, vp)["VULN-001-tools"]; !ok {
+		t.Error("an unfixed critical CVE should count by default")
+	}
+	vp.IgnoreUnfixed = true
+	if f := vulnFindings(state, vp); len(f) != 0 {
+		t.Errorf("ignoreUnfixed: findings = %v", f)
+	}
+}
+
+func TestCheckVulnerabilities_Correlation(t *testing.T) {
+	stale := toolsReport(3, 0)
+	stale.Name, stale.Image = "replicaset-tools-old-app", "ghcr.io/my-org/tools:v0"
+
+	// A report for an image the workload no longer runs is ignored, so the
+	// workload counts as unscanned.
+	findings := vulnFindings(vulnState(stale), DefaultVulnerabilityPolicy())
+	if _, ok := findings["VULN-004-tools"]; !ok || len(findings) != 1 {
+		t.Errorf("stale report: findings = %v, want only VULN-004-tools", findings)
+	}
+
+	// Reports without an owner match by image within the namespace.
+	orphan := toolsReport(1, 0)
+	orphan.OwnerKind, orphan.OwnerName, orphan.Image = "", "", "ghcr.io/my-org/tools:v1"
+	if _, ok := vulnFindings(vulnState(orphan), DefaultVulnerabilityPolicy())["VULN-001-tools"]; !ok {
+		t.Error("an ownerless report scanning the workload's image should be correlated")
+	}
+
+	// Docker Hub short names match the registry-qualified image Trivy reports.
+	state := vulnState(toolsReport(1, 0))
+	state.Workloads[0].ImageNames = []string{"nginx:1.27"}
+	state.VulnerabilityReports[0].Image = "index.docker.io/library/nginx:1.27"
+	if _, ok := vulnFindings(state, DefaultVulnerabilityPolicy())["VULN-001-tools"]; !ok {
+		t.Error("nginx:1.27 should match index.docker.io/library/nginx:1.27")
+	}
+
+	if f := checkVulnerabilities(&ClusterState{}, Policy{Vulnerabilities: DefaultVulnerabilityPolicy()}); len(f) != 0 {
+		t.Errorf("no Trivy reports in the cluster: findings = %v", f)
+	}
+}
+
+func TestCheckVulnerabilities_ConfigAudit(t *testing.T) {
+	state := vulnState(toolsReport(0, 0))
+	state.ConfigAuditReports = []ConfigAuditReportResource{{
+		Name: "replicaset-tools-5d9f", Namespace: "mcp", OwnerKind: "Deployment", OwnerName: "tools", High: 1,
+		FailedChecks: []ConfigAuditCheck{{ID: "KSV017", Severity: "HIGH", Title: "Privileged container"}},
+	}}
+	f, ok := vulnFindings(state, DefaultVulnerabilityPolicy())["VULN-005-tools"]
+	if !ok || !strings.Contains(f.Description, "KSV017") {
+		t.Errorf("VULN-005 = %+v", f)
+	}
+}
+
+func TestBuildMCPServerViews_Vulnerabilities(t *testing.T) {
+	state := vulnState(toolsReport(0, 2, Vulnerability{ID: "CVE-2024-0002", Severity: "HIGH", Package: "zlib", FixedVersion: "1.2.13"}))
+	policy := defaultPolicy()
+
+	views := BuildMCPServerViews(state, checkVulnerabilities(state, policy), policy)
+	if len(views) != 1 || views[0].Vulnerabilities == nil {
+		t.Fatalf("views = %+v", views)
+	}
+	v := views[0].Vulnerabilities
+	if !v.Scanned || v.High != 2 || v.FixableHigh != 1 || len(v.Images) != 1 || v.Images[0].Report != "replicaset-tools-5d9f-app" {
+		t.Errorf("vulnerabilities = %+v", v)
+	}
+	if views[0].ScoreBreakdown.Vulnerabilities >= 100 {
+		t.Errorf("fixable high CVE: Vulnerabilities score = %d, want < 100", views[0].ScoreBreakdown.Vulnerabilities)
+	}
+
+	state.VulnerabilityReports = nil
+	views = BuildMCPServerViews(state, nil, policy)
+	if views[0].Vulnerabilities != nil {
+		t.Errorf("without Trivy reports the category is not applicable, got %+v", views[0].Vulnerabilities)
+	}
+}
+
+func TestTightenPolicy_Vulnerabilities(t *testing.T) {
+	base := Policy{Vulnerabilities: VulnerabilityPolicy{Enabled: true, MaxCritical: 2, MaxHigh: 5, IgnoreUnfixed: true}}
+	overlay := Policy{Vulnerabilities: VulnerabilityPolicy{Enabled: true, MaxCritical: 0, MaxHigh: 10}}
+	got := TightenPolicy(base, overlay).Vulnerabilities
+	want := VulnerabilityPolicy{Enabled: true, MaxCritical: 0, MaxHigh: 5}
+	if got != want {
+		t.Errorf("TightenPolicy = %+v, want %+v", got, want)
+	}
+
+	if got := TightenPolicy(base, Policy{}).Vulnerabilities; got != base.Vulnerabilities {
+		t.Errorf("an overlay without vulnerability settings changed them: %+v", got)
+	}
+}
@@ -0,0 +1,348 @@
+package evaluator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ServiceAccountResource holds a discovered ServiceAccount.
+type ServiceAccountResource struct {
+	Name           string
+	Namespace      string
+	AutomountToken *bool // automountServiceAccountToken; nil = Kubernetes default (true)
+}
+
+// RoleResource holds a discovered Role or ClusterRole.
+type RoleResource struct {
+	Kind      string // "Role" or "ClusterRole"
+	Name      string
+	Namespace string // empty for ClusterRoles
+	Rules     []PolicyRule
+}
+
+// PolicyRule mirrors rbacv1.PolicyRule.
+type PolicyRule struct {
+	APIGroups       []string
+	Resources       []string
+	ResourceNames   []string
+	Verbs           []string
+	NonResourceURLs []string
+}
+
+// RoleBindingResource holds a discovered RoleBinding or ClusterRoleBinding.
+type RoleBindingResource struct {
+	Kind      string // "RoleBinding" or "ClusterRoleBinding"
+	Name      string
+	Namespace string // empty for ClusterRoleBindings
+	RoleKind  string // "Role" or "ClusterRole"
+	RoleName  string
+	Subjects  []RBACSubject
+}
+
+// RBACSubject mirrors rbacv1.Subject.
+type RBACSubject struct {
+	Kind      string // "ServiceAccount", "User", "Group"
+	Name      string
+	Namespace string
+}
+
+// ServiceAccountGrant is one rule granted to a ServiceAccount through a binding.
+type ServiceAccountGrant struct {
+	Rule    PolicyRule
+	Scope   string // namespace the rule applies in, or "*" for cluster-wide
+	Binding string // "ClusterRoleBinding/name" or "RoleBinding/ns/name"
+	Role    string // "ClusterRole/name" or "Role/ns/name"
+}
+
+// source describes where the grant comes from, for finding descriptions.
+func (g ServiceAccountGrant) source() string {
+	scope := "namespace " + g.Scope
+	if g.Scope == "*" {
+		scope = "cluster-wide"
+	}
+	return fmt.Sprintf("%s → %s (%s)", g.Binding, g.Role, scope)
+}
+
+// WorkloadRBAC is the resolved Kubernetes RBAC posture of an MCP workload's
+// ServiceAccount.
+type WorkloadRBAC struct {
+	ServiceAccount   string   `json:"serviceAccount"`
+	Bindings         []string `json:"bindings"` // "binding → role (scope)" for every grant
+	WildcardVerbs    bool     `json:"wildcardVerbs"`
+	ClusterWide      bool     `json:"clusterWide"` // wildcard verbs granted by a ClusterRoleBinding
+	SecretsRead      bool     `json:"secretsRead"`
+	PodsExec         bool     `json:"podsExec"`
+	EscalationVerbs  []string `json:"escalationVerbs,omitempty"` // escalate, bind, impersonate
+	TokenAutomounted bool     `json:"tokenAutomounted"`
+
+	wildcardSources, secretsSources, execSources, escalationSources []string
+}
+
+// HasAPIAccess reports whether the ServiceAccount is granted any permission.
+func (r WorkloadRBAC) HasAPIAccess() bool {
+	return len(r.Bindings) > 0
+}
+
+// serviceAccountGrants resolves every rule bound to the ServiceAccount, either
+// directly or through the system:serviceaccounts and system:authenticated groups.
+// The default bindings of those groups to built-in "system:" ClusterRoles
+// (system:discovery, system:basic-user, system:public-info-viewer, ...) are
+// skipped: every ServiceAccount has them, so they say nothing about the workload.
+func serviceAccountGrants(state *ClusterState, namespace, name string) []ServiceAccountGrant {
+	roles := make(map[string]RoleResource, len(state.Roles))
+	for _, r := range state.Roles {
+		roles[r.Kind+"/"+r.Namespace+"/"+r.Name] = r
+	}
+
+	var grants []ServiceAccountGrant
+	for _, b := range state.RoleBindings {
+		direct, viaGroup := bindingSubjectsServiceAccount(b, namespace, name)
+		if !direct && !viaGroup {
+			continue
+		}
+		if !direct && b.RoleKind == "ClusterRole" && strings.HasPrefix(b.RoleName, "system:") {
+			continue
+		}
+		roleNS := ""
+		if b.RoleKind == "Role" {
+			roleNS = b.Namespace
+		}
+		role, ok := roles[b.RoleKind+"/"+roleNS+"/"+b.RoleName]
+		if !ok {
+			continue
+		}
+		scope, bindingRef, roleRef := "*", "ClusterRoleBinding/"+b.Name, "ClusterRole/"+role.Name
+		if b.Kind == "RoleBinding" {
+			scope, bindingRef = b.Namespace, fmt.Sprintf("RoleBinding/%s/%s", b.Namespace, b.Name)
+		}
+		if role.Kind == "Role" {
+			roleRef = fmt.Sprintf("Role/%s/%s", role.Namespace, role.Name)
+		}
+		for _, rule := range role.Rules {
+			grants = append(grants, ServiceAccountGrant{Rule: rule, Scope: scope, Binding: bindingRef, Role: roleRef})
+		}
+	}
+	return grants
+}
+
+// bindingSubjectsServiceAccount reports whether the binding names the
+// ServiceAccount directly, or one of the groups it belongs to.
+func bindingSubjectsServiceAccount(b RoleBindingResource, namespace, name string) (direct, viaGroup bool) {
+	for _, s := range b.Subjects {
+		switch s.Kind {
+		case "ServiceAccount":
+			ns := s.Namespace
+			if ns == "" {
+				ns = b.Namespace
+			}
+			if s.Name == name && ns == namespace {
+				direct = true
+			}
+		case "Group":
+			if s.Name == "system:serviceaccounts" || s.Name == "system:serviceaccounts:"+namespace || s.Name == "system:authenticated" {
+				viaGroup = true
+			}
+		}
+	}
+	return direct, viaGroup
+}
+
+// ruleAllows reports whether the rule grants any of the verbs on the resource.
+// Rules with wildcard verbs are handled separately (see analyzeWorkloadRBAC).
+func ruleAllows(rule PolicyRule, apiGroup, resource string, verbs ...string) bool {
+	if !matchesRBAC(rule.APIGroups, apiGroup) {
+		return false
+	}
+	resourceOK := false
+	for _, r := range rule.Resources {
+		if r == "*" || r == resource {
+			resourceOK = true
+			break
+		}
+		if base, sub, ok := strings.Cut(resource, "/"); ok && (r == base+"/*" || r == "*/"+sub) {
+			resourceOK = true
+			break
+		}
+	}
+	if !resourceOK {
+		return false
+	}
+	for _, v := range verbs {
+		if matchesRBAC(rule.Verbs, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesRBAC(list []string, v string) bool {
+	for _, s := range list {
+		if s == "*" || s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// analyzeWorkloadRBAC resolves the ServiceAccount of a workload and flags
+// dangerous grants. A rule with wildcard verbs is reported once as such rather
+// than again for every capability it implies.
+func analyzeWorkloadRBAC(state *ClusterState, w WorkloadResource) WorkloadRBAC {
+	sa := w.ServiceAccountName
+	if sa == "" {
+		sa = "default"
+	}
+	out := WorkloadRBAC{ServiceAccount: sa, Bindings: []string{}}
+
+	seen := map[string]bool{}
+	escalation := map[string]bool{}
+	for _, g := range serviceAccountGrants(state, w.Namespace, sa) {
+		src := g.source()
+		if !seen[src] {
+			seen[src] = true
+			out.Bindings = append(out.Bindings, src)
+		}
+		if containsString(g.Rule.Verbs, "*") {
+			out.WildcardVerbs = true
+			out.ClusterWide = out.ClusterWide || g.Scope == "*"
+			out.wildcardSources = appendUnique(out.wildcardSources, src)
+			continue
+		}
+		// Rules limited to named Secrets are the remediation of KRBAC-002.
+		if len(g.Rule.ResourceNames) == 0 && ruleAllows(g.Rule, "", "secrets", "get", "list", "watch") {
+			out.SecretsRead = true
+			out.secretsSources = appendUnique(out.secretsSources, src)
+		}
+		if ruleAllows(g.Rule, "", "pods/exec", "create", "get") {
+			out.PodsExec = true
+			out.execSources = appendUnique(out.execSources, src)
+		}
+		for _, v := range []string{"escalate", "bind", "impersonate"} {
+			if containsString(g.Rule.Verbs, v) {
+				escalation[v] = true
+				out.escalationSources = appendUnique(out.escalationSources, src)
+			}
+		}
+	}
+	out.EscalationVerbs = sortedKeys(escalation)
+
+	// The pod-level setting wins over the ServiceAccount's; both default to true.
+	out.TokenAutomounted = true
+	for _, s := range state.ServiceAccounts {
+		if s.Namespace == w.Namespace && s.Name == sa && s.AutomountToken != nil {
+			out.TokenAutomounted = *s.AutomountToken
+		}
+	}
+	if w.AutomountServiceAccountToken != nil {
+		out.TokenAutomounted = *w.AutomountServiceAccountToken
+	}
+	return out
+}
+
+func appendUnique(list []string, s string) []string {
+	if containsString(list, s) {
+		return list
+	}
+	return append(list, s)
+}
+
+// mcpWorkloads returns the workloads backing kagent MCPServers and
+// RemoteMCPServers, once each.
+func mcpWorkloads(state *ClusterState) []WorkloadResource {
+	var views []MCPServerView
+	for _, m := range state.KagentMCPServers {
+		views = append(views, MCPServerView{Name: m.Name, Namespace: m.Namespace, Source: "KagentMCPServer"})
+	}
+	for _, r := range state.KagentRemoteMCPServers {
+		views = append(views, MCPServerView{Name: r.Name, Namespace: r.Namespace, Source: "KagentRemoteMCPServer", URL: r.URL})
+	}
+
+	seen := map[string]bool{}
+	var out []WorkloadResource
+	for i := range views {
+		w, ok := backingWorkload(state, &views[i])
+		if !ok || seen[w.Namespace+"/"+w.Name] {
+			continue
+		}
+		seen[w.Namespace+"/"+w.Name] = true
+		out = append(out, w)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Namespace+"/"+out[i].Name < out[j].Namespace+"/"+out[j].Name
+	})
+	return out
+}
+
+// checkWorkloadRBAC inspects the Kubernetes RBAC granted to the ServiceAccounts
+// of MCP server workloads (KRBAC-001..005).
+func checkWorkloadRBAC(state *ClusterState, policy Policy) []Finding {
+	var findings []Finding
+	if !policy.RequireWorkloadRBAC {
+		return findings
+	}
+	ts := time.Now().Format(time.RFC3339)
+
+	for _, w := range mcpWorkloads(state) {
+		r := analyzeWorkloadRBAC(state, w)
+		ref := fmt.Sprintf("%s/%s/%s", w.Kind, w.Namespace, w.Name)
+		sa := fmt.Sprintf("ServiceAccount '%s/%s'", w.Namespace, r.ServiceAccount)
+		add := func(code, severity, title, description, impact, remediation string) {
+			findings = append(findings, Finding{
+				ID:          fmt.Sprintf("KRBAC-%s-%s", code, w.Name),
+				Severity:    severity,
+				Category:    CategoryWorkloadRBAC,
+				Title:       title,
+				Description: description,
+				Impact:      impact,
+				Remediation: remediation,
+				ResourceRef: ref,
+				Namespace:   w.Namespace,
+				Timestamp:   ts,
+			})
+		}
+
+		if r.WildcardVerbs {
+			severity := SeverityHigh
+			if r.ClusterWide {
+				severity = SeverityCritical
+			}
+			add("001", severity,
+				fmt.Sprintf("MCP workload '%s' has wildcard RBAC verbs", w.Name),
+				fmt.Sprintf("%s used by %s '%s' is granted all verbs ('*') via: %s.", sa, w.Kind, w.Name, strings.Join(r.wildcardSources, "; ")),
+				"Every tool call runs with the pod's credentials. A prompt-injected or compromised MCP server can modify or delete anything the role covers — with a ClusterRoleBinding, the whole cluster.",
+				"Bind the ServiceAccount to a Role listing only the resources and verbs the MCP server's tools need. Never bind MCP servers to cluster-admin or admin.")
+		}
+		if r.SecretsRead {
+			add("002", SeverityHigh,
+				fmt.Sprintf("MCP workload '%s' can read Secrets", w.Name),
+				fmt.Sprintf("%s used by %s '%s' can get/list/watch Secrets via: %s.", sa, w.Kind, w.Name, strings.Join(r.secretsSources, "; ")),
+				"Any tool that reaches the Kubernetes API can exfiltrate credentials, tokens and TLS keys.",
+				"Remove secrets from the ServiceAccount's roles, or restrict the rule to named Secrets with resourceNames.")
+		}
+		if r.PodsExec {
+			add("003", SeverityCritical,
+				fmt.Sprintf("MCP workload '%s' can exec into pods", w.Name),
+				fmt.Sprintf("%s used by %s '%s' is granted pods/exec via: %s.", sa, w.Kind, w.Name, strings.Join(r.execSources, "; ")),
+				"The MCP server can run arbitrary commands in other pods, turning a single tool call into remote code execution.",
+				"Remove pods/exec from the ServiceAccount's roles.")
+		}
+		if len(r.EscalationVerbs) > 0 {
+			add("004", SeverityCritical,
+				fmt.Sprintf("MCP workload '%s' can %s", w.Name, strings.Join(r.EscalationVerbs, "/")),
+				fmt.Sprintf("%s used by %s '%s' is granted %s via: %s.", sa, w.Kind, w.Name, strings.Join(r.EscalationVerbs, ", "), strings.Join(r.escalationSources, "; ")),
+				"escalate, bind and impersonate let the ServiceAccount grant itself or act as any other identity, up to cluster-admin.",
+				"Remove the escalate, bind and impersonate verbs from the ServiceAccount's roles.")
+		}
+		if r.TokenAutomounted && !r.HasAPIAccess() {
+			add("005", SeverityMedium,
+				fmt.Sprintf("MCP workload '%s' automounts an unused ServiceAccount token", w.Name),
+				fmt.Sprintf("%s used by %s '%s' has no RBAC bindings, so the MCP server never calls the API server, yet automountServiceAccountToken is enabled.", sa, w.Kind, w.Name),
+				"A token mounted into the pod can be stolen and replayed against the API server; any permission later granted to the ServiceAccount becomes reachable from the MCP server.",
+				"Set automountServiceAccountToken: false on the pod spec or the ServiceAccount.")
+		}
+	}
+
+	return findings
+}
@@ -0,0 +1,207 @@
+package evaluator
+
+import (
+	"strings"
+	"testing"
+)
+
+func rbacState(roles []RoleResource, bindings []RoleBindingResource) *ClusterState {
+	return &ClusterState{
+		Namespaces:       []string{"mcp"},
+		KagentMCPServers: []KagentMCPServerResource{{Name: "tools", Namespace: "mcp", Port: 8080}},
+		Workloads: []WorkloadResource{
+			{Name: "tools", Namespace: "mcp", Kind: "Deployment", ServiceAccountName: "tools-sa"},
+		},
+		Roles:        roles,
+		RoleBindings: bindings,
+	}
+}
+
+func bindToToolsSA(kind, name, roleKind, roleName string) RoleBindingResource {
+	b := RoleBindingResource{Kind: kind, Name: name, RoleKind: roleKind, RoleName: roleName,
+		Subjects: []RBACSubject{{Kind: "ServiceAccount", Name: "tools-sa", Namespace: "mcp"}}}
+	if kind == "RoleBinding" {
+		b.Namespace = "mcp"
+	}
+	return b
+}
+
+func krbacFindings(state *ClusterState) map[string]Finding {
+	out := map[string]Finding{}
+	for _, f := range checkWorkloadRBAC(state, Policy{RequireWorkloadRBAC: true}) {
+		out[f.ID] = f
+	}
+	return out
+}
+
+func TestCheckWorkloadRBAC(t *testing.T) {
+	clusterAdmin := RoleResource{Kind: "ClusterRole", Name: "cluster-admin",
+		Rules: []PolicyRule{{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"}}}}
+	secretReader := RoleResource{Kind: "Role", Name: "secrets", Namespace: "mcp",
+		Rules: []PolicyRule{{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get", "list"}}}}
+	execer := RoleResource{Kind: "ClusterRole", Name: "exec",
+		Rules: []PolicyRule{{APIGroups: []string{""}, Resources: []string{"pods/exec"}, Verbs: []string{"create"}}}}
+	escalator := RoleResource{Kind: "ClusterRole", Name: "escalator",
+		Rules: []PolicyRule{{APIGroups: []string{"rbac.authorization.k8s.io"}, Resources: []string{"clusterroles"}, Verbs: []string{"bind", "escalate"}}}}
+
+	tests := []struct {
+		name         string
+		roles        []RoleResource
+		bindings     []RoleBindingResource
+		wantID       string
+		wantSeverity string
+	}{
+		{
+			name:         "cluster-admin via ClusterRoleBinding",
+			roles:        []RoleResource{clusterAdmin},
+			bindings:     []RoleBindingResource{bindToToolsSA("ClusterRoleBinding", "tools-admin", "ClusterRole", "cluster-admin")},
+			wantID:       "KRBAC-001-tools",
+			wantSeverity: SeverityCritical,
+		},
+		{
+			name:         "cluster-admin scoped by RoleBinding",
+			roles:        []RoleResource{clusterAdmin},
+			bindings:     []RoleBindingResource{bindToToolsSA("RoleBinding", "tools-admin", "ClusterRole", "cluster-admin")},
+			wantID:       "KRBAC-001-tools",
+			wantSeverity: SeverityHigh,
+		},
+		{
+			name:         "secrets read",
+			roles:        []RoleResource{secretReader},
+			bindings:     []RoleBindingResource{bindToToolsSA("RoleBinding", "tools-secrets", "Role", "secrets")},
+			wantID:       "KRBAC-002-tools",
+			wantSeverity: SeverityHigh,
+		},
+		{
+			name:         "pods/exec",
+			roles:        []RoleResource{execer},
+			bindings:     []RoleBindingResource{bindToToolsSA("ClusterRoleBinding", "tools-exec", "ClusterRole", "exec")},
+			wantID:       "KRBAC-003-tools",
+			wantSeverity: SeverityCritical,
+		},
+		{
+			name:         "escalation verbs",
+			roles:        []RoleResource{escalator},
+			bindings:     []RoleBindingResource{bindToToolsSA("ClusterRoleBinding", "tools-esc", "ClusterRole", "escalator")},
+			wantID:       "KRBAC-004-tools",
+			wantSeverity: SeverityCritical,
+		},
+		{
+			name:         "no bindings with automounted token",
+			wantID:       "KRBAC-005-tools",
+			wantSeverity: SeverityMedium,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			findings := krbacFindings(rbacState(tt.roles, tt.bindings))
+			f, ok := findings[tt.wantID]
+			if !ok {
+				t.Fatalf("missing %s, got %v", tt.wantID, findings)
+			}
+			if f.Severity != tt.wantSeverity {
+				t.Errorf("severity = %s, want %s", f.Severity, tt.wantSeverity)
+			}
+			if f.ResourceRef != "Deployment/mcp/tools" || f.Category != CategoryWorkloadRBAC {
+				t.Errorf("resourceRef = %s, category = %s", f.ResourceRef, f.Category)
+			}
+			if len(findings) != 1 {
+				t.Errorf("got %d findings, want only %s", len(findings), tt.wantID)
+			}
+		})
+	}
+}
+
+func TestCheckWorkloadRBAC_AutomountDisabled(t *testing.T) {
+	off := false
+	state := rbacState(nil, nil)
+	state.ServiceAccounts = []ServiceAccountResource{{Name: "tools-sa", Namespace: "mcp", AutomountToken: &off}}
+	if findings := krbacFindings(state); len(findings) != 0 {
+		t.Errorf("ServiceAccount with automount disabled: got %v", findings)
+	}
+
+	// The pod spec overrides the ServiceAccount.
+	on := true
+	state.Workloads[0].AutomountServiceAccountToken = &on
+	if _, ok := krbacFindings(state)["KRBAC-005-tools"]; !ok {
+		t.Error("pod-level automountServiceAccountToken: true should re-enable KRBAC-005")
+	}
+}
+
+func TestCheckWorkloadRBAC_GroupBindingAndDisabled(t *testing.T) {
+	state := rbacState(
+		[]RoleResource{{Kind: "ClusterRole", Name: "secret-reader",
+			Rules: []PolicyRule{{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"watch"}}}}},
+		[]RoleBindingResource{{Kind: "ClusterRoleBinding", Name: "all-sas", RoleKind: "ClusterRole", RoleName: "secret-reader",
+			Subjects: []RBACSubject{{Kind: "Group", Name: "system:serviceaccounts"}}}},
+	)
+	f, ok := krbacFindings(state)["KRBAC-002-tools"]
+	if !ok || !strings.Contains(f.Description, "ClusterRoleBinding/all-sas") {
+		t.Errorf("KRBAC-002 via group binding = %+v", f)
+	}
+	if got := checkWorkloadRBAC(state, Policy{}); len(got) != 0 {
+		t.Errorf("RequireWorkloadRBAC=false: got %d findings", len(got))
+	}
+}
+
+func TestCheckWorkloadRBAC_DefaultGroupBindings(t *testing.T) {
+	var roles []RoleResource
+	var bindings []RoleBindingResource
+	for _, name := range []string{"system:discovery", "system:basic-user", "system:public-info-viewer"} {
+		roles = append(roles, RoleResource{Kind: "ClusterRole", Name: name,
+			Rules: []PolicyRule{{NonResourceURLs: []string{"/version", "/healthz"}, Verbs: []string{"get"}}}})
+		bindings = append(bindings, RoleBindingResource{Kind: "ClusterRoleBinding", Name: name, RoleKind: "ClusterRole", RoleName: name,
+			Subjects: []RBACSubject{{Kind: "Group", Name: "system:authenticated"}}})
+	}
+	state := rbacState(roles, bindings)
+
+	r := analyzeWorkloadRBAC(state, state.Workloads[0])
+	if r.HasAPIAccess() {
+		t.Errorf("default bindings should not count as API access, got %v", r.Bindings)
+	}
+	if _, ok := krbacFindings(state)["KRBAC-005-tools"]; !ok {
+		t.Error("Expected KRBAC-005 with only the default group bindings")
+	}
+
+	// The same built-in role bound to the ServiceAccount itself counts.
+	state.RoleBindings = append(state.RoleBindings, bindToToolsSA("ClusterRoleBinding", "tools-discovery", "ClusterRole", "system:discovery"))
+	if r := analyzeWorkloadRBAC(state, state.Workloads[0]); len(r.Bindings) != 1 {
+		t.Errorf("bindings = %v, want only the direct one", r.Bindings)
+	}
+}
+
+func TestCheckWorkloadRBAC_ResourceNamesScopeSecrets(t *testing.T) {
+	state := rbacState(
+		[]RoleResource{{Kind: "Role", Name: "own-secret", Namespace: "mcp",
+			Rules: []PolicyRule{{APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"tools-token"}, Verbs: []string{"get"}}}}},
+		[]RoleBindingResource{bindToToolsSA("RoleBinding", "tools-secret", "Role", "own-secret")},
+	)
+	if findings := krbacFindings(state); len(findings) != 0 {
+		t.Errorf("secrets rule limited by resourceNames: got %v", findings)
+	}
+}
+
+func TestBuildMCPServerViews_WorkloadRBAC(t *testing.T) {
+	state := rbacState(
+		[]RoleResource{{Kind: "Role", Name: "cm-reader", Namespace: "mcp",
+			Rules: []PolicyRule{{APIGroups: []string{""}, Resources: []string{"configmaps"}, Verbs: []string{"get"}}}}},
+		[]RoleBindingResource{bindToToolsSA("RoleBinding", "tools-cm", "Role", "cm-reader")},
+	)
+	views := BuildMCPServerViews(state, checkWorkloadRBAC(state, defaultPolicy()), defaultPolicy())
+	if len(views) != 1 || views[0].WorkloadRBAC == nil {
+		t.Fatalf("views = %+v", views)
+	}
+	v := views[0]
+	if v.WorkloadRBAC.ServiceAccount != "tools-sa" || len(v.WorkloadRBAC.Bindings) != 1 {
+		t.Errorf("workloadRBAC = %+v", v.WorkloadRBAC)
+	}
+	if v.ScoreBreakdown.WorkloadRBAC != 100 {
+		t.Errorf("least-privilege ServiceAccount: WorkloadRBAC score = %d, want 100", v.ScoreBreakdown.WorkloadRBAC)
+	}
+
+	state.Roles[0].Rules[0].Resources = []string{"secrets"}
+	views = BuildMCPServerViews(state, checkWorkloadRBAC(state, defaultPolicy()), defaultPolicy())
+	if views[0].ScoreBreakdown.WorkloadRBAC >= 100 {
+		t.Errorf("secrets read: WorkloadRBAC score = %d, want < 100", views[0].ScoreBreakdown.WorkloadRBAC)
+	}
+}
@@ -0,0 +1,182 @@
+package graph
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/evaluator"
+)
+
+// AgentPath is one route from an agent to an MCP server and the tools it reaches.
+type AgentPath struct {
+	Server          string   `json:"server"`
+	ServerName      string   `json:"serverName"`
+	Namespace       string   `json:"namespace"`
+	ViaGateway      bool     `json:"viaGateway"`
+	BypassesGateway bool     `json:"bypassesGateway"`
+	Hops            []string `json:"hops"`
+	Controls        []string `json:"controls"`
+	Tools           []string `json:"tools"`
+	Missing         bool     `json:"missing,omitempty"` // the referenced MCP server was not discovered
+}
+
+// ReachableTool is a tool an agent can call, with the controls on its path.
+type ReachableTool struct {
+	Name            string   `json:"name"`
+	Server          string   `json:"server"`
+	Sensitivity     string   `json:"sensitivity"`
+	ViaGateway      bool     `json:"viaGateway"`
+	BypassesGateway bool     `json:"bypassesGateway"`
+	Controls        []string `json:"controls"`
+}
+
+// BlastRadius is everything a single agent can reach.
+type BlastRadius struct {
+	Agent          string          `json:"agent"`
+	Paths          []AgentPath     `json:"paths"`
+	Tools          []ReachableTool `json:"tools"`
+	BypassPaths    int             `json:"bypassPaths"`
+	SensitiveTools int             `json:"sensitiveTools"` // destructive or exec tools reachable
+}
+
+// BlastRadius lists every tool the agent ("ns/name") can reach, the controls on
+// each path and whether the path skips the gateway. It returns false when the
+// agent is unknown.
+func (g *Graph) BlastRadius(agent string) (BlastRadius, bool) {
+	parts := strings.SplitN(agent, "/", 2)
+	if len(parts) != 2 {
+		return BlastRadius{}, false
+	}
+	aID := ref(KindAgent, parts[0], parts[1])
+	if !g.has(aID) {
+		return BlastRadius{}, false
+	}
+
+	br := BlastRadius{Agent: agent, Paths: []AgentPath{}, Tools: []ReachableTool{}}
+	for _, ar := range g.agentRefs[aID] {
+		p := AgentPath{
+			Server:          ar.serverID,
+			ServerName:      ar.ref.Name,
+			ViaGateway:      ar.viaGateway,
+			BypassesGateway: !ar.viaGateway,
+			Hops:            []string{aID},
+			Controls:        []string{},
+			Tools:           []string{},
+		}
+		if ar.view == nil {
+			p.Missing = true
+			p.Hops = append(p.Hops, ar.serverID)
+			br.Paths = append(br.Paths, p)
+			if p.BypassesGateway {
+				br.BypassPaths++
+			}
+			continue
+		}
+		v := ar.view
+		p.Namespace = v.Namespace
+
+		if ar.viaGateway {
+			p.Hops = append(p.Hops, g.gatewayHops(ar.gatewayID, v)...)
+			p.Controls = Controls(v)
+		}
+		p.Hops = append(p.Hops, v.ID)
+
+		// Tools: the ref's toolNames when set; otherwise everything the server
+		// offers. Gateway authorization only narrows the set on gateway paths.
+		tools := ar.ref.ToolNames
+		if len(tools) == 0 {
+			tools = v.ToolNames
+			if ar.viaGateway && v.HasToolRestriction {
+				tools = v.EffectiveToolNames
+			}
+		} else if ar.viaGateway && v.HasToolRestriction {
+			tools = intersect(tools, v.EffectiveToolNames)
+		}
+		p.Tools = append(p.Tools, tools...)
+		sort.Strings(p.Tools)
+
+		for _, t := range p.Tools {
+			sensitivity := v.ToolSensitivity[t]
+			if sensitivity == "" {
+				sensitivity = evaluator.DefaultToolSensitivityPolicy().ClassifyTool(t)
+			}
+			if sensitivity == evaluator.ToolClassDestructive || sensitivity == evaluator.ToolClassExec {
+				br.SensitiveTools++
+			}
+			br.Tools = append(br.Tools, ReachableTool{
+				Name:            t,
+				Server:          v.ID,
+				Sensitivity:     sensitivity,
+				ViaGateway:      p.ViaGateway,
+				BypassesGateway: p.BypassesGateway,
+				Controls:        p.Controls,
+			})
+		}
+		if p.BypassesGateway {
+			br.BypassPaths++
+		}
+		br.Paths = append(br.Paths, p)
+	}
+	return br, true
+}
+
+// gatewayHops returns the node IDs between the agent and the MCP server on a
+// gateway path: Gateway → HTTPRoute → AgentgatewayBackend → MCP target,
+// following the edges that lead to the server.
+func (g *Graph) gatewayHops(gatewayID string, v *evaluator.MCPServerView) []string {
+	hops := []string{gatewayID}
+	var target, backend, route string
+	for _, e := range g.Edges {
+		if e.Kind == EdgeServes && e.To == v.ID {
+			target = e.From
+			break
+		}
+	}
+	if target != "" {
+		for _, e := range g.Edges {
+			if e.Kind == EdgeTarget && e.To == target {
+				backend = e.From
+				break
+			}
+		}
+	}
+	if backend != "" {
+		for _, e := range g.Edges {
+			if e.Kind == EdgeBackend && e.To == backend {
+				route = e.From
+				if g.hasEdge(gatewayID, route) {
+					break
+				}
+			}
+		}
+	}
+	for _, id := range []string{route, backend, target} {
+		if id != "" {
+			hops = append(hops, id)
+		}
+	}
+	return hops
+}
+
+func (g *Graph) hasEdge(from, to string) bool {
+	for _, e := range g.Edges {
+		if e.From == from && e.To == to {
+			return true
+		}
+	}
+	return false
+}
+
+func intersect(a, b []string) []string {
+	set := make(map[string]bool, len(b))
+	for _, s := range b {
+		set[s] = true
+	}
+	out := []string{}
+	for _, s := range a {
+		if set[s] {
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,338 @@
+// Package graph connects gateways, routes, backends, MCP servers, tools and
+// agents into a single attack-path graph.
+package graph
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/evaluator"
+)
+
+// Node kinds.
+const (
+	KindGateway   = "Gateway"
+	KindHTTPRoute = "HTTPRoute"
+	KindBackend   = "AgentgatewayBackend"
+	KindTarget    = "MCPTarget"
+	KindServer    = "MCPServer"
+	KindTool      = "Tool"
+	KindAgent     = "Agent"
+)
+
+// Edge kinds.
+const (
+	EdgeRoutes  = "routes"  // Gateway → HTTPRoute (parentRef)
+	EdgeBackend = "backend" // HTTPRoute → AgentgatewayBackend (backendRef)
+	EdgeTarget  = "target"  // AgentgatewayBackend → MCP target
+	EdgeServes  = "serves"  // MCP target → MCP server
+	EdgeExposes = "exposes" // MCP server → tool
+	EdgeUses    = "uses"    // Agent → MCP server (tool ref)
+)
+
+// Node is a vertex of the graph. IDs are "Kind/ns/name" (tools and targets
+// are qualified by their server or backend).
+type Node struct {
+	ID         string                 `json:"id"`
+	Kind       string                 `json:"kind"`
+	Name       string                 `json:"name"`
+	Namespace  string                 `json:"namespace,omitempty"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+}
+
+// Edge is a directed link between two nodes.
+type Edge struct {
+	From       string                 `json:"from"`
+	To         string                 `json:"to"`
+	Kind       string                 `json:"kind"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+}
+
+// Graph is the attack-path graph of the cluster.
+type Graph struct {
+	Nodes []Node `json:"nodes"`
+	Edges []Edge `json:"edges"`
+	// Bypasses lists the agent → MCP server edges that do not pass through agentgateway.
+	Bypasses []Edge `json:"bypasses"`
+
+	nodeIndex map[string]int
+	agentRefs map[string][]agentRef // agent node ID → resolved tool refs
+}
+
+// agentRef is an agent tool reference resolved to an MCP server.
+type agentRef struct {
+	ref        evaluator.KagentToolRef
+	serverID   string
+	view       *evaluator.MCPServerView
+	viaGateway bool
+	gatewayID  string
+}
+
+func ref(kind, ns, name string) string {
+	return fmt.Sprintf("%s/%s/%s", kind, ns, name)
+}
+
+func (g *Graph) addNode(n Node) {
+	if _, ok := g.nodeIndex[n.ID]; ok {
+		return
+	}
+	g.nodeIndex[n.ID] = len(g.Nodes)
+	g.Nodes = append(g.Nodes, n)
+}
+
+func (g *Graph) addEdge(e Edge) {
+	g.Edges = append(g.Edges, e)
+}
+
+// Node returns the node with the given ID.
+func (g *Graph) Node(id string) (Node, bool) {
+	i, ok := g.nodeIndex[id]
+	if !ok {
+		return Node{}, false
+	}
+	return g.Nodes[i], true
+}
+
+// Build assembles the graph from the cluster state and the MCP server views of
+// an evaluation result.
+func Build(state *evaluator.ClusterState, result *evaluator.EvaluationResult) *Graph {
+	g := &Graph{
+		Nodes:     []Node{},
+		Edges:     []Edge{},
+		Bypasses:  []Edge{},
+		nodeIndex: map[string]int{},
+		agentRefs: map[string][]agentRef{},
+	}
+	if state == nil {
+		return g
+	}
+	var views []evaluator.MCPServerView
+	if result != nil {
+		views = result.MCPServerViews
+	}
+
+	// Gateway → HTTPRoute → AgentgatewayBackend → MCP target
+	for _, gw := range state.Gateways {
+		g.addNode(Node{ID: ref(KindGateway, gw.Namespace, gw.Name), Kind: KindGateway, Name: gw.Name, Namespace: gw.Namespace,
+			Attributes: map[string]interface{}{"gatewayClassName": gw.GatewayClassName, "programmed": gw.Programmed}})
+	}
+	for _, rt := range state.HTTPRoutes {
+		id := ref(KindHTTPRoute, rt.Namespace, rt.Name)
+		g.addNode(Node{ID: id, Kind: KindHTTPRoute, Name: rt.Name, Namespace: rt.Namespace,
+			Attributes: map[string]interface{}{"paths": rt.Paths, "hasCORSFilter": rt.HasCORSFilter}})
+		if rt.ParentGateway != "" {
+			parentNS := rt.ParentGatewayNamespace
+			if parentNS == "" {
+				parentNS = rt.Namespace
+			}
+			if gwID := ref(KindGateway, parentNS, rt.ParentGateway); g.has(gwID) {
+				g.addEdge(Edge{From: gwID, To: id, Kind: EdgeRoutes})
+			}
+		}
+	}
+	for _, b := range state.AgentgatewayBackends {
+		if b.BackendType != "mcp" {
+			continue
+		}
+		bID := ref(KindBackend, b.Namespace, b.Name)
+		g.addNode(Node{ID: bID, Kind: KindBackend, Name: b.Name, Namespace: b.Namespace,
+			Attributes: map[string]interface{}{"hasTLS": b.HasTLS, "hasClientCert": b.HasClientCert}})
+		for _, rt := range state.HTTPRoutes {
+			for _, br := range rt.BackendRefs {
+				if br == b.Name {
+					g.addEdge(Edge{From: ref(KindHTTPRoute, rt.Namespace, rt.Name), To: bID, Kind: EdgeBackend})
+				}
+			}
+		}
+		for _, t := range b.MCPTargets {
+			tID := targetID(b.Namespace, b.Name, t.Name)
+			g.addNode(Node{ID: tID, Kind: KindTarget, Name: t.Name, Namespace: b.Namespace,
+				Attributes: map[string]interface{}{"host": t.Host, "port": t.Port, "hasAuth": t.HasAuth, "hasRBAC": t.HasRBAC}})
+			g.addEdge(Edge{From: bID, To: tID, Kind: EdgeTarget})
+		}
+	}
+
+	// MCP target → MCP server → tools
+	for i := range views {
+		v := &views[i]
+		g.addNode(Node{ID: v.ID, Kind: KindServer, Name: v.Name, Namespace: v.Namespace,
+			Attributes: map[string]interface{}{
+				"source":               v.Source,
+				"score":                v.Score,
+				"grade":                v.Grade,
+				"routedThroughGateway": v.RoutedThroughGateway,
+				"controls":             Controls(v),
+			}})
+		for _, rb := range v.RelatedBackends {
+			if tn, _ := rb.Details["targetName"].(string); tn != "" {
+				if tID := targetID(rb.Namespace, rb.Name, tn); g.has(tID) {
+					g.addEdge(Edge{From: tID, To: v.ID, Kind: EdgeServes})
+				}
+			}
+		}
+		for _, tool := range v.ToolNames {
+			g.addTool(v, tool)
+		}
+	}
+
+	// Agent → tool refs → MCP server
+	for _, a := range state.KagentAgents {
+		aID := ref(KindAgent, a.Namespace, a.Name)
+		g.addNode(Node{ID: aID, Kind: KindAgent, Name: a.Name, Namespace: a.Namespace,
+			Attributes: map[string]interface{}{"type": a.Type, "ready": a.Ready}})
+		for _, tr := range a.Tools {
+			if tr.Name == "" {
+				continue
+			}
+			ar := resolveAgentRef(state, views, a, tr)
+			if ar.view == nil {
+				// Referenced server was not discovered; keep the dangling ref visible
+				g.addNode(Node{ID: ar.serverID, Kind: KindServer, Name: tr.Name, Namespace: a.Namespace,
+					Attributes: map[string]interface{}{"source": tr.Kind, "missing": true}})
+			} else {
+				for _, tool := range tr.ToolNames {
+					g.addTool(ar.view, tool)
+				}
+			}
+			e := Edge{From: aID, To: ar.serverID, Kind: EdgeUses, Attributes: map[string]interface{}{
+				"refKind":         tr.Kind,
+				"viaGateway":      ar.viaGateway,
+				"bypassesGateway": !ar.viaGateway,
+			}}
+			if ar.gatewayID != "" {
+				e.Attributes["gateway"] = ar.gatewayID
+			}
+			if len(tr.ToolNames) > 0 {
+				e.Attributes["toolNames"] = tr.ToolNames
+			}
+			g.addEdge(e)
+			if !ar.viaGateway {
+				g.Bypasses = append(g.Bypasses, e)
+			}
+			g.agentRefs[aID] = append(g.agentRefs[aID], ar)
+		}
+	}
+
+	sort.SliceStable(g.Bypasses, func(i, j int) bool {
+		if g.Bypasses[i].From != g.Bypasses[j].From {
+			return g.Bypasses[i].From < g.Bypasses[j].From
+		}
+		return g.Bypasses[i].To < g.Bypasses[j].To
+	})
+	return g
+}
+
+func (g *Graph) has(id string) bool {
+	_, ok := g.nodeIndex[id]
+	return ok
+}
+
+func targetID(ns, backend, target string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", KindTarget, ns, backend, target)
+}
+
+func toolID(serverID, tool string) string {
+	return fmt.Sprintf("%s/%s/%s", KindTool, serverID, tool)
+}
+
+// addTool adds a tool node for the server and the exposes edge to it.
+func (g *Graph) addTool(v *evaluator.MCPServerView, tool string) {
+	id := toolID(v.ID, tool)
+	if g.has(id) {
+		return
+	}
+	exposed := !v.HasToolRestriction
+	for _, t := range v.EffectiveToolNames {
+		if t == tool {
+			exposed = true
+			break
+		}
+	}
+	sensitivity := v.ToolSensitivity[tool]
+	if sensitivity == "" {
+		sensitivity = evaluator.DefaultToolSensitivityPolicy().ClassifyTool(tool)
+	}
+	g.addNode(Node{ID: id, Kind: KindTool, Name: tool, Namespace: v.Namespace,
+		Attributes: map[string]interface{}{"server": v.ID, "sensitivity": sensitivity}})
+	g.addEdge(Edge{From: v.ID, To: id, Kind: EdgeExposes, Attributes: map[string]interface{}{"allowedByPolicy": exposed}})
+}
+
+// resolveAgentRef finds the MCP server view an agent tool ref points at and
+// whether the agent reaches it through agentgateway. kagent MCPServer refs and
+// plain Services are called directly; RemoteMCPServer refs go through the
+// gateway only when their URL points at the agentgateway Service.
+func resolveAgentRef(state *evaluator.ClusterState, views []evaluator.MCPServerView, a evaluator.KagentAgentResource, tr evaluator.KagentToolRef) agentRef {
+	source := "KagentMCPServer"
+	if tr.Kind == "RemoteMCPServer" {
+		source = "KagentRemoteMCPServer"
+	}
+	ar := agentRef{ref: tr, serverID: ref(source, a.Namespace, tr.Name)}
+
+	var fallback *evaluator.MCPServerView
+	for i := range views {
+		v := &views[i]
+		if v.Name != tr.Name || (tr.Kind != "" && tr.Kind != "Service" && v.Source != source) {
+			continue
+		}
+		if v.Namespace == a.Namespace {
+			ar.view = v
+			break
+		}
+		if fallback == nil {
+			fallback = v
+		}
+	}
+	if ar.view == nil {
+		ar.view = fallback
+	}
+	if ar.view != nil {
+		ar.serverID = ar.view.ID
+	}
+
+	if tr.Kind == "RemoteMCPServer" {
+		for _, rms := range state.KagentRemoteMCPServers {
+			if rms.Name == tr.Name && (ar.view == nil || rms.Namespace == ar.view.Namespace) {
+				if gw, ok := evaluator.GatewayForURL(state, rms.URL); ok {
+					ar.viaGateway = true
+					ar.gatewayID = ref(KindGateway, gw.Namespace, gw.Name)
+				}
+				break
+			}
+		}
+	}
+	return ar
+}
+
+// Controls lists the security controls enforced on traffic to an MCP server
+// through the gateway.
+func Controls(v *evaluator.MCPServerView) []string {
+	controls := []string{}
+	if v.HasJWT {
+		mode := v.JWTMode
+		if mode == "" {
+			mode = "Strict"
+		}
+		controls = append(controls, "jwt:"+mode)
+	} else if v.HasAuth {
+		controls = append(controls, "auth")
+	}
+	if v.HasRBAC {
+		controls = append(controls, "rbac")
+	}
+	if v.HasToolRestriction {
+		controls = append(controls, "tool-restriction")
+	}
+	if v.HasTLS {
+		controls = append(controls, "backend-tls")
+	}
+	if v.HasCORS {
+		controls = append(controls, "cors")
+	}
+	if v.HasRateLimit {
+		controls = append(controls, "rate-limit")
+	}
+	if v.HasPromptGuard {
+		controls = append(controls, "prompt-guard")
+	}
+	return controls
+}
@@ -0,0 +1,143 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/techwithhuz/mcp-security-governance/controller/pkg/evaluator"
+)
+
+func graphState() *evaluator.ClusterState {
+	return &evaluator.ClusterState{
+		Namespaces: []string{"agentgateway-system", "mcp"},
+		Gateways: []evaluator.GatewayResource{
+			{Name: "agentgateway", Namespace: "agentgateway-system", GatewayClassName: "agentgateway", Programmed: true},
+		},
+		Services: []evaluator.ServiceResource{
+			{Name: "agentgateway", Namespace: "agentgateway-system", Ports: []int{8080}},
+		},
+		HTTPRoutes: []evaluator.HTTPRouteResource{
+			{Name: "mcp-route", Namespace: "mcp", ParentGateway: "agentgateway", ParentGatewayNamespace: "agentgateway-system", BackendRefs: []string{"mcp-backend"}},
+		},
+		AgentgatewayBackends: []evaluator.AgentgatewayBackendResource{
+			{Name: "mcp-backend", Namespace: "mcp", BackendType: "mcp", HasTLS: true,
+				MCPTargets: []evaluator.MCPTargetInfo{{Name: "k8s-mcp", Host: "k8s-mcp.mcp.svc.cluster.local", Port: 8080}}},
+		},
+		AgentgatewayPolicies: []evaluator.AgentgatewayPolicyResource{
+			{Name: "jwt", Namespace: "mcp", HasJWT: true, JWTMode: "Strict", HasRBAC: true,
+				TargetRefs: []evaluator.PolicyTargetRef{{Kind: "HTTPRoute", Name: "mcp-route"}}},
+		},
+		KagentMCPServers: []evaluator.KagentMCPServerResource{
+			{Name: "k8s-mcp", Namespace: "mcp", Transport: "streamablehttp", Port: 8080},
+		},
+		KagentRemoteMCPServers: []evaluator.KagentRemoteMCPServerResource{
+			{Name: "gw-tools", Namespace: "mcp", URL: "http://agentgateway.agentgateway-system:8080/mcp",
+				ToolCount: 2, ToolNames: []string{"get_pods", "delete_pod"}},
+		},
+		KagentAgents: []evaluator.KagentAgentResource{
+			{Name: "ops", Namespace: "mcp", Tools: []evaluator.KagentToolRef{
+				{Type: "McpServer", Kind: "RemoteMCPServer", Name: "gw-tools"},
+				{Type: "McpServer", Kind: "MCPServer", Name: "k8s-mcp", ToolNames: []string{"exec_pod"}},
+				{Type: "McpServer", Kind: "RemoteMCPServer", Name: "ghost"},
+			}},
+		},
+	}
+}
+
+func buildTestGraph(t *testing.T) *Graph {
+	t.Helper()
+	state := graphState()
+	return Build(state, evaluator.Evaluate(state, evaluator.DefaultPolicy()))
+}
+
+func hasEdge(g *Graph, from, to, kind string) bool {
+	for _, e := range g.Edges {
+		if e.From == from && e.To == to && e.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func TestBuild_GatewayChain(t *testing.T) {
+	g := buildTestGraph(t)
+
+	chain := []struct{ from, to, kind string }{
+		{"Gateway/agentgateway-system/agentgateway", "HTTPRoute/mcp/mcp-route", EdgeRoutes},
+		{"HTTPRoute/mcp/mcp-route", "AgentgatewayBackend/mcp/mcp-backend", EdgeBackend},
+		{"AgentgatewayBackend/mcp/mcp-backend", "MCPTarget/mcp/mcp-backend/k8s-mcp", EdgeTarget},
+		{"MCPTarget/mcp/mcp-backend/k8s-mcp", "KagentMCPServer/mcp/k8s-mcp", EdgeServes},
+		{"KagentRemoteMCPServer/mcp/gw-tools", "Tool/KagentRemoteMCPServer/mcp/gw-tools/delete_pod", EdgeExposes},
+		{"Agent/mcp/ops", "KagentRemoteMCPServer/mcp/gw-tools", EdgeUses},
+	}
+	for _, c := range chain {
+		if !hasEdge(g, c.from, c.to, c.kind) {
+			t.Errorf("missing %s edge %s → %s", c.kind, c.from, c.to)
+		}
+	}
+	if n, ok := g.Node("Tool/KagentRemoteMCPServer/mcp/gw-tools/delete_pod"); !ok || n.Attributes["sensitivity"] != evaluator.ToolClassDestructive {
+		t.Errorf("delete_pod node = %+v", n)
+	}
+	if n, ok := g.Node("KagentRemoteMCPServer/mcp/ghost"); !ok || n.Attributes["missing"] != true {
+		t.Errorf("dangling ref node = %+v", n)
+	}
+}
+
+func TestBuild_FlagsGatewayBypass(t *testing.T) {
+	g := buildTestGraph(t)
+
+	bypassed := map[string]bool{}
+	for _, e := range g.Bypasses {
+		bypassed[e.To] = true
+	}
+	if !bypassed["KagentMCPServer/mcp/k8s-mcp"] {
+		t.Error("direct MCPServer ref should be flagged as bypassing the gateway")
+	}
+	if bypassed["KagentRemoteMCPServer/mcp/gw-tools"] {
+		t.Error("RemoteMCPServer via the agentgateway Service should not be a bypass")
+	}
+}
+
+func TestBlastRadius(t *testing.T) {
+	g := buildTestGraph(t)
+
+	br, ok := g.BlastRadius("mcp/ops")
+	if !ok {
+		t.Fatal("agent mcp/ops not found")
+	}
+	if len(br.Paths) != 3 || br.BypassPaths != 2 {
+		t.Fatalf("paths = %+v, bypass = %d", br.Paths, br.BypassPaths)
+	}
+	paths := map[string]AgentPath{}
+	for _, p := range br.Paths {
+		paths[p.Server] = p
+	}
+
+	direct := paths["KagentMCPServer/mcp/k8s-mcp"]
+	if !direct.BypassesGateway || len(direct.Controls) != 0 {
+		t.Errorf("direct path = %+v", direct)
+	}
+	// Route-level JWT/RBAC apply only to gateway traffic, not to the direct call
+	if len(direct.Tools) != 1 || direct.Tools[0] != "exec_pod" {
+		t.Errorf("direct tools = %v", direct.Tools)
+	}
+
+	via := paths["KagentRemoteMCPServer/mcp/gw-tools"]
+	if !via.ViaGateway || via.Hops[1] != "Gateway/agentgateway-system/agentgateway" {
+		t.Errorf("gateway path = %+v", via)
+	}
+	if len(via.Tools) != 2 {
+		t.Errorf("gateway tools = %v", via.Tools)
+	}
+
+	if !paths["KagentRemoteMCPServer/mcp/ghost"].Missing {
+		t.Error("unknown server should be reported as missing")
+	}
+	// delete_pod (destructive) + exec_pod (exec)
+	if br.SensitiveTools != 2 {
+		t.Errorf("SensitiveTools = %d, want 2", br.SensitiveTools)
+	}
+
+	if _, ok := g.BlastRadius("mcp/nobody"); ok {
+		t.Error("unknown agent should not resolve")
+	}
+}
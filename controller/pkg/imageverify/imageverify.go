@@ -0,0 +1,290 @@
+// Package imageverify verifies cosign signatures of container images offline.
+// Signatures are read from an OCI registry the controller can reach — the
+// image's own registry or a local/in-cluster mirror — and checked against
+// trusted public keys. No Rekor, Fulcio or other Sigstore service is contacted,
+// so keyless (certificate-based) signatures are not supported.
+package imageverify
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Status values of a Result.
+const (
+	StatusVerified = "verified" // signed by a trusted key
+	StatusUnsigned = "unsigned" // no cosign signature in the registry
+	StatusInvalid  = "invalid"  // signatures exist but none verifies with a trusted key
+	StatusError    = "error"    // the registry could not be queried
+)
+
+// signatureAnnotation holds the base64 signature of a cosign signature layer.
+const signatureAnnotation = "dev.cosignproject.cosign/signature"
+
+// Result is the outcome of verifying one image.
+type Result struct {
+	Image  string `json:"image"`
+	Digest string `json:"digest,omitempty"`
+	Status string `json:"status"`
+	KeyID  string `json:"keyId,omitempty"` // fingerprint of the key that verified the signature
+	Reason string `json:"reason,omitempty"`
+}
+
+// Config configures a Verifier.
+type Config struct {
+	// PublicKeys are PEM-encoded ECDSA, RSA or Ed25519 public keys. One entry
+	// may hold several PEM blocks.
+	PublicKeys []string
+	// Mirror is the host[:port] of an OCI registry holding copies of all
+	// images and their signatures. Empty contacts each image's own registry.
+	Mirror string
+	// Insecure uses plain HTTP (e.g. an in-cluster registry without TLS).
+	Insecure bool
+	// Timeout bounds each registry request. Default: 10s
+	Timeout time.Duration
+}
+
+// Verifier verifies image signatures and caches the outcome by manifest
+// digest. It is safe for concurrent use.
+type Verifier struct {
+	keys []trustedKey
+	reg  *registryClient
+
+	mu    sync.Mutex
+	cache map[string]Result // digest → result
+}
+
+type trustedKey struct {
+	id  string
+	pub crypto.PublicKey
+}
+
+// New creates a Verifier. It fails when no usable public key is configured.
+func New(cfg Config) (*Verifier, error) {
+	keys, err := parseKeys(cfg.PublicKeys)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no trusted public keys configured")
+	}
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	scheme := "https"
+	if cfg.Insecure {
+		scheme = "http"
+	}
+	return &Verifier{
+		keys: keys,
+		reg: &registryClient{
+			http:   &http.Client{Timeout: timeout},
+			mirror: strings.TrimSuffix(strings.TrimPrefix(strings.TrimPrefix(cfg.Mirror, "https://"), "http://"), "/"),
+			scheme: scheme,
+			tokens: map[string]string{},
+		},
+		cache: map[string]Result{},
+	}, nil
+}
+
+// ConfigKey returns a stable identity for a configuration, so callers can
+// keep a Verifier (and its cache) until the keys or registry settings change.
+func ConfigKey(cfg Config) string {
+	keys := append([]string{}, cfg.PublicKeys...)
+	sort.Strings(keys)
+	h := sha256.New()
+	fmt.Fprintf(h, "%s|%v|%s|", cfg.Mirror, cfg.Insecure, cfg.Timeout)
+	for _, k := range keys {
+		h.Write([]byte(strings.TrimSpace(k)))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func parseKeys(pems []string) ([]trustedKey, error) {
+	var keys []trustedKey
+	for _, s := range pems {
+		rest := []byte(s)
+		for {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+			if block.Type != "PUBLIC KEY" {
+				continue
+			}
+			pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("parse public key: %w", err)
+			}
+			sum := sha256.Sum256(block.Bytes)
+			keys = append(keys, trustedKey{id: hex.EncodeToString(sum[:])[:16], pub: pub})
+		}
+	}
+	return keys, nil
+}
+
+// verify checks a cosign signature over the payload. cosign signs the
+// SHA-256 digest of the payload with ECDSA and RSA keys and the raw payload
+// with Ed25519 keys.
+func (k trustedKey) verify(payload, sig []byte) bool {
+	digest := sha256.Sum256(payload)
+	switch pub := k.pub.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(pub, digest[:], sig)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], sig) == nil ||
+			rsa.VerifyPSS(pub, crypto.SHA256, digest[:], sig, nil) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(pub, payload, sig)
+	}
+	return false
+}
+
+// simpleSigning is the cosign "simple signing" payload.
+type simpleSigning struct {
+	Critical struct {
+		Identity struct {
+			DockerReference string `json:"docker-reference"`
+		} `json:"identity"`
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+}
+
+// Verify resolves the image's digest and checks its cosign signatures. Only
+// definitive outcomes (verified, unsigned, invalid) are cached.
+func (v *Verifier) Verify(ctx context.Context, image string) Result {
+	res := Result{Image: image}
+	ref, err := ParseReference(image)
+	if err != nil {
+		res.Status, res.Reason = StatusError, err.Error()
+		return res
+	}
+
+	digest := ref.Digest
+	if digest == "" {
+		if digest, err = v.reg.resolveDigest(ctx, ref); err != nil {
+			res.Status, res.Reason = StatusError, fmt.Sprintf("resolve %s: %v", ref, err)
+			return res
+		}
+	}
+	res.Digest = digest
+
+	v.mu.Lock()
+	cached, ok := v.cache[digest]
+	v.mu.Unlock()
+	if ok {
+		cached.Image = image
+		return cached
+	}
+
+	res.Status, res.KeyID, res.Reason = v.verifyDigest(ctx, ref, digest)
+	if res.Status != StatusError {
+		v.mu.Lock()
+		v.cache[digest] = res
+		v.mu.Unlock()
+	}
+	return res
+}
+
+func (v *Verifier) verifyDigest(ctx context.Context, ref Reference, digest string) (status, keyID, reason string) {
+	m, err := v.reg.signatureManifest(ctx, ref, digest)
+	if errors.Is(err, errNotFound) {
+		return StatusUnsigned, "", "no cosign signature found for " + digest
+	}
+	if err != nil {
+		return StatusError, "", err.Error()
+	}
+
+	reason = "no signature layers"
+	for _, layer := range m.Layers {
+		b64 := layer.Annotations[signatureAnnotation]
+		if b64 == "" {
+			continue
+		}
+		sig, err := base64.StdEncoding.DecodeString(b64)
+		if err != nil {
+			reason = "malformed signature annotation"
+			continue
+		}
+		payload, err := v.reg.blob(ctx, ref, layer.Digest)
+		if err != nil {
+			if !errors.Is(err, errNotFound) {
+				return StatusError, "", err.Error()
+			}
+			reason = "signature payload " + layer.Digest + " missing"
+			continue
+		}
+		var ss simpleSigning
+		if err := json.Unmarshal(payload, &ss); err != nil {
+			reason = "malformed signature payload"
+			continue
+		}
+		if ss.Critical.Image.DockerManifestDigest != digest {
+			reason = fmt.Sprintf("signature is for %s, not %s", ss.Critical.Image.DockerManifestDigest, digest)
+			continue
+		}
+		for _, k := range v.keys {
+			if k.verify(payload, sig) {
+				return StatusVerified, k.id, ""
+			}
+		}
+		reason = "signature does not verify with any trusted key"
+	}
+	return StatusInvalid, "", reason
+}
+
+// VerifyAll verifies every image with at most `concurrency` registry lookups
+// in flight and returns the results keyed by image reference.
+func (v *Verifier) VerifyAll(ctx context.Context, images []string, concurrency int) map[string]Result {
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+	out := make(map[string]Result, len(images))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for _, img := range images {
+		mu.Lock()
+		_, seen := out[img]
+		if !seen {
+			out[img] = Result{}
+		}
+		mu.Unlock()
+		if seen {
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(img string) {
+			defer func() { <-sem; wg.Done() }()
+			r := v.Verify(ctx, img)
+			mu.Lock()
+			out[img] = r
+			mu.Unlock()
+		}(img)
+	}
+	wg.Wait()
+	return out
+}
@@ -0,0 +1,222 @@
+package imageverify
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestParseReference(t *testing.T) {
+	digest := "sha256:" + strings.Repeat("a", 64)
+	tests := []struct {
+		image string
+		want  Reference
+	}{
+		{"nginx", Reference{Registry: "docker.io", Repository: "library/nginx", Tag: "latest"}},
+		{"bitnami/redis:7.2", Reference{Registry: "docker.io", Repository: "bitnami/redis", Tag: "7.2"}},
+		{"ghcr.io/org/app:v1@" + digest, Reference{Registry: "ghcr.io", Repository: "org/app", Tag: "v1", Digest: digest}},
+		{"localhost/app@" + digest, Reference{Registry: "localhost", Repository: "app", Digest: digest}},
+		{"registry.local:5000/team/mcp", Reference{Registry: "registry.local:5000", Repository: "team/mcp", Tag: "latest"}},
+	}
+	for _, tt := range tests {
+		got, err := ParseReference(tt.image)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseReference(%q) = %+v, %v; want %+v", tt.image, got, err, tt.want)
+		}
+	}
+	if _, err := ParseReference("app@sha256:short"); err == nil {
+		t.Error("expected an error for a malformed digest")
+	}
+}
+
+func TestRegistryAllowed(t *testing.T) {
+	allowed := []string{"ghcr.io/my-org", "registry.local:5000", "docker.io/library"}
+	tests := map[string]bool{
+		"ghcr.io/my-org/app:v1":         true,
+		"ghcr.io/my-org-evil/app:v1":    false,
+		"ghcr.io/other/app":             false,
+		"registry.local:5000/x/y":       true,
+		"nginx:1.27":                    true,
+		"bitnami/redis:7":               false,
+		"registry.local:5000.evil.io/x": false,
+	}
+	for image, want := range tests {
+		if got := RegistryAllowed(image, allowed); got != want {
+			t.Errorf("RegistryAllowed(%q) = %v, want %v", image, got, want)
+		}
+	}
+}
+
+// fakeRegistry serves one repository with an image manifest and, optionally,
+// a cosign signature made with key.
+type fakeRegistry struct {
+	manifest   []byte
+	digest     string
+	sigTag     string
+	sigBody    []byte
+	payload    []byte
+	payloadDig string
+	requests   atomic.Int32
+	token      string // when set, require this bearer token
+}
+
+func newFakeRegistry(t *testing.T, key *ecdsa.PrivateKey) *fakeRegistry {
+	t.Helper()
+	r := &fakeRegistry{manifest: []byte(`{"schemaVersion":2,"mediaType":"application/vnd.oci.image.manifest.v1+json","layers":[]}`)}
+	sum := sha256.Sum256(r.manifest)
+	r.digest = "sha256:" + hex.EncodeToString(sum[:])
+	r.sigTag = "sha256-" + hex.EncodeToString(sum[:]) + ".sig"
+	if key == nil {
+		return r
+	}
+
+	r.payload = []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"x/app"},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`, r.digest))
+	psum := sha256.Sum256(r.payload)
+	r.payloadDig = "sha256:" + hex.EncodeToString(psum[:])
+	sig, err := ecdsa.SignASN1(rand.Reader, key, psum[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.sigBody, _ = json.Marshal(manifest{
+		MediaType: "application/vnd.oci.image.manifest.v1+json",
+		Layers: []descriptor{{
+			MediaType:   "application/vnd.dev.cosign.simplesigning.v1+json",
+			Digest:      r.payloadDig,
+			Size:        int64(len(r.payload)),
+			Annotations: map[string]string{signatureAnnotation: base64.StdEncoding.EncodeToString(sig)},
+		}},
+	})
+	return r
+}
+
+func (r *fakeRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.requests.Add(1)
+	if req.URL.Path == "/token" {
+		fmt.Fprintf(w, `{"token":%q}`, r.token)
+		return
+	}
+	if r.token != "" && req.Header.Get("Authorization") != "Bearer "+r.token {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="http://%s/token",service="fake"`, req.Host))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	switch req.URL.Path {
+	case "/v2/x/app/manifests/v1", "/v2/x/app/manifests/" + r.digest:
+		w.Header().Set("Docker-Content-Digest", r.digest)
+		w.Write(r.manifest)
+	case "/v2/x/app/manifests/" + r.sigTag:
+		if r.sigBody == nil {
+			http.NotFound(w, req)
+			return
+		}
+		w.Write(r.sigBody)
+	case "/v2/x/app/blobs/" + r.payloadDig:
+		w.Write(r.payload)
+	default:
+		http.NotFound(w, req)
+	}
+}
+
+func publicKeyPEM(t *testing.T, key *ecdsa.PrivateKey) string {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func newKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func TestVerify(t *testing.T) {
+	trusted, other := newKey(t), newKey(t)
+	tests := []struct {
+		name       string
+		signer     *ecdsa.PrivateKey
+		token      string
+		wantStatus string
+	}{
+		{"signed by trusted key", trusted, "", StatusVerified},
+		{"signed behind token auth", trusted, "s3cret", StatusVerified},
+		{"unsigned", nil, "", StatusUnsigned},
+		{"signed by untrusted key", other, "", StatusInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := newFakeRegistry(t, tt.signer)
+			reg.token = tt.token
+			srv := httptest.NewServer(reg)
+			defer srv.Close()
+
+			v, err := New(Config{PublicKeys: []string{publicKeyPEM(t, trusted)}, Insecure: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+			image := strings.TrimPrefix(srv.URL, "http://") + "/x/app:v1"
+			res := v.Verify(context.Background(), image)
+			if res.Status != tt.wantStatus || res.Digest != reg.digest {
+				t.Fatalf("Verify = %+v, want status %s digest %s", res, tt.wantStatus, reg.digest)
+			}
+			if tt.wantStatus == StatusVerified && res.KeyID == "" {
+				t.Error("verified result should carry the key ID")
+			}
+		})
+	}
+}
+
+func TestVerify_CachesByDigestAndUsesMirror(t *testing.T) {
+	key := newKey(t)
+	reg := newFakeRegistry(t, key)
+	srv := httptest.NewServer(reg)
+	defer srv.Close()
+
+	v, err := New(Config{PublicKeys: []string{publicKeyPEM(t, key)}, Mirror: srv.URL, Insecure: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The image names another registry; every request goes to the mirror.
+	pinned := "ghcr.io/x/app@" + reg.digest
+	results := v.VerifyAll(context.Background(), []string{"ghcr.io/x/app:v1", pinned, pinned}, 2)
+	if len(results) != 2 {
+		t.Fatalf("results = %v", results)
+	}
+	for img, r := range results {
+		if r.Status != StatusVerified || r.Image != img {
+			t.Errorf("%s: %+v", img, r)
+		}
+	}
+
+	before := reg.requests.Load()
+	if r := v.Verify(context.Background(), pinned); r.Status != StatusVerified {
+		t.Fatalf("cached Verify = %+v", r)
+	}
+	if reg.requests.Load() != before {
+		t.Error("a pinned, already verified digest should be served from the cache")
+	}
+}
+
+func TestNew_RequiresKeys(t *testing.T) {
+	if _, err := New(Config{PublicKeys: []string{"not a key"}}); err == nil {
+		t.Error("expected an error without usable keys")
+	}
+}
@@ -0,0 +1,101 @@
+package imageverify
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultRegistry is the registry of image references without a host.
+const DefaultRegistry = "docker.io"
+
+// Reference is a parsed container image reference.
+type Reference struct {
+	Registry   string // "docker.io", "ghcr.io", "registry.local:5000"
+	Repository string // "library/nginx", "org/app"
+	Tag        string // empty when only a digest is given
+	Digest     string // "sha256:..." when pinned
+}
+
+// ParseReference parses an image reference following the Docker rules: the
+// first path component is a registry host only if it contains '.' or ':' or
+// is "localhost"; single-component Docker Hub images live under "library/";
+// an image without tag or digest means ":latest".
+func ParseReference(image string) (Reference, error) {
+	var ref Reference
+	name := strings.TrimSpace(image)
+	if name == "" {
+		return ref, fmt.Errorf("empty image reference")
+	}
+	if i := strings.Index(name, "@"); i >= 0 {
+		ref.Digest = name[i+1:]
+		name = name[:i]
+		if !strings.HasPrefix(ref.Digest, "sha256:") || len(ref.Digest) != len("sha256:")+64 {
+			return Reference{}, fmt.Errorf("invalid digest %q", ref.Digest)
+		}
+	}
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		ref.Tag = name[i+1:]
+		name = name[:i]
+	}
+
+	parts := strings.SplitN(name, "/", 2)
+	if len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		ref.Registry, ref.Repository = parts[0], parts[1]
+	} else {
+		ref.Registry, ref.Repository = DefaultRegistry, name
+	}
+	if ref.Registry == "index.docker.io" {
+		ref.Registry = DefaultRegistry
+	}
+	if ref.Registry == DefaultRegistry && !strings.Contains(ref.Repository, "/") {
+		ref.Repository = "library/" + ref.Repository
+	}
+	if ref.Repository == "" {
+		return Reference{}, fmt.Errorf("invalid image reference %q", image)
+	}
+	if ref.Tag == "" && ref.Digest == "" {
+		ref.Tag = "latest"
+	}
+	return ref, nil
+}
+
+// Name returns the fully qualified repository, e.g. "docker.io/library/nginx".
+func (r Reference) Name() string {
+	return r.Registry + "/" + r.Repository
+}
+
+// String returns the canonical reference.
+func (r Reference) String() string {
+	s := r.Name()
+	if r.Tag != "" {
+		s += ":" + r.Tag
+	}
+	if r.Digest != "" {
+		s += "@" + r.Digest
+	}
+	return s
+}
+
+// RegistryAllowed reports whether the image comes from one of the allowed
+// registries. An entry is either a registry host ("ghcr.io") or a repository
+// prefix ("ghcr.io/my-org"); it matches whole path components only.
+func RegistryAllowed(image string, allowed []string) bool {
+	ref, err := ParseReference(image)
+	if err != nil {
+		return false
+	}
+	name := ref.Name()
+	for _, a := range allowed {
+		a = strings.TrimSuffix(strings.TrimSpace(a), "/")
+		if a == "" {
+			continue
+		}
+		if a == "index.docker.io" || strings.HasPrefix(a, "index.docker.io/") {
+			a = DefaultRegistry + strings.TrimPrefix(a, "index.docker.io")
+		}
+		if name == a || strings.HasPrefix(name, a+"/") {
+			return true
+		}
+	}
+	return false
+}
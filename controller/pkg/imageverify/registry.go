@@ -0,0 +1,215 @@
+package imageverify
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// maxBody caps manifest and signature payload reads.
+const maxBody = 4 << 20
+
+var errNotFound = errors.New("not found")
+
+// manifestAccept lists the manifest media types the client understands.
+var manifestAccept = []string{
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+}
+
+// manifest is the subset of an OCI image manifest cosign signatures use.
+type manifest struct {
+	MediaType string       `json:"mediaType"`
+	Layers    []descriptor `json:"layers"`
+}
+
+type descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// registryClient is a minimal, read-only OCI distribution API client with
+// anonymous bearer-token support.
+type registryClient struct {
+	http   *http.Client
+	mirror string // host[:port] used for every repository when set
+	scheme string
+
+	mu     sync.Mutex
+	tokens map[string]string // "host/repository" → bearer token
+}
+
+// host returns the registry host to contact for a reference.
+func (c *registryClient) host(ref Reference) string {
+	if c.mirror != "" {
+		return c.mirror
+	}
+	if ref.Registry == DefaultRegistry {
+		return "registry-1.docker.io"
+	}
+	return ref.Registry
+}
+
+// get fetches /v2/<repository>/<path> and returns the body and response
+// headers. A 404 returns errNotFound.
+func (c *registryClient) get(ctx context.Context, ref Reference, path string, accept []string) ([]byte, http.Header, error) {
+	host := c.host(ref)
+	u := fmt.Sprintf("%s://%s/v2/%s/%s", c.scheme, host, ref.Repository, path)
+	tokenKey := host + "/" + ref.Repository
+
+	resp, err := c.do(ctx, u, accept, tokenKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		if err := c.fetchToken(ctx, challenge, ref, tokenKey); err != nil {
+			return nil, nil, err
+		}
+		if resp, err = c.do(ctx, u, accept, tokenKey); err != nil {
+			return nil, nil, err
+		}
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, nil, errNotFound
+	case resp.StatusCode != http.StatusOK:
+		return nil, nil, fmt.Errorf("GET %s: %s", u, resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBody))
+	if err != nil {
+		return nil, nil, fmt.Errorf("GET %s: %w", u, err)
+	}
+	return body, resp.Header, nil
+}
+
+func (c *registryClient) do(ctx context.Context, u string, accept []string, tokenKey string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(accept) > 0 {
+		req.Header.Set("Accept", strings.Join(accept, ", "))
+	}
+	c.mu.Lock()
+	token := c.tokens[tokenKey]
+	c.mu.Unlock()
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return c.http.Do(req)
+}
+
+// fetchToken performs the anonymous token flow of a
+// `Bearer realm="...",service="..."` challenge for pull access.
+func (c *registryClient) fetchToken(ctx context.Context, challenge string, ref Reference, tokenKey string) error {
+	params := parseChallenge(challenge)
+	realm := params["realm"]
+	if realm == "" {
+		return fmt.Errorf("registry %s requires credentials", c.host(ref))
+	}
+	q := url.Values{}
+	if s := params["service"]; s != "" {
+		q.Set("service", s)
+	}
+	q.Set("scope", "repository:"+ref.Repository+":pull")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm+"?"+q.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("token request to %s: %s", realm, resp.Status)
+	}
+	var tok struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxBody)).Decode(&tok); err != nil {
+		return fmt.Errorf("token response from %s: %w", realm, err)
+	}
+	if tok.Token == "" {
+		tok.Token = tok.AccessToken
+	}
+	c.mu.Lock()
+	c.tokens[tokenKey] = tok.Token
+	c.mu.Unlock()
+	return nil
+}
+
+// parseChallenge parses the parameters of a WWW-Authenticate Bearer challenge.
+func parseChallenge(h string) map[string]string {
+	out := map[string]string{}
+	scheme, rest, ok := strings.Cut(strings.TrimSpace(h), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return out
+	}
+	for _, part := range strings.Split(rest, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if ok {
+			out[strings.ToLower(k)] = strings.Trim(v, `"`)
+		}
+	}
+	return out
+}
+
+// resolveDigest returns the manifest digest a tag points to.
+func (c *registryClient) resolveDigest(ctx context.Context, ref Reference) (string, error) {
+	body, hdr, err := c.get(ctx, ref, "manifests/"+ref.Tag, manifestAccept)
+	if err != nil {
+		return "", err
+	}
+	if d := hdr.Get("Docker-Content-Digest"); strings.HasPrefix(d, "sha256:") {
+		return d, nil
+	}
+	sum := sha256.Sum256(body)
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+// signatureManifest returns the cosign signature manifest stored under the
+// "sha256-<hex>.sig" tag of the image's repository.
+func (c *registryClient) signatureManifest(ctx context.Context, ref Reference, digest string) (manifest, error) {
+	var m manifest
+	tag := strings.Replace(digest, ":", "-", 1) + ".sig"
+	body, _, err := c.get(ctx, ref, "manifests/"+tag, manifestAccept)
+	if err != nil {
+		return m, err
+	}
+	if err := json.Unmarshal(body, &m); err != nil {
+		return m, fmt.Errorf("signature manifest %s: %w", tag, err)
+	}
+	return m, nil
+}
+
+// blob fetches a blob and checks it against its digest.
+func (c *registryClient) blob(ctx context.Context, ref Reference, digest string) ([]byte, error) {
+	body, _, err := c.get(ctx, ref, "blobs/"+digest, nil)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(body)
+	if "sha256:"+hex.EncodeToString(sum[:]) != digest {
+		return nil, fmt.Errorf("blob %s does not match its digest", digest)
+	}
+	return body, nil
+}
@@ -0,0 +1,192 @@
+package mcpprobe
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Kinds of AuthRequest.
+const (
+	AuthUnauthenticated = "unauthenticated" // no Authorization header
+	AuthExpiredToken    = "expired-token"   // a token that expired an hour ago
+	AuthWrongAudience   = "wrong-audience"  // a current token for another audience
+)
+
+// WrongAudience is the audience claimed by wrong-audience tokens.
+const WrongAudience = "mcp-governance-probe.invalid"
+
+// Outcomes of an AuthResult.
+const (
+	OutcomeAccepted     = "accepted"     // initialize and tools/list succeeded
+	OutcomeRejected     = "rejected"     // the endpoint answered 401 or 403
+	OutcomeInconclusive = "inconclusive" // unreachable, timed out or not an MCP server
+)
+
+// AuthRequest is one credential presented by an auth probe.
+type AuthRequest struct {
+	Kind   string                 `json:"kind"`
+	Token  string                 `json:"-"`                // bearer token; empty sends no Authorization header
+	Claims map[string]interface{} `json:"claims,omitempty"` // claims of Token, quoted in evidence
+}
+
+// AuthTarget is an endpoint and the requests an auth probe sends it.
+type AuthTarget struct {
+	Target
+	Requests []AuthRequest
+}
+
+// AuthResult records how an endpoint answered one AuthRequest.
+type AuthResult struct {
+	Kind     string `json:"kind"`
+	Outcome  string `json:"outcome"`
+	Evidence string `json:"evidence"` // the request sent and the answer received
+}
+
+// AuthRequests returns the requests of an auth probe: an unauthenticated
+// request and, when withTokens is set, an expired token for audience and a
+// current token for WrongAudience, both claiming issuer.
+//
+// Tokens are signed with a key generated for the call, which no verifier
+// trusts: an endpoint accepting one does not validate tokens at all, and a
+// rejection does not show which claim was refused.
+func AuthRequests(issuer, audience string, withTokens bool, now time.Time) ([]AuthRequest, error) {
+	reqs := []AuthRequest{{Kind: AuthUnauthenticated}}
+	if !withTokens {
+		return reqs, nil
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range []struct {
+		kind     string
+		audience string
+		exp      time.Time
+	}{
+		{AuthExpiredToken, audience, now.Add(-time.Hour)},
+		{AuthWrongAudience, WrongAudience, now.Add(5 * time.Minute)},
+	} {
+		claims := map[string]interface{}{
+			"sub": "mcp-governance-probe",
+			"iat": r.exp.Add(-10 * time.Minute).Unix(),
+			"exp": r.exp.Unix(),
+		}
+		if issuer != "" {
+			claims["iss"] = issuer
+		}
+		if r.audience != "" {
+			claims["aud"] = r.audience
+		}
+		token, err := signES256(key, claims)
+		if err != nil {
+			return nil, err
+		}
+		reqs = append(reqs, AuthRequest{Kind: r.kind, Token: token, Claims: claims})
+	}
+	return reqs, nil
+}
+
+// signES256 returns a compact JWS of claims.
+func signES256(key *ecdsa.PrivateKey, claims map[string]interface{}) (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": "ES256", "typ": "JWT", "kid": "mcp-governance-probe"})
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	enc := base64.RawURLEncoding
+	signingInput := enc.EncodeToString(header) + "." + enc.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	if err != nil {
+		return "", err
+	}
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+	return signingInput + "." + enc.EncodeToString(sig), nil
+}
+
+// ProbeAuthAll runs ProbeAuth on every target with at most
+// Config.Concurrency targets in flight. Results are in target order.
+func (p *Prober) ProbeAuthAll(ctx context.Context, targets []AuthTarget) [][]AuthResult {
+	out := make([][]AuthResult, len(targets))
+	p.forEach(len(targets), func(i int) {
+		out[i] = p.ProbeAuth(ctx, targets[i])
+	})
+	return out
+}
+
+// ProbeAuth sends each request of t and records whether the endpoint
+// accepted it, i.e. completed initialize and tools/list.
+func (p *Prober) ProbeAuth(ctx context.Context, t AuthTarget) []AuthResult {
+	out := make([]AuthResult, 0, len(t.Requests))
+	for _, req := range t.Requests {
+		target := t.Target
+		target.Header = t.Header.Clone()
+		if req.Token != "" {
+			if target.Header == nil {
+				target.Header = http.Header{}
+			}
+			target.Header.Set("Authorization", "Bearer "+req.Token)
+		}
+		res := p.Probe(ctx, target)
+
+		r := AuthResult{Kind: req.Kind}
+		var answer string
+		switch res.Status {
+		case StatusOK:
+			r.Outcome = OutcomeAccepted
+			answer = fmt.Sprintf("initialize succeeded (%s, protocol %s) and tools/list returned %d tool(s)",
+				res.ServerLabel(), res.ProtocolVersion, len(res.Tools))
+		case StatusUnauthorized:
+			r.Outcome = OutcomeRejected
+			answer = "rejected with " + res.Reason
+		default:
+			r.Outcome = OutcomeInconclusive
+			answer = "no MCP answer: " + res.Reason
+		}
+		r.Evidence = fmt.Sprintf("%s %s via %s: %s", describeEndpoint(res, target), describeCredential(req), res.Transport, answer)
+		out = append(out, r)
+	}
+	return out
+}
+
+func describeEndpoint(res Result, t Target) string {
+	if t.Host != "" {
+		return fmt.Sprintf("%s (Host: %s)", res.URL, t.Host)
+	}
+	return res.URL
+}
+
+// describeCredential summarizes the credential of req without the token.
+func describeCredential(req AuthRequest) string {
+	if req.Token == "" {
+		return "without credentials"
+	}
+	var parts []string
+	for _, k := range []string{"iss", "aud", "exp"} {
+		v, ok := req.Claims[k]
+		if !ok {
+			continue
+		}
+		if k == "exp" {
+			if sec, ok := v.(int64); ok {
+				v = time.Unix(sec, 0).UTC().Format(time.RFC3339)
+			}
+		}
+		parts = append(parts, fmt.Sprintf("%s=%v", k, v))
+	}
+	return fmt.Sprintf("with a forged %s token (%s)", strings.ReplaceAll(req.Kind, "-token", ""), strings.Join(parts, ", "))
+}
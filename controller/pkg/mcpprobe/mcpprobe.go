@@ -0,0 +1,310 @@
+// Package mcpprobe connects to MCP servers over the Streamable HTTP or the
+// legacy HTTP+SSE transport, performs the initialize handshake and lists the
+// server's tools. Only initialize and tools/list are sent; no tool is called.
+// ProbeAuth repeats that handshake without credentials or with invalid
+// tokens to test whether an endpoint enforces authentication.
+package mcpprobe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ProtocolVersion is the MCP revision the prober offers in initialize.
+const ProtocolVersion = "2025-06-18"
+
+// Transport values of a Target and a Result.
+const (
+	TransportStreamableHTTP = "streamable-http"
+	TransportSSE            = "sse"
+)
+
+// Status values of a Result.
+const (
+	StatusOK           = "ok"           // initialize and tools/list succeeded
+	StatusUnauthorized = "unauthorized" // the endpoint answered 401 or 403
+	StatusError        = "error"        // unreachable, timed out or not an MCP server
+)
+
+// maxBodySize bounds every JSON response and SSE event read from a server.
+const maxBodySize = 4 << 20
+
+// maxToolPages bounds tools/list pagination.
+const maxToolPages = 20
+
+// Target is an MCP endpoint to probe.
+type Target struct {
+	ID  string // caller's identifier, copied to the Result
+	URL string
+	// Transport is TransportStreamableHTTP, TransportSSE, or empty to try
+	// Streamable HTTP and fall back to SSE when the endpoint rejects POST.
+	Transport string
+	// Host overrides the Host header, for endpoints routed by hostname.
+	Host string
+	// Header is added to every request (e.g. Authorization).
+	Header http.Header
+}
+
+// apply sets the target's Host and headers on req.
+func (t Target) apply(req *http.Request) {
+	if t.Host != "" {
+		req.Host = t.Host
+	}
+	for k, v := range t.Header {
+		req.Header[k] = v
+	}
+}
+
+// Result is the outcome of probing one endpoint.
+type Result struct {
+	ID              string   `json:"id"`
+	URL             string   `json:"url"`
+	Transport       string   `json:"transport,omitempty"`
+	Status          string   `json:"status"`
+	Reason          string   `json:"reason,omitempty"`
+	ServerName      string   `json:"serverName,omitempty"`
+	ServerVersion   string   `json:"serverVersion,omitempty"`
+	ProtocolVersion string   `json:"protocolVersion,omitempty"`
+	Tools           []string `json:"tools,omitempty"`
+	ProbedAt        string   `json:"probedAt"`
+}
+
+// ServerLabel returns the server name and version reported by initialize.
+func (r Result) ServerLabel() string {
+	switch {
+	case r.ServerName == "":
+		return "unnamed server"
+	case r.ServerVersion == "":
+		return r.ServerName
+	}
+	return r.ServerName + " " + r.ServerVersion
+}
+
+// Config configures a Prober.
+type Config struct {
+	// Timeout bounds the whole probe of one endpoint. Default: 10s
+	Timeout time.Duration
+	// Concurrency is the number of endpoints probed at once. Default: 4
+	Concurrency int
+	// Client overrides the HTTP client (tests, custom TLS).
+	Client *http.Client
+}
+
+// Prober probes MCP endpoints. It is safe for concurrent use.
+type Prober struct {
+	timeout     time.Duration
+	concurrency int
+	client      *http.Client
+}
+
+// New creates a Prober.
+func New(cfg Config) *Prober {
+	p := &Prober{timeout: cfg.Timeout, concurrency: cfg.Concurrency, client: cfg.Client}
+	if p.timeout <= 0 {
+		p.timeout = 10 * time.Second
+	}
+	if p.concurrency <= 0 {
+		p.concurrency = 4
+	}
+	if p.client == nil {
+		// No client-wide timeout: SSE streams stay open for the whole probe,
+		// which the per-probe context bounds instead.
+		p.client = &http.Client{}
+	}
+	return p
+}
+
+// ProbeAll probes every target with at most Config.Concurrency probes in
+// flight and returns the results keyed by target ID.
+func (p *Prober) ProbeAll(ctx context.Context, targets []Target) map[string]Result {
+	out := make(map[string]Result, len(targets))
+	var mu sync.Mutex
+	p.forEach(len(targets), func(i int) {
+		r := p.Probe(ctx, targets[i])
+		mu.Lock()
+		out[targets[i].ID] = r
+		mu.Unlock()
+	})
+	return out
+}
+
+// forEach calls fn for 0..n-1 with at most Config.Concurrency calls running.
+func (p *Prober) forEach(n int, fn func(i int)) {
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, p.concurrency)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() { <-sem; wg.Done() }()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+// Probe performs initialize and tools/list against one endpoint.
+func (p *Prober) Probe(ctx context.Context, t Target) Result {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	res := p.probeWith(ctx, t, t.Transport)
+	var se *statusError
+	if t.Transport == "" && res.Status == StatusError && errors.As(res.err, &se) && se.notStreamable() {
+		sse := p.probeWith(ctx, t, TransportSSE)
+		if sse.Status != StatusError {
+			return sse.Result
+		}
+		res.Reason += "; SSE fallback: " + sse.Reason
+	}
+	return res.Result
+}
+
+type probeResult struct {
+	Result
+	err error
+}
+
+func (p *Prober) probeWith(ctx context.Context, t Target, transport string) probeResult {
+	if transport == "" {
+		transport = TransportStreamableHTTP
+	}
+	res := probeResult{Result: Result{ID: t.ID, URL: t.URL, Transport: transport, ProbedAt: time.Now().UTC().Format(time.RFC3339)}}
+
+	var sess session
+	var err error
+	if transport == TransportSSE {
+		sess, err = p.openSSE(ctx, t)
+	} else {
+		sess = &streamableSession{p: p, target: t}
+	}
+	if err == nil {
+		defer sess.close()
+		err = handshake(ctx, sess, &res.Result)
+	}
+
+	res.err = err
+	var se *statusError
+	switch {
+	case err == nil:
+		res.Status = StatusOK
+	case errors.As(err, &se) && (se.code == http.StatusUnauthorized || se.code == http.StatusForbidden):
+		res.Status, res.Reason = StatusUnauthorized, err.Error()
+	case ctx.Err() != nil:
+		res.Status, res.Reason = StatusError, fmt.Sprintf("timed out after %s", p.timeout)
+	default:
+		res.Status, res.Reason = StatusError, err.Error()
+	}
+	return res
+}
+
+// session is an initialized-or-not connection to one MCP server.
+type session interface {
+	call(ctx context.Context, id int, method string, params interface{}) ([]byte, error)
+	notify(ctx context.Context, method string) error
+	close()
+}
+
+// statusError is a non-2xx HTTP answer.
+type statusError struct {
+	code int
+	body string
+}
+
+func (e *statusError) Error() string {
+	if e.body == "" {
+		return fmt.Sprintf("HTTP %d", e.code)
+	}
+	return fmt.Sprintf("HTTP %d: %s", e.code, e.body)
+}
+
+// notStreamable reports whether the answer suggests a server that only
+// speaks the legacy SSE transport (it rejects POSTs to the endpoint).
+func (e *statusError) notStreamable() bool {
+	return e.code == http.StatusBadRequest || e.code == http.StatusNotFound || e.code == http.StatusMethodNotAllowed
+}
+
+type initializeResult struct {
+	ProtocolVersion string `json:"protocolVersion"`
+	ServerInfo      struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	} `json:"serverInfo"`
+}
+
+type toolsListResult struct {
+	Tools []struct {
+		Name string `json:"name"`
+	} `json:"tools"`
+	NextCursor string `json:"nextCursor"`
+}
+
+// handshake runs initialize, notifications/initialized and tools/list.
+func handshake(ctx context.Context, s session, res *Result) error {
+	raw, err := s.call(ctx, 1, "initialize", map[string]interface{}{
+		"protocolVersion": ProtocolVersion,
+		"capabilities":    map[string]interface{}{},
+		"clientInfo":      map[string]interface{}{"name": "mcp-governance-prober", "version": "1.0.0"},
+	})
+	if err != nil {
+		return fmt.Errorf("initialize: %w", err)
+	}
+	var init initializeResult
+	if err := decodeResult(raw, &init); err != nil {
+		return fmt.Errorf("initialize: %w", err)
+	}
+	res.ProtocolVersion = init.ProtocolVersion
+	res.ServerName = init.ServerInfo.Name
+	res.ServerVersion = init.ServerInfo.Version
+	if ss, ok := s.(*streamableSession); ok {
+		ss.protocol = init.ProtocolVersion
+	}
+
+	if err := s.notify(ctx, "notifications/initialized"); err != nil {
+		return fmt.Errorf("notifications/initialized: %w", err)
+	}
+
+	tools := []string{}
+	cursor := ""
+	for page := 0; page < maxToolPages; page++ {
+		var params interface{}
+		if cursor != "" {
+			params = map[string]interface{}{"cursor": cursor}
+		}
+		raw, err := s.call(ctx, 2+page, "tools/list", params)
+		if err != nil {
+			return fmt.Errorf("tools/list: %w", err)
+		}
+		var list toolsListResult
+		if err := decodeResult(raw, &list); err != nil {
+			return fmt.Errorf("tools/list: %w", err)
+		}
+		for _, t := range list.Tools {
+			if t.Name != "" {
+				tools = append(tools, t.Name)
+			}
+		}
+		if list.NextCursor == "" {
+			break
+		}
+		cursor = list.NextCursor
+	}
+	sort.Strings(tools)
+	res.Tools = tools
+	return nil
+}
+
+// truncate shortens a response body quoted in an error.
+func truncate(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) > 200 {
+		return s[:200] + "…"
+	}
+	return s
+}
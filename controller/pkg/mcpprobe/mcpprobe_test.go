@@ -0,0 +1,314 @@
+package mcpprobe
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeMCP answers JSON-RPC requests like a small MCP server.
+func fakeMCP(t *testing.T, tools ...string) func(req map[string]interface{}) interface{} {
+	return func(req map[string]interface{}) interface{} {
+		id, ok := req["id"]
+		if !ok {
+			return nil // notification
+		}
+		switch req["method"] {
+		case "initialize":
+			return map[string]interface{}{"jsonrpc": "2.0", "id": id, "result": map[string]interface{}{
+				"protocolVersion": "2025-03-26",
+				"serverInfo":      map[string]interface{}{"name": "fake", "version": "1.2.3"},
+				"capabilities":    map[string]interface{}{"tools": map[string]interface{}{}},
+			}}
+		case "tools/list":
+			// two pages: first tool, then the rest
+			params, _ := req["params"].(map[string]interface{})
+			var list []interface{}
+			result := map[string]interface{}{}
+			if params["cursor"] == nil && len(tools) > 1 {
+				list = append(list, map[string]interface{}{"name": tools[0]})
+				result["nextCursor"] = "p2"
+			} else {
+				start := 0
+				if params["cursor"] == "p2" {
+					start = 1
+				}
+				for _, n := range tools[start:] {
+					list = append(list, map[string]interface{}{"name": n})
+				}
+			}
+			result["tools"] = list
+			return map[string]interface{}{"jsonrpc": "2.0", "id": id, "result": result}
+		}
+		t.Errorf("unexpected method %v", req["method"])
+		return map[string]interface{}{"jsonrpc": "2.0", "id": id, "error": map[string]interface{}{"code": -32601, "message": "not found"}}
+	}
+}
+
+func streamableServer(t *testing.T, sse bool, handle func(map[string]interface{}) interface{}) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			return
+		}
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var req map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&req)
+		if req["method"] != "initialize" && r.Header.Get("Mcp-Session-Id") != "s1" {
+			http.Error(w, "missing session", http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Mcp-Session-Id", "s1")
+		resp := handle(req)
+		if resp == nil {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		data, _ := json.Marshal(resp)
+		if sse {
+			w.Header().Set("Content-Type", "text/event-stream")
+			fmt.Fprintf(w, ": ping\n\nevent: message\ndata: {\"jsonrpc\":\"2.0\",\"method\":\"notifications/progress\"}\n\nevent: message\ndata: %s\n\n", data)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+	}))
+}
+
+// legacySSEServer serves the 2024-11-05 HTTP+SSE transport on /sse.
+func legacySSEServer(t *testing.T, handle func(map[string]interface{}) interface{}) *httptest.Server {
+	var mu sync.Mutex
+	streams := map[string]chan []byte{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/sse", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		ch := make(chan []byte, 4)
+		mu.Lock()
+		streams["abc"] = ch
+		mu.Unlock()
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, "event: endpoint\ndata: /messages?session=abc\n\n")
+		w.(http.Flusher).Flush()
+		for {
+			select {
+			case msg := <-ch:
+				fmt.Fprintf(w, "event: message\ndata: %s\n\n", msg)
+				w.(http.Flusher).Flush()
+			case <-r.Context().Done():
+				return
+			}
+		}
+	})
+	mux.HandleFunc("/messages", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		ch := streams[r.URL.Query().Get("session")]
+		mu.Unlock()
+		var req map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&req)
+		if resp := handle(req); resp != nil {
+			data, _ := json.Marshal(resp)
+			ch <- data
+		}
+		w.WriteHeader(http.StatusAccepted)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestProbe_StreamableHTTP(t *testing.T) {
+	for _, sse := range []bool{false, true} {
+		srv := streamableServer(t, sse, fakeMCP(t, "read", "exec", "write"))
+		res := New(Config{}).Probe(context.Background(), Target{ID: "s", URL: srv.URL})
+		srv.Close()
+		want := Result{ID: "s", URL: srv.URL, Transport: TransportStreamableHTTP, Status: StatusOK,
+			ServerName: "fake", ServerVersion: "1.2.3", ProtocolVersion: "2025-03-26",
+			Tools: []string{"exec", "read", "write"}, ProbedAt: res.ProbedAt}
+		if !reflect.DeepEqual(res, want) {
+			t.Errorf("sse=%v: result = %+v", sse, res)
+		}
+	}
+}
+
+func TestProbe_LegacySSE(t *testing.T) {
+	srv := legacySSEServer(t, fakeMCP(t, "only"))
+	defer srv.Close()
+	p := New(Config{Timeout: 5 * time.Second})
+
+	// Explicit SSE transport, and fallback from Streamable HTTP (GET-only endpoint).
+	for _, transport := range []string{TransportSSE, ""} {
+		res := p.Probe(context.Background(), Target{ID: "s", URL: srv.URL + "/sse", Transport: transport})
+		if res.Status != StatusOK || res.Transport != TransportSSE || !reflect.DeepEqual(res.Tools, []string{"only"}) || res.ServerName != "fake" {
+			t.Errorf("transport %q: result = %+v", transport, res)
+		}
+	}
+}
+
+func TestProbe_Failures(t *testing.T) {
+	unauthorized := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "missing bearer token", http.StatusUnauthorized)
+	}))
+	defer unauthorized.Close()
+	notMCP := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "<html>hello</html>")
+	}))
+	defer notMCP.Close()
+	hang := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-hang:
+		case <-r.Context().Done():
+		}
+	}))
+	defer slow.Close()
+	defer close(hang)
+	crossOrigin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, "event: endpoint\ndata: http://attacker.example/messages\n\n")
+	}))
+	defer crossOrigin.Close()
+
+	p := New(Config{Timeout: 300 * time.Millisecond})
+	tests := []struct {
+		target     Target
+		wantStatus string
+		wantReason string
+	}{
+		{Target{URL: unauthorized.URL}, StatusUnauthorized, "HTTP 401"},
+		{Target{URL: notMCP.URL}, StatusError, "unexpected response"},
+		{Target{URL: slow.URL}, StatusError, "timed out"},
+		{Target{URL: crossOrigin.URL, Transport: TransportSSE}, StatusError, "is not on"},
+		{Target{URL: "http://127.0.0.1:1/mcp"}, StatusError, "initialize"},
+	}
+	for _, tt := range tests {
+		res := p.Probe(context.Background(), tt.target)
+		if res.Status != tt.wantStatus || !strings.Contains(res.Reason, tt.wantReason) {
+			t.Errorf("%s: status = %s, reason = %q; want %s containing %q", tt.target.URL, res.Status, res.Reason, tt.wantStatus, tt.wantReason)
+		}
+	}
+}
+
+func TestProbeAll_Concurrency(t *testing.T) {
+	var inFlight, peak int32
+	handle := fakeMCP(t, "a")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		var req map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&req)
+		resp := handle(req)
+		if resp == nil {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	var targets []Target
+	for i := 0; i < 8; i++ {
+		targets = append(targets, Target{ID: fmt.Sprint(i), URL: srv.URL})
+	}
+	results := New(Config{Concurrency: 2}).ProbeAll(context.Background(), targets)
+	if len(results) != 8 {
+		t.Fatalf("results = %d", len(results))
+	}
+	for id, r := range results {
+		if r.Status != StatusOK || r.ID != id {
+			t.Errorf("result %s = %+v", id, r)
+		}
+	}
+	if peak > 2 {
+		t.Errorf("peak concurrency = %d, want <= 2", peak)
+	}
+}
+
+func TestProbeAuth(t *testing.T) {
+	handle := fakeMCP(t, "read", "write")
+	// A misconfigured server: anonymous requests pass, tokens are checked
+	// for expiry only (never signature or audience).
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Host != "tools.example.com" {
+			http.Error(w, "no route", http.StatusNotFound)
+			return
+		}
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			parts := strings.Split(strings.TrimPrefix(auth, "Bearer "), ".")
+			if len(parts) != 3 {
+				http.Error(w, "malformed token", http.StatusUnauthorized)
+				return
+			}
+			payload, _ := base64.RawURLEncoding.DecodeString(parts[1])
+			var claims struct {
+				Exp int64 `json:"exp"`
+			}
+			json.Unmarshal(payload, &claims)
+			if claims.Exp < time.Now().Unix() {
+				http.Error(w, "token expired", http.StatusUnauthorized)
+				return
+			}
+		}
+		var req map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&req)
+		if resp := handle(req); resp != nil {
+			json.NewEncoder(w).Encode(resp)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	reqs, err := AuthRequests("https://idp.example.com", "tools", true, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := New(Config{Timeout: 5 * time.Second})
+	results := p.ProbeAuthAll(context.Background(), []AuthTarget{
+		{Target: Target{URL: srv.URL + "/mcp", Host: "tools.example.com", Transport: TransportStreamableHTTP}, Requests: reqs},
+		{Target: Target{URL: "http://127.0.0.1:1/mcp"}, Requests: reqs[:1]},
+	})
+
+	want := map[string]string{AuthUnauthenticated: OutcomeAccepted, AuthExpiredToken: OutcomeRejected, AuthWrongAudience: OutcomeAccepted}
+	if len(results[0]) != 3 {
+		t.Fatalf("results = %+v", results[0])
+	}
+	for i, r := range results[0] {
+		if r.Kind != reqs[i].Kind || r.Outcome != want[r.Kind] {
+			t.Errorf("%s: outcome = %s (%s)", r.Kind, r.Outcome, r.Evidence)
+		}
+		if strings.Contains(r.Evidence, reqs[len(reqs)-1].Token) || !strings.Contains(r.Evidence, "Host: tools.example.com") {
+			t.Errorf("%s: evidence = %s", r.Kind, r.Evidence)
+		}
+	}
+	if ev := results[0][2].Evidence; !strings.Contains(ev, "aud="+WrongAudience) || !strings.Contains(ev, "tools/list returned 2 tool(s)") {
+		t.Errorf("wrong-audience evidence = %s", ev)
+	}
+	if len(results[1]) != 1 || results[1][0].Outcome != OutcomeInconclusive {
+		t.Errorf("unreachable: %+v", results[1])
+	}
+
+	anon, _ := AuthRequests("", "", false, time.Now())
+	if len(anon) != 1 || anon[0].Kind != AuthUnauthenticated || anon[0].Token != "" {
+		t.Errorf("without tokens: %+v", anon)
+	}
+}
@@ -0,0 +1,361 @@
+package mcpprobe
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type rpcRequest struct {
+	JSONRPC string      `json:"jsonrpc"`
+	ID      *int        `json:"id,omitempty"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params,omitempty"`
+}
+
+type rpcResponse struct {
+	ID     json.RawMessage `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func newRequest(id int, method string, params interface{}) rpcRequest {
+	req := rpcRequest{JSONRPC: "2.0", Method: method, Params: params}
+	if id > 0 {
+		req.ID = &id
+	}
+	return req
+}
+
+// matchResponse decodes data as the JSON-RPC response to request id. It
+// returns false for other messages (notifications, server requests).
+func matchResponse(data []byte, id int) (*rpcResponse, bool) {
+	var resp rpcResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, false
+	}
+	if strings.Trim(string(resp.ID), `"`) != strconv.Itoa(id) {
+		return nil, false
+	}
+	return &resp, true
+}
+
+// decodeResult unmarshals a response's result, or returns its JSON-RPC error.
+func decodeResult(data []byte, v interface{}) error {
+	var resp rpcResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return fmt.Errorf("invalid JSON-RPC response: %v", err)
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("JSON-RPC error %d: %s", resp.Error.Code, resp.Error.Message)
+	}
+	if len(resp.Result) == 0 {
+		return errors.New("JSON-RPC response without result")
+	}
+	return json.Unmarshal(resp.Result, v)
+}
+
+func isEventStream(h http.Header) bool {
+	mt, _, _ := mime.ParseMediaType(h.Get("Content-Type"))
+	return mt == "text/event-stream"
+}
+
+func checkStatus(resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return &statusError{code: resp.StatusCode, body: truncate(string(body))}
+}
+
+// readSSE reads server-sent events from r and calls fn for each until fn
+// returns false or the stream ends.
+func readSSE(r io.Reader, fn func(event, data string) bool) error {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), maxBodySize)
+	event := ""
+	var data []string
+	for sc.Scan() {
+		line := sc.Text()
+		switch {
+		case line == "":
+			if len(data) > 0 {
+				if event == "" {
+					event = "message"
+				}
+				if !fn(event, strings.Join(data, "\n")) {
+					return nil
+				}
+			}
+			event, data = "", nil
+		case strings.HasPrefix(line, ":"):
+			// comment / keep-alive
+		default:
+			field, value, _ := strings.Cut(line, ":")
+			value = strings.TrimPrefix(value, " ")
+			switch field {
+			case "event":
+				event = value
+			case "data":
+				data = append(data, value)
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+	return io.ErrUnexpectedEOF
+}
+
+// streamableSession speaks the Streamable HTTP transport: every message is
+// POSTed to the endpoint, which answers with JSON or an SSE stream.
+type streamableSession struct {
+	p         *Prober
+	target    Target
+	sessionID string
+	protocol  string
+}
+
+func (s *streamableSession) post(ctx context.Context, msg rpcRequest) (*http.Response, error) {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.target.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	s.target.apply(req)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json, text/event-stream")
+	if s.sessionID != "" {
+		req.Header.Set("Mcp-Session-Id", s.sessionID)
+	}
+	if s.protocol != "" {
+		req.Header.Set("MCP-Protocol-Version", s.protocol)
+	}
+	resp, err := s.p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkStatus(resp); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	if id := resp.Header.Get("Mcp-Session-Id"); id != "" {
+		s.sessionID = id
+	}
+	return resp, nil
+}
+
+func (s *streamableSession) call(ctx context.Context, id int, method string, params interface{}) ([]byte, error) {
+	resp, err := s.post(ctx, newRequest(id, method, params))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if !isEventStream(resp.Header) {
+		data, err := io.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := matchResponse(data, id); !ok {
+			return nil, fmt.Errorf("unexpected response: %s", truncate(string(data)))
+		}
+		return data, nil
+	}
+
+	var out []byte
+	err = readSSE(resp.Body, func(_, data string) bool {
+		if _, ok := matchResponse([]byte(data), id); ok {
+			out = []byte(data)
+			return false
+		}
+		return true
+	})
+	if out == nil {
+		return nil, fmt.Errorf("no response in event stream: %v", err)
+	}
+	return out, nil
+}
+
+func (s *streamableSession) notify(ctx context.Context, method string) error {
+	resp, err := s.post(ctx, newRequest(0, method, nil))
+	if err != nil {
+		return err
+	}
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxBodySize))
+	resp.Body.Close()
+	return nil
+}
+
+// close terminates the server-side session, if one was created.
+func (s *streamableSession) close() {
+	if s.sessionID == "" {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), s.p.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.target.URL, nil)
+	if err != nil {
+		return
+	}
+	s.target.apply(req)
+	req.Header.Set("Mcp-Session-Id", s.sessionID)
+	if resp, err := s.p.client.Do(req); err == nil {
+		resp.Body.Close()
+	}
+}
+
+type sseEvent struct {
+	event, data string
+}
+
+// sseSession speaks the legacy HTTP+SSE transport: the server announces a
+// POST endpoint on a long-lived GET stream and answers on that stream.
+type sseSession struct {
+	p        *Prober
+	target   Target
+	endpoint string
+	events   chan sseEvent
+	done     chan struct{}
+	err      error
+	cancel   context.CancelFunc
+}
+
+func (p *Prober) openSSE(ctx context.Context, t Target) (*sseSession, error) {
+	base, err := url.Parse(t.URL)
+	if err != nil {
+		return nil, err
+	}
+	streamCtx, cancel := context.WithCancel(ctx)
+	req, err := http.NewRequestWithContext(streamCtx, http.MethodGet, t.URL, nil)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	t.apply(req)
+	req.Header.Set("Accept", "text/event-stream")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	if err := checkStatus(resp); err != nil {
+		resp.Body.Close()
+		cancel()
+		return nil, err
+	}
+	if !isEventStream(resp.Header) {
+		resp.Body.Close()
+		cancel()
+		return nil, fmt.Errorf("not an SSE endpoint (Content-Type %q)", resp.Header.Get("Content-Type"))
+	}
+
+	s := &sseSession{p: p, target: t, events: make(chan sseEvent), done: make(chan struct{}), cancel: cancel}
+	go func() {
+		defer close(s.done)
+		defer resp.Body.Close()
+		s.err = readSSE(resp.Body, func(event, data string) bool {
+			select {
+			case s.events <- sseEvent{event, data}:
+				return true
+			case <-streamCtx.Done():
+				return false
+			}
+		})
+	}()
+
+	ev, err := s.next(ctx, func(ev sseEvent) bool { return ev.event == "endpoint" })
+	if err != nil {
+		s.close()
+		return nil, fmt.Errorf("endpoint event: %w", err)
+	}
+	endpoint, err := base.Parse(strings.TrimSpace(ev.data))
+	if err != nil {
+		s.close()
+		return nil, fmt.Errorf("endpoint event: %w", err)
+	}
+	// Only POST back to the server that was probed.
+	if endpoint.Scheme != base.Scheme || endpoint.Host != base.Host {
+		s.close()
+		return nil, fmt.Errorf("endpoint %s is not on %s", endpoint.Redacted(), base.Host)
+	}
+	s.endpoint = endpoint.String()
+	return s, nil
+}
+
+// next returns the next event accepted by match.
+func (s *sseSession) next(ctx context.Context, match func(sseEvent) bool) (sseEvent, error) {
+	for {
+		select {
+		case ev := <-s.events:
+			if match(ev) {
+				return ev, nil
+			}
+		case <-s.done:
+			if s.err != nil && !errors.Is(s.err, io.ErrUnexpectedEOF) {
+				return sseEvent{}, s.err
+			}
+			return sseEvent{}, errors.New("event stream closed")
+		case <-ctx.Done():
+			return sseEvent{}, ctx.Err()
+		}
+	}
+}
+
+func (s *sseSession) post(ctx context.Context, msg rpcRequest) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	s.target.apply(req)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxBodySize))
+	return checkStatus(resp)
+}
+
+func (s *sseSession) call(ctx context.Context, id int, method string, params interface{}) ([]byte, error) {
+	if err := s.post(ctx, newRequest(id, method, params)); err != nil {
+		return nil, err
+	}
+	ev, err := s.next(ctx, func(ev sseEvent) bool {
+		_, ok := matchResponse([]byte(ev.data), id)
+		return ev.event == "message" && ok
+	})
+	if err != nil {
+		return nil, err
+	}
+	return []byte(ev.data), nil
+}
+
+func (s *sseSession) notify(ctx context.Context, method string) error {
+	return s.post(ctx, newRequest(0, method, nil))
+}
+
+func (s *sseSession) close() {
+	s.cancel()
+	<-s.done
+}
@@ -13,6 +13,9 @@ import {
   VerifiedCatalogResponse,
   VerifiedSummary,
   VerifiedResource,
+  AuthzPrincipal,
+  AuthzDecision,
+  AuthzMatrix,
 } from './types';
 
 /**
@@ -73,6 +76,27 @@ export async function getMCPServerDetail(id: string): Promise<MCPServerView> {
   return fetchAPI(`/api/governance/mcp-servers/detail?id=${encodeURIComponent(id)}`);
 }
 
+async function postAPI<T>(endpoint: string, body: unknown): Promise<T> {
+  const res = await fetch(endpoint, {
+    method: 'POST',
+    cache: 'no-store',
+    headers: { 'Content-Type': 'application/json' },
+    body: JSON.stringify(body),
+  });
+  if (!res.ok) {
+    throw new Error(`API error: ${res.status} ${res.statusText}`);
+  }
+  return res.json();
+}
+
+export async function simulateAuthz(serverId: string, tool: string, principal: AuthzPrincipal): Promise<AuthzDecision> {
+  return postAPI('/api/governance/authz/simulate', { serverId, tool, ...principal });
+}
+
+export async function simulateAuthzMatrix(serverId: string, principals: AuthzPrincipal[], tools?: string[]): Promise<AuthzMatrix> {
+  return postAPI('/api/governance/authz/simulate', { serverId, principals, tools });
+}
+
 // ---------- Verified Catalog (Inventory) ----------
 
 export async function getVerifiedCatalog(): Promise<VerifiedCatalogResponse> {
@@ -9,6 +9,14 @@ export interface Finding {
   namespace: string;
   impact: string;
   remediation: string;
+  fingerprint?: string;
+  owner?: string;
+  status?: 'open' | 'waived' | 'resolved';
+  firstSeen?: string;
+  lastSeen?: string;
+  resolvedAt?: string;
+  occurrences?: number;
+  controls?: Record<string, string[]>;
 }
 
 export interface ScoreBreakdown {
@@ -113,6 +121,8 @@ export interface MCPServerScoreBreakdown {
   promptGuard: number;
   toolScope: number;
   hardenedDeployment: number;
+  workloadRBAC: number;
+  vulnerabilities: number;
 }
 
 export interface ScoreExplanation {
@@ -133,6 +143,132 @@ export interface RelatedResource {
   details?: Record<string, unknown>;
 }
 
+// Certificate read from a Gateway listener's TLS Secret
+export interface CertificateInfo {
+  secret: string;
+  subject?: string;
+  issuer?: string;
+  notBefore: string;
+  notAfter: string;
+  dnsNames?: string[];
+  keyAlgorithm?: string;
+  keySize?: number;
+  error?: string;
+}
+
+// Entry of details.jwtProviders on a related AgentgatewayPolicy
+export interface JWTProvider {
+  issuer?: string;
+  audiences?: string[];
+  jwksSource?: 'inline' | 'remote';
+  jwksUrl?: string;
+  algorithms?: string[];
+  requiredClaims?: string[];
+}
+
+// details.cors on a related AgentgatewayPolicy
+export interface CORSConfig {
+  allowOrigins?: string[];
+  allowMethods?: string[];
+  allowHeaders?: string[];
+  allowCredentials: boolean;
+  maxAge?: number;
+}
+
+// Entry of details.rateLimits on a related AgentgatewayPolicy
+export interface RateLimitRule {
+  type: 'local' | 'global';
+  requests?: number;
+  unit?: string;
+  burst?: number;
+}
+
+// Entry of details.promptGuardRules on a related AgentgatewayPolicy
+export interface PromptGuardRule {
+  phase: 'request' | 'response';
+  kind: string;
+  action?: string;
+  matches?: string[];
+  builtins?: string[];
+}
+
+// Entry of details.authorization.rules on a related AgentgatewayPolicy
+export interface RuleAnalysis {
+  section: 'traffic' | 'backend.mcp';
+  action: 'Allow' | 'Deny';
+  expression: string;
+  grants?: ToolGrant[];
+  issue?: 'invalid' | 'tautological' | 'contradictory' | 'unreachable';
+  detail?: string;
+}
+
+// One disjunct of an authorization rule
+export interface ToolGrant {
+  tools?: string[];
+  anyTool?: boolean;
+  toolPatterns?: string[];
+  excludedTools?: string[];
+  requiredClaims?: string[];
+  claimValues?: Record<string, string>;
+  conditions?: string[];
+}
+
+// Entry of details.toolAccess on a related AgentgatewayPolicy
+export interface ToolAccess {
+  tool: string;
+  requiredClaims?: string[];
+}
+
+// POST /api/governance/authz/simulate
+export interface AuthzPrincipal {
+  name?: string;
+  agent?: string; // AgentView ID
+  claims?: Record<string, unknown>;
+}
+
+export interface AuthzRuleResult {
+  section: 'traffic' | 'backend.mcp';
+  action: 'Allow' | 'Deny';
+  expression: string;
+  result: 'match' | 'no-match' | 'unknown' | 'error';
+  detail?: string;
+}
+
+export interface AuthzPolicyDecision {
+  policy: string;
+  decision: 'allow' | 'deny' | 'unknown';
+  rules: AuthzRuleResult[];
+  missingClaims?: string[];
+}
+
+export interface AuthzDecision {
+  serverId: string;
+  tool: string;
+  principal: string;
+  decision: 'allow' | 'deny' | 'unknown';
+  allowed: boolean;
+  reason: string;
+  routes: { route?: string; decision: 'allow' | 'deny' | 'unknown'; policies: AuthzPolicyDecision[] }[];
+  missingClaims?: string[];
+}
+
+export interface AuthzMatrix {
+  serverId: string;
+  tools: string[];
+  rows: { principal: string; decisions: Record<string, 'allow' | 'deny' | 'unknown'>; missingClaims?: Record<string, string[]> }[];
+}
+
+// Entry of details.listeners on a related Gateway
+export interface GatewayListener {
+  name: string;
+  port: number;
+  protocol: string;
+  hostname?: string;
+  tlsMode?: 'Terminate' | 'Passthrough';
+  certificateRefs?: string[];
+  certificates?: CertificateInfo[];
+}
+
 export interface MCPServerView {
   id: string;
   name: string;
@@ -149,6 +285,8 @@ export interface MCPServerView {
   toolsByRoute?: Record<string, string[]>; // Route name -> allowed tools
   toolsByPolicy?: Record<string, Record<string, string[]>>; // Route name -> Policy name -> allowed tools
   pathTools?: Record<string, string[]>; // Path label (/ro, /rw) -> allowed tools
+  toolSensitivity?: Record<string, 'read' | 'write' | 'destructive' | 'exec'>; // Tool name -> class
+  exposedToolRisk?: ToolRiskSummary;
 
   relatedBackends: RelatedResource[];
   relatedPolicies: RelatedResource[];
@@ -172,6 +310,21 @@ export interface MCPServerView {
   hasSecurityContext?: boolean;
   hasSeccomp?: boolean;
   imageVersionPinned?: boolean;
+  hasWorkload?: boolean;
+  networkPolicies?: string[];
+  ingressRestrictedToGateway?: boolean;
+  egressRestricted?: boolean;
+  gatewayBypasses?: GatewayBypass[];
+  workloadRBAC?: WorkloadRBAC;
+  imageSignatures?: ImageSignature[];
+  vulnerabilities?: WorkloadVulnerabilities;
+  liveProbe?: MCPProbeResult;
+  undeclaredTools?: string[];
+  authProbes?: AuthProbe[];
+  authConfidence?: 'inferred' | 'verified';
+
+  owner?: string;
+  ownerSource?: string;
 
   score: number;
   grade: string;
@@ -181,6 +334,126 @@ export interface MCPServerView {
   scoreExplanations?: ScoreExplanation[];
 }
 
+export interface WorkloadRBAC {
+  serviceAccount: string;
+  bindings: string[];
+  wildcardVerbs: boolean;
+  clusterWide: boolean;
+  secretsRead: boolean;
+  podsExec: boolean;
+  escalationVerbs?: string[];
+  tokenAutomounted: boolean;
+}
+
+export interface MCPProbeResult {
+  id: string;
+  url: string;
+  transport?: 'streamable-http' | 'sse';
+  status: 'ok' | 'unauthorized' | 'error';
+  reason?: string;
+  serverName?: string;
+  serverVersion?: string;
+  protocolVersion?: string;
+  tools?: string[];
+  probedAt: string;
+}
+
+export interface AuthProbeResult {
+  kind: 'unauthenticated' | 'expired-token' | 'wrong-audience';
+  outcome: 'accepted' | 'rejected' | 'inconclusive';
+  evidence: string;
+}
+
+export interface AuthProbe {
+  serverId: string;
+  via: 'gateway' | 'direct';
+  through: string;
+  url: string;
+  jwtMode?: string;
+  results?: AuthProbeResult[];
+}
+
+export interface ImageSignature {
+  image: string;
+  digest?: string;
+  status: 'verified' | 'unsigned' | 'invalid' | 'error';
+  keyId?: string;
+  reason?: string;
+}
+
+export interface Vulnerability {
+  id: string;
+  severity: string;
+  package: string;
+  installedVersion: string;
+  fixedVersion?: string;
+  title?: string;
+}
+
+export interface ImageVulnerabilities {
+  container: string;
+  image: string;
+  digest?: string;
+  report: string;
+  critical: number;
+  high: number;
+  medium: number;
+  low: number;
+  fixableCritical: number;
+  fixableHigh: number;
+  fixable?: Vulnerability[];
+}
+
+export interface WorkloadVulnerabilities {
+  scanned: boolean;
+  critical: number;
+  high: number;
+  medium: number;
+  low: number;
+  fixableCritical: number;
+  fixableHigh: number;
+  images?: ImageVulnerabilities[];
+  configAuditFailures?: string[];
+}
+
+export interface GatewayBypass {
+  kind: 'Service' | 'Ingress' | 'Route' | 'HTTPRoute';
+  name: string;
+  namespace: string;
+  via: string;
+  service: string;
+  hosts: string[];
+  gateway?: string;
+}
+
+export interface ToolRiskSummary {
+  read: number;
+  write: number;
+  destructive: number;
+  exec: number;
+  sensitive?: string[];
+}
+
+export interface TeamServerRef {
+  id: string;
+  name: string;
+  namespace: string;
+  score: number;
+  grade: string;
+  findings: number;
+}
+
+export interface TeamScore {
+  team: string;
+  score: number;
+  grade: string;
+  namespaces: string[];
+  serverCount: number;
+  openFindings: number;
+  findingsBySeverity: Record<string, number>;
+  worstServers: TeamServerRef[];
+}
+
 export interface MCPServerSummary {
   totalMCPServers: number;
   routedServers: number;
@@ -198,6 +471,47 @@ export interface MCPServersResponse {
   summary: MCPServerSummary;
 }
 
+// ---------- Agent-centric Types ----------
+
+export interface AgentReference {
+  type: string;
+  kind?: string;
+  name: string;
+  targetId?: string;
+  found: boolean;
+  score: number;
+  grade?: string;
+  declaredToolNames?: string[];
+  effectiveToolNames?: string[];
+  blockedToolNames?: string[];
+  weakestControl?: string;
+  weakestControlScore: number;
+}
+
+export interface AgentView {
+  id: string;
+  name: string;
+  namespace: string;
+  type?: string;
+  ready: boolean;
+  references: AgentReference[];
+  declaredToolNames: string[];
+  effectiveToolNames: string[];
+  exposedToolRisk: ToolRiskSummary;
+  weakestControl?: string;
+  weakestControlScore: number;
+  owner: string;
+  ownerSource?: string;
+  score: number;
+  grade: string;
+  status: 'compliant' | 'warning' | 'failing' | 'critical';
+  findings: Finding[];
+}
+
+export interface AgentsResponse {
+  agents: AgentView[];
+}
+
 // ---------- Verified Catalog Types (Inventory) ----------
 
 export interface VerifiedCheck {